@@ -16,6 +16,31 @@ interface RevenueByShowtime {
     total_tickets: number
 }
 
+interface Occupancy {
+    key: string
+    label: string
+    total_showtimes: number
+    tickets_sold: number
+    seat_capacity: number
+    occupancy_rate: number
+}
+
+interface RevenueByMovie {
+    movie_id: string
+    movie_title: string
+    total_revenue: number
+    total_bookings: number
+    total_tickets: number
+}
+
+interface SalesHeatmapCell {
+    weekday: number
+    hour: number
+    total_bookings: number
+    total_tickets: number
+    total_revenue: number
+}
+
 interface GetRevenueByTimeResponse {
     success: boolean
     message: string
@@ -34,9 +59,35 @@ interface GetTotalRevenueResponse {
     total_revenue: number
 }
 
+interface GetOccupancyResponse {
+    success: boolean
+    message: string
+    data: Occupancy[]
+}
+
+interface GetRevenueByMovieResponse {
+    success: boolean
+    message: string
+    data: RevenueByMovie[]
+}
+
+interface GetSalesHeatmapResponse {
+    success: boolean
+    message: string
+    data: SalesHeatmapCell[]
+}
+
+interface GetBookingLeadTimeResponse {
+    success: boolean
+    message: string
+    avg_lead_seconds: number
+    median_lead_seconds: number
+    total_bookings: number
+}
+
 interface BookingServiceClient {
     GetRevenueByTime(
-        request: { start_date: string; end_date: string; limit: number },
+        request: { start_date: string; end_date: string; limit: number; group_by: string },
         callback: (error: grpc.ServiceError | null, response: GetRevenueByTimeResponse) => void,
     ): void
 
@@ -49,6 +100,26 @@ interface BookingServiceClient {
         request: { start_date: string; end_date: string },
         callback: (error: grpc.ServiceError | null, response: GetTotalRevenueResponse) => void,
     ): void
+
+    GetOccupancy(
+        request: { start_date: string; end_date: string; group_by: string; limit: number },
+        callback: (error: grpc.ServiceError | null, response: GetOccupancyResponse) => void,
+    ): void
+
+    GetRevenueByMovie(
+        request: { start_date: string; end_date: string; limit: number },
+        callback: (error: grpc.ServiceError | null, response: GetRevenueByMovieResponse) => void,
+    ): void
+
+    GetSalesHeatmap(
+        request: { start_date: string; end_date: string },
+        callback: (error: grpc.ServiceError | null, response: GetSalesHeatmapResponse) => void,
+    ): void
+
+    GetBookingLeadTime(
+        request: { start_date: string; end_date: string; movie_id: string },
+        callback: (error: grpc.ServiceError | null, response: GetBookingLeadTimeResponse) => void,
+    ): void
 }
 
 class BookingGrpcClient {
@@ -83,12 +154,13 @@ class BookingGrpcClient {
         startDate: string,
         endDate: string,
         limit: number = 100,
+        groupBy: string = 'day',
     ): Promise<RevenueByTime[]> {
         const client = this.connect()
 
         return new Promise((resolve, reject) => {
             client.GetRevenueByTime(
-                { start_date: startDate, end_date: endDate, limit },
+                { start_date: startDate, end_date: endDate, limit, group_by: groupBy },
                 (error, response) => {
                     if (error) {
                         reject(error)
@@ -156,6 +228,118 @@ class BookingGrpcClient {
             )
         })
     }
+
+    async getOccupancy(
+        startDate: string,
+        endDate: string,
+        groupBy: string = 'showtime',
+        limit: number = 100,
+    ): Promise<Occupancy[]> {
+        const client = this.connect()
+
+        return new Promise((resolve, reject) => {
+            client.GetOccupancy(
+                { start_date: startDate, end_date: endDate, group_by: groupBy, limit },
+                (error, response) => {
+                    if (error) {
+                        reject(error)
+                        return
+                    }
+
+                    if (!response.success) {
+                        resolve([])
+                        return
+                    }
+
+                    resolve(response.data)
+                },
+            )
+        })
+    }
+
+    async getRevenueByMovie(
+        startDate: string,
+        endDate: string,
+        limit: number = 50,
+    ): Promise<RevenueByMovie[]> {
+        const client = this.connect()
+
+        return new Promise((resolve, reject) => {
+            client.GetRevenueByMovie(
+                { start_date: startDate, end_date: endDate, limit },
+                (error, response) => {
+                    if (error) {
+                        reject(error)
+                        return
+                    }
+
+                    if (!response.success) {
+                        resolve([])
+                        return
+                    }
+
+                    resolve(response.data)
+                },
+            )
+        })
+    }
+
+    async getSalesHeatmap(
+        startDate: string,
+        endDate: string,
+    ): Promise<SalesHeatmapCell[]> {
+        const client = this.connect()
+
+        return new Promise((resolve, reject) => {
+            client.GetSalesHeatmap(
+                { start_date: startDate, end_date: endDate },
+                (error, response) => {
+                    if (error) {
+                        reject(error)
+                        return
+                    }
+
+                    if (!response.success) {
+                        resolve([])
+                        return
+                    }
+
+                    resolve(response.data)
+                },
+            )
+        })
+    }
+
+    async getBookingLeadTime(
+        startDate: string,
+        endDate: string,
+        movieId: string = '',
+    ): Promise<{ avg_lead_seconds: number; median_lead_seconds: number; total_bookings: number }> {
+        const client = this.connect()
+
+        return new Promise((resolve, reject) => {
+            client.GetBookingLeadTime(
+                { start_date: startDate, end_date: endDate, movie_id: movieId },
+                (error, response) => {
+                    if (error) {
+                        reject(error)
+                        return
+                    }
+
+                    if (!response.success) {
+                        resolve({ avg_lead_seconds: 0, median_lead_seconds: 0, total_bookings: 0 })
+                        return
+                    }
+
+                    resolve({
+                        avg_lead_seconds: response.avg_lead_seconds,
+                        median_lead_seconds: response.median_lead_seconds,
+                        total_bookings: response.total_bookings,
+                    })
+                },
+            )
+        })
+    }
 }
 
 export default new BookingGrpcClient()
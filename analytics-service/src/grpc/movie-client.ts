@@ -11,6 +11,7 @@ interface ShowtimeData {
     movie_title: string
     room_number: string
     seat_numbers: string[]
+    seat_capacity: number
 }
 
 interface GetShowtimeResponse {
@@ -13,12 +13,22 @@ const parseFilters = (query: any): AnalyticsFilters => {
         genre: query.genre,
         status: query.status,
         booking_type: query.booking_type,
+        group_by: query.group_by,
         limit: query.limit ? parseInt(query.limit) : undefined,
         offset: query.offset ? parseInt(query.offset) : undefined,
     }
 }
 
 router.get('/revenue/time', async (req: Request, res: Response) => {
+    const groupBy = req.query.group_by
+    if (groupBy && !['hour', 'day', 'week', 'month'].includes(String(groupBy))) {
+        res.status(400).json({
+            success: false,
+            message: 'group_by must be one of hour, day, week, month',
+        })
+        return
+    }
+
     try {
         const filters = parseFilters(req.query)
         const data = await analyticsService.getRevenueByTime(filters)
@@ -108,4 +118,67 @@ router.get('/revenue/total', async (req: Request, res: Response) => {
     }
 })
 
+router.get('/occupancy', async (req: Request, res: Response) => {
+    const groupBy = req.query.group_by
+    if (groupBy && !['showtime', 'room', 'format'].includes(String(groupBy))) {
+        res.status(400).json({
+            success: false,
+            message: 'group_by must be one of showtime, room, format',
+        })
+        return
+    }
+
+    try {
+        const filters = parseFilters(req.query)
+        const data = await analyticsService.getOccupancy(filters)
+
+        res.json({
+            success: true,
+            data,
+        })
+    } catch (error) {
+        console.error('Error fetching occupancy:', error)
+        res.status(500).json({
+            success: false,
+            message: 'Failed to fetch occupancy',
+        })
+    }
+})
+
+router.get('/sales/heatmap', async (req: Request, res: Response) => {
+    try {
+        const filters = parseFilters(req.query)
+        const data = await analyticsService.getSalesHeatmap(filters)
+
+        res.json({
+            success: true,
+            data,
+        })
+    } catch (error) {
+        console.error('Error fetching sales heatmap:', error)
+        res.status(500).json({
+            success: false,
+            message: 'Failed to fetch sales heatmap',
+        })
+    }
+})
+
+router.get('/bookings/lead-time', async (req: Request, res: Response) => {
+    try {
+        const filters = parseFilters(req.query)
+        const data = await analyticsService.getBookingLeadTime(filters)
+
+        res.json({
+            success: true,
+            data,
+        })
+    } catch (error) {
+        console.error('Error fetching booking lead time:', error)
+        res.status(500).json({
+            success: false,
+            message: 'Failed to fetch booking lead time',
+        })
+    }
+})
+
 export default router
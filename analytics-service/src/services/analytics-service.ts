@@ -6,6 +6,9 @@ import {
     RevenueByShowtime,
     AnalyticsFilters,
     RevenueByGenre,
+    OccupancyStat,
+    SalesHeatmapCell,
+    BookingLeadTime,
 } from '../types/index.js'
 
 class AnalyticsService {
@@ -21,6 +24,7 @@ class AnalyticsService {
             filters.start_date || '',
             filters.end_date || '',
             filters.limit || 100,
+            filters.group_by || 'day',
         )
 
         await redisClient.set(cacheKey, JSON.stringify(data), 300)
@@ -35,50 +39,12 @@ class AnalyticsService {
             return JSON.parse(cached)
         }
 
-        const rawData = await bookingGrpcClient.getRevenueByShowtime(
+        const result = await bookingGrpcClient.getRevenueByMovie(
             filters.start_date || '',
             filters.end_date || '',
-            '',
-            filters.limit || 1000,
+            filters.limit || 50,
         )
 
-        const showtimeIds = [...new Set(rawData.map((item) => item.showtime_id))]
-        const showtimes = await movieGrpcClient.getShowtimes(showtimeIds)
-
-        const showtimeMap = new Map(showtimes.map((st) => [st.id, st]))
-
-        const movieRevenueMap = new Map<string, RevenueByMovie>()
-
-        for (const item of rawData) {
-            const showtime = showtimeMap.get(item.showtime_id)
-            if (!showtime) {
-                continue
-            }
-
-            const movieId = showtime.movie_id
-            const existing = movieRevenueMap.get(movieId)
-
-            if (existing) {
-                existing.total_revenue += item.total_revenue
-                existing.total_bookings += item.total_bookings
-                existing.total_tickets += item.total_tickets
-            }
-
-            if (!existing) {
-                movieRevenueMap.set(movieId, {
-                    movie_id: movieId,
-                    movie_title: showtime.movie_title,
-                    total_revenue: item.total_revenue,
-                    total_bookings: item.total_bookings,
-                    total_tickets: item.total_tickets,
-                })
-            }
-        }
-
-        const result = Array.from(movieRevenueMap.values())
-            .sort((a, b) => b.total_revenue - a.total_revenue)
-            .slice(0, filters.limit || 50)
-
         await redisClient.set(cacheKey, JSON.stringify(result), 300)
         return result
     }
@@ -128,7 +94,7 @@ class AnalyticsService {
                 }
             }
 
-            const totalSeats = showtime.seat_numbers.length
+            const totalSeats = showtime.seat_capacity
             const occupancyRate = totalSeats > 0 ? (item.total_tickets / totalSeats) * 100 : 0
 
             return {
@@ -211,6 +177,60 @@ class AnalyticsService {
         return result
     }
 
+    async getOccupancy(filters: AnalyticsFilters): Promise<OccupancyStat[]> {
+        const cacheKey = `analytics:occupancy:${JSON.stringify(filters)}`
+
+        const cached = await redisClient.get(cacheKey)
+        if (cached) {
+            return JSON.parse(cached)
+        }
+
+        const data = await bookingGrpcClient.getOccupancy(
+            filters.start_date || '',
+            filters.end_date || '',
+            filters.group_by || 'showtime',
+            filters.limit || 100,
+        )
+
+        await redisClient.set(cacheKey, JSON.stringify(data), 300)
+        return data
+    }
+
+    async getSalesHeatmap(filters: AnalyticsFilters): Promise<SalesHeatmapCell[]> {
+        const cacheKey = `analytics:sales:heatmap:${JSON.stringify(filters)}`
+
+        const cached = await redisClient.get(cacheKey)
+        if (cached) {
+            return JSON.parse(cached)
+        }
+
+        const data = await bookingGrpcClient.getSalesHeatmap(
+            filters.start_date || '',
+            filters.end_date || '',
+        )
+
+        await redisClient.set(cacheKey, JSON.stringify(data), 300)
+        return data
+    }
+
+    async getBookingLeadTime(filters: AnalyticsFilters): Promise<BookingLeadTime> {
+        const cacheKey = `analytics:bookings:lead-time:${JSON.stringify(filters)}`
+
+        const cached = await redisClient.get(cacheKey)
+        if (cached) {
+            return JSON.parse(cached)
+        }
+
+        const data = await bookingGrpcClient.getBookingLeadTime(
+            filters.start_date || '',
+            filters.end_date || '',
+            filters.movie_id || '',
+        )
+
+        await redisClient.set(cacheKey, JSON.stringify(data), 300)
+        return data
+    }
+
     async getTotalRevenueSummary(filters: AnalyticsFilters): Promise<{
         total_revenue: number
         period_start: string | undefined
@@ -37,6 +37,29 @@ export interface RevenueByGenre {
     movie_count: number
 }
 
+export interface OccupancyStat {
+    key: string
+    label: string
+    total_showtimes: number
+    tickets_sold: number
+    seat_capacity: number
+    occupancy_rate: number
+}
+
+export interface SalesHeatmapCell {
+    weekday: number
+    hour: number
+    total_bookings: number
+    total_tickets: number
+    total_revenue: number
+}
+
+export interface BookingLeadTime {
+    avg_lead_seconds: number
+    median_lead_seconds: number
+    total_bookings: number
+}
+
 export interface Movie {
     id: string
     title: string
@@ -53,6 +76,7 @@ export interface AnalyticsFilters {
     genre?: string
     status?: string
     booking_type?: string
+    group_by?: string
     limit?: number
     offset?: number
 }
@@ -114,13 +114,13 @@ func (a *AuthMiddleware) isPublicPath(path string) bool {
 
 func (a *AuthMiddleware) isAdminPath(path string) bool {
 	for _, adminPath := range a.config.Auth.AdminPaths {
-		// Handle wildcard patterns
+		// Handle wildcard patterns. A miss must not end the loop, or every
+		// entry after the first wildcard would be ignored.
 		if strings.HasSuffix(adminPath, "*") {
-			prefix := strings.TrimSuffix(adminPath, "*")
-			if !strings.HasPrefix(path, prefix) {
-				return false
+			if strings.HasPrefix(path, strings.TrimSuffix(adminPath, "*")) {
+				return true
 			}
-			return true
+			continue
 		}
 		// Exact match or glob pattern match
 		matched, _ := filepath.Match(adminPath, path)
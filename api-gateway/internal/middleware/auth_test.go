@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"testing"
+
+	"api-gateway/internal/config"
+)
+
+func TestIsAdminPath(t *testing.T) {
+	a := &AuthMiddleware{config: &config.Config{Auth: config.AuthConfig{AdminPaths: []string{
+		"/api/v1/movies/*/update",
+		"/api/v1/admin/*",
+		"/api/v1/pricing-rules",
+		"/api/v1/pricing-rules/*",
+		"/api/v1/ticket-categories",
+		"/api/v1/ticket-categories/*",
+		"/api/v1/gift-cards",
+		"/api/v1/gift-cards/*",
+	}}}}
+
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "/api/v1/movies/42/update", want: true},
+		{path: "/api/v1/admin/bookings/1/force-confirm", want: true},
+		{path: "/api/v1/pricing-rules", want: true},
+		{path: "/api/v1/pricing-rules/abc", want: true},
+		{path: "/api/v1/ticket-categories", want: true},
+		{path: "/api/v1/ticket-categories/child", want: true},
+		{path: "/api/v1/gift-cards/abc/void", want: true},
+		{path: "/api/v1/payments/booking/1/gift-card", want: false},
+		{path: "/api/v1/movies/42", want: false},
+		{path: "/api/v1/bookings", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := a.isAdminPath(tt.path); got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -38,6 +38,7 @@ func DefaultCORSConfig() *CORSConfig {
 			"Accept",
 			"X-Requested-With",
 			"X-Request-ID",
+			"Idempotency-Key",
 		},
 		ExposeHeaders: []string{
 			"Content-Length",
@@ -45,6 +46,7 @@ func DefaultCORSConfig() *CORSConfig {
 			"X-RateLimit-Limit",
 			"X-RateLimit-Remaining",
 			"X-RateLimit-Reset",
+			"Idempotent-Replayed",
 		},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
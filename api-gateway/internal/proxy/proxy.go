@@ -160,6 +160,8 @@ func (p *Proxy) getTargetService(path string) (*ServiceInfo, string) {
 		strings.HasPrefix(path, "/api/v1/rooms"),
 		strings.HasPrefix(path, "/api/v1/seats"),
 		strings.HasPrefix(path, "/api/v1/showtimes"),
+		strings.HasPrefix(path, "/api/v1/pricing-rules"),
+		strings.HasPrefix(path, "/api/v1/ticket-categories"),
 		strings.HasPrefix(path, "/api/v1/news"):
 		return &ServiceInfo{
 			Name:     "movie-service",
@@ -181,13 +183,21 @@ func (p *Proxy) getTargetService(path string) (*ServiceInfo, string) {
 		}, path
 
 	case strings.HasPrefix(path, "/api/v1/bookings"),
-		strings.HasPrefix(path, "/api/v1/tickets"):
+		strings.HasPrefix(path, "/api/v1/tickets"),
+		strings.HasPrefix(path, "/api/v1/holds"),
+		strings.HasPrefix(path, "/api/v1/promotions"),
+		strings.HasPrefix(path, "/api/v1/loyalty"),
+		strings.HasPrefix(path, "/api/v1/waitlist"),
+		strings.HasPrefix(path, "/api/v1/staff-sessions"),
+		strings.HasPrefix(path, "/api/v1/concessions"),
+		strings.HasPrefix(path, "/api/v1/admin/bookings"):
 		return &ServiceInfo{
 			Name:     "booking-service",
 			Endpoint: p.config.Services.BookingService,
 		}, path
 
-	case strings.HasPrefix(path, "/api/v1/payments"):
+	case strings.HasPrefix(path, "/api/v1/payments"),
+		strings.HasPrefix(path, "/api/v1/gift-cards"):
 		return &ServiceInfo{
 			Name:     "payment-service",
 			Endpoint: p.config.Services.PaymentService,
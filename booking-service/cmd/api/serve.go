@@ -1,15 +1,18 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"net"
 	"net/http"
 	"strings"
 	"sync"
+	"time"
 
 	"booking-service/internal/container"
 	grpcServer "booking-service/internal/grpc_server"
 	"booking-service/internal/handlers"
+	"booking-service/internal/services"
 	"booking-service/internal/utils/env"
 	"booking-service/proto/pb"
 
@@ -56,6 +59,8 @@ func serve(c *cli.Context) error {
 		Handler: router,
 	}
 
+	go migrateSeatStates(i)
+
 	wg := new(sync.WaitGroup)
 
 	// Start HTTP server
@@ -97,3 +102,34 @@ func serve(c *cli.Context) error {
 	wg.Wait()
 	return nil
 }
+
+// migrateSeatStates brings seat state left by older releases into the
+// per-showtime seat state hash and fills in the seats of active bookings it
+// is missing. It runs in the background: booked seats are also guarded by
+// booking_seats, so serving does not wait for it.
+func migrateSeatStates(i *do.Injector) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	bookingService, err := do.Invoke[*services.BookingService](i)
+	if err != nil {
+		logrus.Errorf("Failed to get booking service for seat state migration: %v", err)
+		return
+	}
+
+	migrated, err := bookingService.MigrateLegacySeatLocks(ctx)
+	if err != nil {
+		logrus.Errorf("Failed to migrate legacy seat locks: %v", err)
+	}
+	if migrated > 0 {
+		logrus.Printf("Migrated %d legacy seat locks into seat state\n", migrated)
+	}
+
+	backfilled, err := bookingService.BackfillSeatStates(ctx)
+	if err != nil {
+		logrus.Errorf("Failed to backfill seat state from bookings: %v", err)
+	}
+	if backfilled > 0 {
+		logrus.Printf("Backfilled %d booked seats into seat state\n", backfilled)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"booking-service/internal/pkg/db"
 	"booking-service/internal/pkg/pubsub"
 	redisPubsub "booking-service/internal/pkg/pubsub/redis"
+	"booking-service/internal/pkg/tickettoken"
 	"booking-service/internal/services"
 	"booking-service/internal/utils/env"
 
@@ -32,6 +33,7 @@ func NewContainer() *do.Injector {
 		"REDIS_LIMITER_URL",
 		"REDIS_PUBSUB_URL",
 		"REDIS_PUBSUB_READONLY_URL",
+		"TICKET_SIGNING_SECRET",
 	)
 	if err != nil {
 		panic(err)
@@ -50,8 +52,10 @@ func NewContainer() *do.Injector {
 	do.Provide(injector, provideRedisCacheReadOnly)
 	do.Provide(injector, provideRedisPubsub)
 	do.Provide(injector, provideOutboxClient)
+	do.Provide(injector, provideTicketSigner)
 	do.Provide(injector, provideBookingService)
 	do.Provide(injector, provideMovieClient)
+	do.Provide(injector, providePaymentClient)
 	do.Provide(injector, provideAuthClient)
 	do.Provide(injector, provideBookingServer)
 
@@ -204,6 +208,10 @@ func provideMovieClient(_ *do.Injector) (*grpc.MovieClient, error) {
 	return grpc.NewMovieClient()
 }
 
+func providePaymentClient(_ *do.Injector) (*grpc.PaymentClient, error) {
+	return grpc.NewPaymentClient()
+}
+
 func provideAuthClient(_ *do.Injector) (*grpc.AuthClient, error) {
 	return grpc.NewAuthClient()
 }
@@ -212,6 +220,10 @@ func provideOutboxClient(_ *do.Injector) (*grpc.OutboxClient, error) {
 	return grpc.NewOutboxClient()
 }
 
+func provideTicketSigner(_ *do.Injector) (*tickettoken.Signer, error) {
+	return tickettoken.NewSigner(os.Getenv("TICKET_SIGNING_SECRET"))
+}
+
 func provideBookingServer(i *do.Injector) (*grpc_server.BookingServer, error) {
 	return grpc_server.NewBookingServer(i)
 }
@@ -2,6 +2,7 @@ package datastore
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"booking-service/internal/models"
@@ -30,72 +31,109 @@ type RevenueByBookingType struct {
 	Percentage    float64 `bun:"percentage"`
 }
 
-func GetRevenueByTime(ctx context.Context, db *bun.DB, startDate, endDate string, limit int) ([]*RevenueByTime, error) {
-	var results []*RevenueByTime
+type ShowtimeOccupancy struct {
+	ShowtimeId  string `bun:"showtime_id"`
+	RoomId      string `bun:"room_id"`
+	Format      string `bun:"format"`
+	TicketsSold int    `bun:"tickets_sold"`
+}
 
-	query := `
-		SELECT
-			DATE_TRUNC('day', created_at) as time_period,
-			SUM(total_amount) as total_revenue,
-			COUNT(*) as total_bookings,
-			AVG(total_amount) as avg_booking_value
-		FROM bookings
-		WHERE status = ?
-	`
+type RevenueByMovie struct {
+	MovieId       string  `bun:"movie_id"`
+	MovieTitle    string  `bun:"movie_title"`
+	TotalRevenue  float64 `bun:"total_revenue"`
+	TotalBookings int     `bun:"total_bookings"`
+	TotalTickets  int     `bun:"total_tickets"`
+}
+
+type SalesHeatmapCell struct {
+	Weekday       int     `bun:"weekday"`
+	Hour          int     `bun:"hour"`
+	TotalBookings int     `bun:"total_bookings"`
+	TotalTickets  int     `bun:"total_tickets"`
+	TotalRevenue  float64 `bun:"total_revenue"`
+}
 
-	args := []interface{}{models.BookingStatusConfirmed}
+type BookingLeadTime struct {
+	AvgLeadSeconds    float64 `bun:"avg_lead_seconds"`
+	MedianLeadSeconds float64 `bun:"median_lead_seconds"`
+	TotalBookings     int     `bun:"total_bookings"`
+}
 
-	if startDate != "" {
-		query += " AND created_at >= ?"
-		args = append(args, startDate)
+var ErrInvalidGranularity = fmt.Errorf("granularity must be one of hour, day, week, month")
+
+var revenueGranularities = map[string]bool{
+	"hour":  true,
+	"day":   true,
+	"week":  true,
+	"month": true,
+}
+
+// GetRevenueByTime buckets confirmed bookings by when they were made, in the
+// business timezone, newest bucket first.
+func GetRevenueByTime(ctx context.Context, db *bun.DB, startDate, endDate, granularity string, limit int) ([]*RevenueByTime, error) {
+	if granularity == "" {
+		granularity = "day"
+	}
+	if !revenueGranularities[granularity] {
+		return nil, ErrInvalidGranularity
 	}
 
-	if endDate != "" {
-		query += " AND created_at <= ?"
-		args = append(args, endDate)
+	facts, err := getAnalyticsFacts(ctx, db, granularity != "hour", startDate, endDate)
+	if err != nil {
+		return nil, err
 	}
 
-	query += `
-		GROUP BY DATE_TRUNC('day', created_at)
+	var results []*RevenueByTime
+
+	query := `
+		WITH facts AS (` + facts.query + `)
+		SELECT
+			DATE_TRUNC(?, local_ts) AT TIME ZONE ? as time_period,
+			SUM(total_revenue) as total_revenue,
+			SUM(total_bookings) as total_bookings,
+			SUM(total_revenue) / NULLIF(SUM(total_bookings), 0) as avg_booking_value
+		FROM facts
+		GROUP BY DATE_TRUNC(?, local_ts)
 		ORDER BY time_period DESC
 		LIMIT ?
 	`
-	args = append(args, limit)
+	args := append(facts.args, granularity, facts.location.String(), granularity, limit)
 
-	err := db.NewRaw(query, args...).Scan(ctx, &results)
+	err = db.NewRaw(query, args...).Scan(ctx, &results)
 	if err != nil {
 		return nil, err
 	}
 
+	for _, r := range results {
+		r.TimePeriod = r.TimePeriod.In(facts.location)
+	}
+
 	return results, nil
 }
 
 func GetRevenueByShowtime(ctx context.Context, db *bun.DB, startDate, endDate, showtimeId string, limit int) ([]*RevenueByShowtime, error) {
+	facts, err := getAnalyticsFacts(ctx, db, false, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
 	var results []*RevenueByShowtime
 
 	query := db.NewSelect().
-		TableExpr("bookings b").
-		ColumnExpr("b.showtime_id").
-		ColumnExpr("SUM(b.total_amount) as total_revenue").
-		ColumnExpr("COUNT(DISTINCT b.id) as total_bookings").
-		ColumnExpr("COALESCE(COUNT(t.id), 0) as total_tickets").
-		Join("LEFT JOIN tickets t ON t.booking_id = b.id").
-		Where("b.status = ?", models.BookingStatusConfirmed)
-
-	if startDate != "" {
-		query = query.Where("b.created_at >= ?", startDate)
-	}
-
-	if endDate != "" {
-		query = query.Where("b.created_at <= ?", endDate)
-	}
+		With("facts", db.NewRaw(facts.query, facts.args...)).
+		TableExpr("facts").
+		ColumnExpr("showtime_id").
+		ColumnExpr("SUM(total_revenue) as total_revenue").
+		ColumnExpr("SUM(total_bookings) as total_bookings").
+		ColumnExpr("SUM(total_tickets) as total_tickets")
 
 	if showtimeId != "" {
-		query = query.Where("b.showtime_id = ?", showtimeId)
+		query = query.Where("showtime_id = ?", showtimeId)
 	}
 
-	err := query.
-		Group("b.showtime_id").
+	err = query.
+		Group("showtime_id").
 		Order("total_revenue DESC").
 		Limit(limit).
 		Scan(ctx, &results)
@@ -107,31 +145,21 @@ func GetRevenueByShowtime(ctx context.Context, db *bun.DB, startDate, endDate, s
 }
 
 func GetRevenueByBookingType(ctx context.Context, db *bun.DB, startDate, endDate string) ([]*RevenueByBookingType, error) {
+	facts, err := getAnalyticsFacts(ctx, db, false, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
 	var results []*RevenueByBookingType
 
 	query := `
-		WITH booking_stats AS (
+		WITH facts AS (` + facts.query + `),
+		booking_stats AS (
 			SELECT
 				booking_type,
-				SUM(total_amount) as total_revenue,
-				COUNT(*) as total_bookings
-			FROM bookings
-			WHERE status = ?
-	`
-
-	args := []interface{}{models.BookingStatusConfirmed}
-
-	if startDate != "" {
-		query += " AND created_at >= ?"
-		args = append(args, startDate)
-	}
-
-	if endDate != "" {
-		query += " AND created_at <= ?"
-		args = append(args, endDate)
-	}
-
-	query += `
+				SUM(total_revenue) as total_revenue,
+				SUM(total_bookings) as total_bookings
+			FROM facts
 			GROUP BY booking_type
 		),
 		total_stats AS (
@@ -147,7 +175,7 @@ func GetRevenueByBookingType(ctx context.Context, db *bun.DB, startDate, endDate
 		ORDER BY bs.total_revenue DESC
 	`
 
-	err := db.NewRaw(query, args...).Scan(ctx, &results)
+	err = db.NewRaw(query, facts.args...).Scan(ctx, &results)
 	if err != nil {
 		return nil, err
 	}
@@ -156,25 +184,151 @@ func GetRevenueByBookingType(ctx context.Context, db *bun.DB, startDate, endDate
 }
 
 func GetTotalRevenue(ctx context.Context, db *bun.DB, startDate, endDate string) (float64, error) {
+	facts, err := getAnalyticsFacts(ctx, db, false, startDate, endDate)
+	if err != nil {
+		return 0, err
+	}
+
 	var total float64
 
+	err = db.NewSelect().
+		With("facts", db.NewRaw(facts.query, facts.args...)).
+		TableExpr("facts").
+		ColumnExpr("COALESCE(SUM(total_revenue), 0)").
+		Scan(ctx, &total)
+	if err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// GetShowtimeOccupancy counts the active tickets of every showtime starting
+// in the range, newest first. A limit <= 0 returns every showtime.
+func GetShowtimeOccupancy(ctx context.Context, db *bun.DB, startDate, endDate string, limit int) ([]*ShowtimeOccupancy, error) {
+	var results []*ShowtimeOccupancy
+
 	query := db.NewSelect().
-		TableExpr("bookings").
-		ColumnExpr("COALESCE(SUM(total_amount), 0)").
-		Where("status = ?", models.BookingStatusConfirmed)
+		TableExpr("showtimes s").
+		ColumnExpr("s.id as showtime_id").
+		ColumnExpr("s.room_id").
+		ColumnExpr("s.format").
+		ColumnExpr("COUNT(t.id) as tickets_sold").
+		Join("LEFT JOIN tickets t ON t.showtime_id = s.id AND t.status != ?", models.TicketStatusCancelled).
+		Where("s.status != 'CANCELED'")
 
 	if startDate != "" {
-		query = query.Where("created_at >= ?", startDate)
+		query = query.Where("s.start_time >= ?", startDate)
 	}
 
 	if endDate != "" {
-		query = query.Where("created_at <= ?", endDate)
+		query = query.Where("s.start_time <= ?", endDate)
+	}
+
+	query = query.
+		Group("s.id", "s.room_id", "s.format", "s.start_time").
+		Order("s.start_time DESC")
+
+	if limit > 0 {
+		query = query.Limit(limit)
 	}
 
-	err := query.Scan(ctx, &total)
+	err := query.Scan(ctx, &results)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	return total, nil
+	return results, nil
+}
+
+func GetRevenueByMovie(ctx context.Context, db *bun.DB, startDate, endDate string, limit int) ([]*RevenueByMovie, error) {
+	facts, err := getAnalyticsFacts(ctx, db, false, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*RevenueByMovie
+
+	err = db.NewSelect().
+		With("facts", db.NewRaw(facts.query, facts.args...)).
+		TableExpr("facts f").
+		ColumnExpr("s.movie_id").
+		ColumnExpr("m.title as movie_title").
+		ColumnExpr("SUM(f.total_revenue) as total_revenue").
+		ColumnExpr("SUM(f.total_bookings) as total_bookings").
+		ColumnExpr("SUM(f.total_tickets) as total_tickets").
+		Join("INNER JOIN showtimes s ON s.id = f.showtime_id").
+		Join("INNER JOIN movies m ON m.id = s.movie_id").
+		Group("s.movie_id", "m.title").
+		Order("total_revenue DESC").
+		Limit(limit).
+		Scan(ctx, &results)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// GetSalesHeatmap buckets confirmed bookings by the weekday (0 = Sunday) and
+// hour they were made at, in the business timezone. Empty buckets are left
+// out.
+func GetSalesHeatmap(ctx context.Context, db *bun.DB, startDate, endDate string) ([]*SalesHeatmapCell, error) {
+	facts, err := getAnalyticsFacts(ctx, db, false, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*SalesHeatmapCell
+
+	err = db.NewSelect().
+		With("facts", db.NewRaw(facts.query, facts.args...)).
+		TableExpr("facts").
+		ColumnExpr("EXTRACT(DOW FROM local_ts)::int as weekday").
+		ColumnExpr("EXTRACT(HOUR FROM local_ts)::int as hour").
+		ColumnExpr("SUM(total_bookings) as total_bookings").
+		ColumnExpr("SUM(total_tickets) as total_tickets").
+		ColumnExpr("SUM(total_revenue) as total_revenue").
+		GroupExpr("weekday, hour").
+		OrderExpr("weekday, hour").
+		Scan(ctx, &results)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// GetBookingLeadTime measures how long before the showtime confirmed bookings
+// are made. Bookings made after the showtime started count as zero.
+func GetBookingLeadTime(ctx context.Context, db *bun.DB, startDate, endDate, movieId string) (*BookingLeadTime, error) {
+	result := new(BookingLeadTime)
+
+	query := db.NewSelect().
+		TableExpr("bookings b").
+		ColumnExpr("COALESCE(AVG(l.lead_seconds), 0) as avg_lead_seconds").
+		ColumnExpr("COALESCE(PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY l.lead_seconds), 0) as median_lead_seconds").
+		ColumnExpr("COUNT(b.id) as total_bookings").
+		Join("INNER JOIN showtimes s ON s.id = b.showtime_id").
+		Join("CROSS JOIN LATERAL (SELECT GREATEST(EXTRACT(EPOCH FROM (s.start_time - b.created_at)), 0) as lead_seconds) l").
+		Where("b.status = ?", models.BookingStatusConfirmed)
+
+	if startDate != "" {
+		query = query.Where("b.created_at >= ?", startDate)
+	}
+
+	if endDate != "" {
+		query = query.Where("b.created_at <= ?", endDate)
+	}
+
+	if movieId != "" {
+		query = query.Where("s.movie_id = ?", movieId)
+	}
+
+	err := query.Scan(ctx, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
 }
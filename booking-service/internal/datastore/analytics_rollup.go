@@ -0,0 +1,163 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"booking-service/internal/models"
+
+	"github.com/uptrace/bun"
+)
+
+// Rollup tables are maintained by the worker-service rollup job. Hourly
+// buckets are UTC hours, daily buckets are dates in the business timezone
+// recorded on the daily state.
+const (
+	analyticsRollupHourly = "hourly"
+	analyticsRollupDaily  = "daily"
+)
+
+type AnalyticsRollupState struct {
+	bun.BaseModel `bun:"table:analytics_rollup_states,alias:ars"`
+
+	Name        string     `bun:"name,pk"`
+	Timezone    string     `bun:"timezone"`
+	ClosedUntil *time.Time `bun:"closed_until"`
+}
+
+// analyticsFacts is a query yielding confirmed booking totals as rows of
+// (local_ts, showtime_id, booking_type, total_bookings, total_tickets,
+// total_revenue), where local_ts is a timestamp in the business timezone.
+// Closed buckets come from a rollup table; bookings made since the rollup
+// was last closed are read live.
+type analyticsFacts struct {
+	query    string
+	args     []interface{}
+	location *time.Location
+}
+
+func getAnalyticsRollupStates(ctx context.Context, db *bun.DB) (map[string]*AnalyticsRollupState, error) {
+	var states []*AnalyticsRollupState
+
+	err := db.NewSelect().
+		Model(&states).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get analytics rollup states: %w", err)
+	}
+
+	byName := make(map[string]*AnalyticsRollupState, len(states))
+	for _, state := range states {
+		byName[state.Name] = state
+	}
+
+	return byName, nil
+}
+
+// getAnalyticsFacts builds the facts for bookings created between startDate
+// and endDate. Daily facts are coarser but cheaper and should be used when
+// results are grouped by day or longer. Rollup buckets are matched by their
+// start, so ranges are only exact on bucket boundaries.
+func getAnalyticsFacts(ctx context.Context, db *bun.DB, daily bool, startDate, endDate string) (*analyticsFacts, error) {
+	states, err := getAnalyticsRollupStates(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	timezone := "UTC"
+	if state, ok := states[analyticsRollupDaily]; ok && state.Timezone != "" {
+		timezone = state.Timezone
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	var closedUntil *time.Time
+	var rollupQuery string
+	var args []interface{}
+
+	if daily {
+		if state, ok := states[analyticsRollupDaily]; ok && state.ClosedUntil != nil {
+			closedUntil = state.ClosedUntil
+			rollupQuery = `
+				SELECT r.bucket_date::timestamp AS local_ts, r.showtime_id, r.booking_type,
+					r.total_bookings, r.total_tickets, r.total_revenue
+				FROM analytics_daily_rollups r
+				WHERE r.bucket_date < (?::timestamptz AT TIME ZONE ?)::date
+			`
+			args = append(args, *closedUntil, timezone)
+
+			if startDate != "" {
+				rollupQuery += " AND r.bucket_date >= (?::timestamptz AT TIME ZONE ?)::date"
+				args = append(args, startDate, timezone)
+			}
+
+			if endDate != "" {
+				rollupQuery += " AND r.bucket_date <= (?::timestamptz AT TIME ZONE ?)::date"
+				args = append(args, endDate, timezone)
+			}
+		}
+	} else {
+		if state, ok := states[analyticsRollupHourly]; ok && state.ClosedUntil != nil {
+			closedUntil = state.ClosedUntil
+			rollupQuery = `
+				SELECT r.bucket_start AT TIME ZONE ? AS local_ts, r.showtime_id, r.booking_type,
+					r.total_bookings, r.total_tickets, r.total_revenue
+				FROM analytics_hourly_rollups r
+				WHERE r.bucket_start < ?
+			`
+			args = append(args, timezone, *closedUntil)
+
+			if startDate != "" {
+				rollupQuery += " AND r.bucket_start >= ?"
+				args = append(args, startDate)
+			}
+
+			if endDate != "" {
+				rollupQuery += " AND r.bucket_start <= ?"
+				args = append(args, endDate)
+			}
+		}
+	}
+
+	liveQuery := `
+		SELECT b.created_at AT TIME ZONE ? AS local_ts, b.showtime_id, b.booking_type,
+			1 AS total_bookings, tc.tickets AS total_tickets, b.total_amount AS total_revenue
+		FROM bookings b
+		LEFT JOIN LATERAL (
+			SELECT COUNT(*) AS tickets FROM tickets t
+			WHERE t.booking_id = b.id AND t.status != ?
+		) tc ON TRUE
+		WHERE b.status = ?
+	`
+	liveArgs := []interface{}{timezone, models.TicketStatusCancelled, models.BookingStatusConfirmed}
+
+	if closedUntil != nil {
+		liveQuery += " AND b.created_at >= ?"
+		liveArgs = append(liveArgs, *closedUntil)
+	}
+
+	if startDate != "" {
+		liveQuery += " AND b.created_at >= ?"
+		liveArgs = append(liveArgs, startDate)
+	}
+
+	if endDate != "" {
+		liveQuery += " AND b.created_at <= ?"
+		liveArgs = append(liveArgs, endDate)
+	}
+
+	query := liveQuery
+	if rollupQuery != "" {
+		query = rollupQuery + " UNION ALL " + liveQuery
+	}
+
+	return &analyticsFacts{
+		query:    query,
+		args:     append(args, liveArgs...),
+		location: location,
+	}, nil
+}
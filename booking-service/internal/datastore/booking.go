@@ -84,12 +84,28 @@ func GetBookingById(ctx context.Context, db *bun.DB, id string) (*models.Booking
 	return booking, nil
 }
 
+func GetBookingByIdForUpdate(ctx context.Context, db bun.IDB, id string) (*models.Booking, error) {
+	booking := new(models.Booking)
+
+	err := db.NewSelect().
+		Model(booking).
+		Where("id = ?", id).
+		For("UPDATE").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get booking by id for update: %w", err)
+	}
+
+	return booking, nil
+}
+
 func GetBookingByIdWithTickets(ctx context.Context, db bun.IDB, id string) (*models.Booking, error) {
 	booking := new(models.Booking)
 
 	err := db.NewSelect().
 		Model(booking).
 		Relation("Ticket").
+		Relation("Concessions").
 		Where("id = ?", id).
 		Scan(ctx)
 	if err != nil {
@@ -128,10 +144,11 @@ func CreateBooking(ctx context.Context, db bun.IDB, booking *models.Booking) err
 	return nil
 }
 
-func UpdateBookingStatus(ctx context.Context, db *bun.DB, bookingId string, status models.BookingStatus) error {
+func UpdateBookingStatus(ctx context.Context, db bun.IDB, bookingId string, status models.BookingStatus) error {
 	_, err := db.NewUpdate().
 		Model((*models.Booking)(nil)).
 		Set("status = ?", status).
+		Set("updated_at = CURRENT_TIMESTAMP").
 		Where("id = ?", bookingId).
 		Exec(ctx)
 	if err != nil {
@@ -141,6 +158,21 @@ func UpdateBookingStatus(ctx context.Context, db *bun.DB, bookingId string, stat
 	return nil
 }
 
+func UpdateBookingShowtime(ctx context.Context, db bun.IDB, bookingId, showtimeId string, totalAmount float64) error {
+	_, err := db.NewUpdate().
+		Model((*models.Booking)(nil)).
+		Set("showtime_id = ?", showtimeId).
+		Set("total_amount = ?", totalAmount).
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("id = ?", bookingId).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update booking showtime: %w", err)
+	}
+
+	return nil
+}
+
 func GetBookingsByShowtimeId(ctx context.Context, db *bun.DB, showtimeId string, limit, offset int) ([]*models.Booking, error) {
 	bookings := make([]*models.Booking, 0)
 
@@ -169,3 +201,81 @@ func CountBookingsByShowtimeId(ctx context.Context, db *bun.DB, showtimeId strin
 
 	return count, nil
 }
+
+func CountBookingsByUserId(ctx context.Context, db bun.IDB, userId string) (int, error) {
+	count, err := db.NewSelect().
+		Model((*models.Booking)(nil)).
+		Where("user_id = ?", userId).
+		Where("status != ?", models.BookingStatusCancelled).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count user bookings: %w", err)
+	}
+
+	return count, nil
+}
+
+func CountPendingBookingsByUserId(ctx context.Context, db bun.IDB, userId string) (int, error) {
+	count, err := db.NewSelect().
+		Model((*models.Booking)(nil)).
+		Where("user_id = ?", userId).
+		Where("status = ?", models.BookingStatusPending).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count pending user bookings: %w", err)
+	}
+
+	return count, nil
+}
+
+// LockUserBookings serializes booking creation of one user until the
+// transaction ends, so per-user limits can be checked without races.
+func LockUserBookings(ctx context.Context, tx bun.Tx, userId string) error {
+	_, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext(?))", "booking_user:"+userId)
+	if err != nil {
+		return fmt.Errorf("failed to lock user bookings: %w", err)
+	}
+
+	return nil
+}
+
+// ExpireBookingPayment expires the booking's pending payment so a late
+// webhook can no longer complete it. It reports whether the payment was
+// already completed.
+func ExpireBookingPayment(ctx context.Context, db bun.IDB, bookingId string) (bool, error) {
+	_, err := db.ExecContext(ctx, `
+		UPDATE payments SET status = 'EXPIRED', updated_at = NOW()
+		WHERE booking_id = ? AND status = 'PENDING'
+	`, bookingId)
+	if err != nil {
+		return false, fmt.Errorf("failed to expire booking payment: %w", err)
+	}
+
+	completed, err := db.NewSelect().
+		TableExpr("payments").
+		Where("booking_id = ?", bookingId).
+		Where("status = 'COMPLETED'").
+		Exists(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check booking payment: %w", err)
+	}
+
+	return completed, nil
+}
+
+// GetBookingGiftCardAmount returns the gift card amount held on the booking's
+// unpaid payment, or 0 when the booking has no such payment.
+func GetBookingGiftCardAmount(ctx context.Context, db bun.IDB, bookingId string) (float64, error) {
+	var amount float64
+
+	err := db.NewRaw(`
+		SELECT COALESCE(SUM(gift_card_amount), 0)
+		FROM payments
+		WHERE booking_id = ? AND status <> 'COMPLETED'
+	`, bookingId).Scan(ctx, &amount)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get booking gift card amount: %w", err)
+	}
+
+	return amount, nil
+}
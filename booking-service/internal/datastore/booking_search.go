@@ -0,0 +1,133 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"booking-service/internal/models"
+
+	"github.com/uptrace/bun"
+)
+
+const (
+	BookingSortCreatedAt   = "created_at"
+	BookingSortTotalAmount = "total_amount"
+)
+
+// BookingSearchFilter narrows the admin booking search. Results are ordered
+// by SortBy then id and continue after the cursor fields when they are set.
+type BookingSearchFilter struct {
+	Email       string
+	UserId      string
+	MovieId     string
+	ShowtimeId  string
+	Status      models.BookingStatus
+	BookingType models.BookingType
+	From        *time.Time
+	To          *time.Time
+	MinAmount   *float64
+	MaxAmount   *float64
+
+	SortBy    string
+	Ascending bool
+	Limit     int
+
+	AfterCreatedAt *time.Time
+	AfterAmount    *float64
+	AfterId        string
+}
+
+func SearchBookings(ctx context.Context, db bun.IDB, filter *BookingSearchFilter) ([]*models.Booking, error) {
+	bookings := make([]*models.Booking, 0)
+
+	query := db.NewSelect().
+		Model(&bookings).
+		Limit(filter.Limit)
+
+	if filter.Email != "" {
+		query = query.
+			Join("INNER JOIN users AS u ON u.id = b.user_id").
+			Where("u.email ILIKE ?", "%"+filter.Email+"%")
+	}
+	if filter.MovieId != "" {
+		query = query.
+			Join("INNER JOIN showtimes AS st ON st.id = b.showtime_id").
+			Where("st.movie_id = ?", filter.MovieId)
+	}
+	if filter.UserId != "" {
+		query = query.Where("b.user_id = ?", filter.UserId)
+	}
+	if filter.ShowtimeId != "" {
+		query = query.Where("b.showtime_id = ?", filter.ShowtimeId)
+	}
+	if filter.Status != "" {
+		query = query.Where("b.status = ?", filter.Status)
+	}
+	if filter.BookingType != "" {
+		query = query.Where("b.booking_type = ?", filter.BookingType)
+	}
+	if filter.From != nil {
+		query = query.Where("b.created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("b.created_at <= ?", *filter.To)
+	}
+	if filter.MinAmount != nil {
+		query = query.Where("b.total_amount >= ?", *filter.MinAmount)
+	}
+	if filter.MaxAmount != nil {
+		query = query.Where("b.total_amount <= ?", *filter.MaxAmount)
+	}
+
+	direction, comparator := "DESC", "<"
+	if filter.Ascending {
+		direction, comparator = "ASC", ">"
+	}
+
+	sortColumn := "b.created_at"
+	if filter.SortBy == BookingSortTotalAmount {
+		sortColumn = "b.total_amount"
+		if filter.AfterAmount != nil {
+			query = query.Where(fmt.Sprintf("(b.total_amount, b.id) %s (?, ?)", comparator), *filter.AfterAmount, filter.AfterId)
+		}
+	} else if filter.AfterCreatedAt != nil {
+		query = query.Where(fmt.Sprintf("(b.created_at, b.id) %s (?, ?)", comparator), *filter.AfterCreatedAt, filter.AfterId)
+	}
+
+	query = query.
+		OrderExpr(fmt.Sprintf("%s %s", sortColumn, direction)).
+		OrderExpr(fmt.Sprintf("b.id %s", direction))
+
+	if err := query.Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to search bookings: %w", err)
+	}
+
+	return bookings, nil
+}
+
+func CreateBookingAuditLog(ctx context.Context, db bun.IDB, log *models.BookingAuditLog) error {
+	_, err := db.NewInsert().
+		Model(log).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create booking audit log: %w", err)
+	}
+
+	return nil
+}
+
+func GetBookingAuditLogs(ctx context.Context, db bun.IDB, bookingId string) ([]*models.BookingAuditLog, error) {
+	logs := make([]*models.BookingAuditLog, 0)
+
+	err := db.NewSelect().
+		Model(&logs).
+		Where("booking_id = ?", bookingId).
+		Order("created_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get booking audit logs: %w", err)
+	}
+
+	return logs, nil
+}
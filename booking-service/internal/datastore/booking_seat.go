@@ -0,0 +1,164 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"booking-service/internal/models"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+)
+
+const pgUniqueViolation = "23505"
+
+var ErrBookingSeatTaken = errors.New("seat is already taken by an active booking")
+
+func CreateBookingSeats(ctx context.Context, db bun.IDB, seats []*models.BookingSeat) error {
+	if len(seats) == 0 {
+		return nil
+	}
+
+	_, err := db.NewInsert().
+		Model(&seats).
+		Exec(ctx)
+	if err != nil {
+		var pgErr pgdriver.Error
+		if errors.As(err, &pgErr) && pgErr.Field('C') == pgUniqueViolation {
+			return fmt.Errorf("failed to create booking seats: %w", ErrBookingSeatTaken)
+		}
+		return fmt.Errorf("failed to create booking seats: %w", err)
+	}
+
+	return nil
+}
+
+func GetSeatIdsByBookingId(ctx context.Context, db bun.IDB, bookingId string) ([]string, error) {
+	seatIds := make([]string, 0)
+
+	err := db.NewSelect().
+		Model((*models.BookingSeat)(nil)).
+		Column("seat_id").
+		Where("booking_id = ?", bookingId).
+		Order("created_at ASC").
+		Scan(ctx, &seatIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get booking seats: %w", err)
+	}
+
+	return seatIds, nil
+}
+
+// GetActiveBookingSeats returns the seats a booking currently holds, with the
+// ticket category each was sold for.
+func GetActiveBookingSeats(ctx context.Context, db bun.IDB, bookingId string) ([]*models.BookingSeat, error) {
+	seats := make([]*models.BookingSeat, 0)
+
+	err := db.NewSelect().
+		Model(&seats).
+		Where("booking_id = ?", bookingId).
+		Where("status = ?", models.BookingSeatStatusActive).
+		Order("created_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get booking seats: %w", err)
+	}
+
+	return seats, nil
+}
+
+func ReleaseBookingSeats(ctx context.Context, db bun.IDB, bookingId string) error {
+	_, err := db.NewUpdate().
+		Model((*models.BookingSeat)(nil)).
+		Set("status = ?", models.BookingSeatStatusReleased).
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("booking_id = ?", bookingId).
+		Where("status = ?", models.BookingSeatStatusActive).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to release booking seats: %w", err)
+	}
+
+	return nil
+}
+
+func GetBookedSeatsForShowtime(ctx context.Context, db bun.IDB, showtimeId string) (map[string]string, error) {
+	var results []struct {
+		SeatId    string `bun:"seat_id"`
+		BookingId string `bun:"booking_id"`
+	}
+
+	err := db.NewSelect().
+		Model((*models.BookingSeat)(nil)).
+		Column("bs.seat_id", "bs.booking_id").
+		Where("bs.showtime_id = ?", showtimeId).
+		Where("bs.status = ?", models.BookingSeatStatusActive).
+		Scan(ctx, &results)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get booked seats: %w", err)
+	}
+
+	bookedSeats := make(map[string]string)
+	for _, r := range results {
+		bookedSeats[r.SeatId] = r.BookingId
+	}
+
+	return bookedSeats, nil
+}
+
+// CountUserSeatsForShowtime counts the seats a user holds in active bookings
+// of a showtime.
+func CountUserSeatsForShowtime(ctx context.Context, db bun.IDB, userId, showtimeId string) (int, error) {
+	count, err := db.NewSelect().
+		Model((*models.BookingSeat)(nil)).
+		Join("INNER JOIN bookings b ON b.id = bs.booking_id").
+		Where("bs.showtime_id = ?", showtimeId).
+		Where("bs.status = ?", models.BookingSeatStatusActive).
+		Where("b.user_id = ?", userId).
+		Where("b.status IN (?)", bun.In([]models.BookingStatus{models.BookingStatusPending, models.BookingStatusConfirmed})).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count user seats for showtime: %w", err)
+	}
+
+	return count, nil
+}
+
+// ActiveBookingSeat is a seat held by a pending or confirmed booking of a
+// showtime that has not ended yet.
+type ActiveBookingSeat struct {
+	ShowtimeId       string               `bun:"showtime_id"`
+	SeatId           string               `bun:"seat_id"`
+	BookingId        string               `bun:"booking_id"`
+	UserId           string               `bun:"user_id"`
+	BookingStatus    models.BookingStatus `bun:"booking_status"`
+	BookingCreatedAt time.Time            `bun:"booking_created_at"`
+	ShowtimeEndsAt   time.Time            `bun:"showtime_ends_at"`
+}
+
+// GetActiveBookingSeatsOfUpcomingShowtimes returns every seat held by a
+// pending or confirmed booking whose showtime has not ended.
+func GetActiveBookingSeatsOfUpcomingShowtimes(ctx context.Context, db bun.IDB) ([]*ActiveBookingSeat, error) {
+	seats := make([]*ActiveBookingSeat, 0)
+
+	err := db.NewSelect().
+		Model((*models.BookingSeat)(nil)).
+		Column("bs.showtime_id", "bs.seat_id", "bs.booking_id").
+		ColumnExpr("b.user_id").
+		ColumnExpr("b.status AS booking_status").
+		ColumnExpr("b.created_at AS booking_created_at").
+		ColumnExpr("st.end_time AS showtime_ends_at").
+		Join("INNER JOIN bookings b ON b.id = bs.booking_id").
+		Join("INNER JOIN showtimes st ON st.id = bs.showtime_id").
+		Where("bs.status = ?", models.BookingSeatStatusActive).
+		Where("b.status IN (?)", bun.In([]models.BookingStatus{models.BookingStatusPending, models.BookingStatusConfirmed})).
+		Where("st.end_time > CURRENT_TIMESTAMP").
+		Scan(ctx, &seats)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active booking seats: %w", err)
+	}
+
+	return seats, nil
+}
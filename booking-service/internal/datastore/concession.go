@@ -0,0 +1,277 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+
+	"booking-service/internal/models"
+
+	"github.com/uptrace/bun"
+)
+
+func CreateConcessionItem(ctx context.Context, db bun.IDB, item *models.ConcessionItem) error {
+	_, err := db.NewInsert().
+		Model(item).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create concession item: %w", err)
+	}
+
+	if len(item.Components) == 0 {
+		return nil
+	}
+
+	_, err = db.NewInsert().
+		Model(&item.Components).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create concession combo items: %w", err)
+	}
+
+	return nil
+}
+
+func UpdateConcessionItem(ctx context.Context, db bun.IDB, item *models.ConcessionItem) (bool, error) {
+	result, err := db.NewUpdate().
+		Model(item).
+		Column("name", "description", "price", "is_active").
+		Set("updated_at = CURRENT_TIMESTAMP").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to update concession item: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// ReplaceConcessionComboItems swaps the components of a combo for the given
+// ones.
+func ReplaceConcessionComboItems(ctx context.Context, db bun.IDB, comboId string, components []*models.ConcessionComboItem) error {
+	_, err := db.NewDelete().
+		Model((*models.ConcessionComboItem)(nil)).
+		Where("combo_id = ?", comboId).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete concession combo items: %w", err)
+	}
+
+	if len(components) == 0 {
+		return nil
+	}
+
+	_, err = db.NewInsert().
+		Model(&components).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create concession combo items: %w", err)
+	}
+
+	return nil
+}
+
+// GetConcessionItems returns the catalog with each item's stock, only that of
+// cinemaId if given.
+func GetConcessionItems(ctx context.Context, db bun.IDB, activeOnly bool, cinemaId string) ([]*models.ConcessionItem, error) {
+	items := make([]*models.ConcessionItem, 0)
+
+	query := db.NewSelect().
+		Model(&items).
+		Relation("Components").
+		Relation("Stocks", func(q *bun.SelectQuery) *bun.SelectQuery {
+			if cinemaId != "" {
+				q = q.Where("cs.cinema_id = ?", cinemaId)
+			}
+			return q.Order("cs.cinema_id ASC")
+		}).
+		Order("category ASC", "name ASC")
+	if activeOnly {
+		query = query.Where("ci.is_active = ?", true)
+	}
+
+	if err := query.Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get concession items: %w", err)
+	}
+
+	return items, nil
+}
+
+func GetConcessionItemById(ctx context.Context, db bun.IDB, id string) (*models.ConcessionItem, error) {
+	item := new(models.ConcessionItem)
+
+	err := db.NewSelect().
+		Model(item).
+		Relation("Components").
+		Relation("Stocks", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Order("cs.cinema_id ASC")
+		}).
+		Where("ci.id = ?", id).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get concession item: %w", err)
+	}
+
+	return item, nil
+}
+
+func GetConcessionItemsByIds(ctx context.Context, db bun.IDB, ids []string) ([]*models.ConcessionItem, error) {
+	items := make([]*models.ConcessionItem, 0)
+	if len(ids) == 0 {
+		return items, nil
+	}
+
+	err := db.NewSelect().
+		Model(&items).
+		Relation("Components").
+		Where("ci.id IN (?)", bun.In(ids)).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get concession items: %w", err)
+	}
+
+	return items, nil
+}
+
+// AddConcessionStock adds quantity to a cinema's stock of an item, starting
+// it at quantity if the cinema has none yet.
+func AddConcessionStock(ctx context.Context, db bun.IDB, itemId, cinemaId string, quantity int) error {
+	_, err := db.NewInsert().
+		Model(&models.ConcessionStock{ItemId: itemId, CinemaId: cinemaId, Stock: quantity}).
+		On("CONFLICT (item_id, cinema_id) DO UPDATE").
+		Set("stock = cs.stock + EXCLUDED.stock").
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to add concession stock: %w", err)
+	}
+
+	return nil
+}
+
+// TakeConcessionStock takes quantity from a cinema's stock of an item unless
+// it has less than that left, which it reports as false. The check and the
+// update are one statement, so concurrent takers cannot oversell the item.
+func TakeConcessionStock(ctx context.Context, db bun.IDB, itemId, cinemaId string, quantity int) (bool, error) {
+	result, err := db.NewUpdate().
+		Model((*models.ConcessionStock)(nil)).
+		Set("stock = stock - ?", quantity).
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("item_id = ?", itemId).
+		Where("cinema_id = ?", cinemaId).
+		Where("stock >= ?", quantity).
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to take concession stock: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// GetConcessionStocks returns a cinema's stock of the given items by item id.
+// Items the cinema has never stocked are left out.
+func GetConcessionStocks(ctx context.Context, db bun.IDB, cinemaId string, itemIds []string) (map[string]int, error) {
+	stocks := make([]*models.ConcessionStock, 0)
+	if len(itemIds) > 0 {
+		err := db.NewSelect().
+			Model(&stocks).
+			Where("cinema_id = ?", cinemaId).
+			Where("item_id IN (?)", bun.In(itemIds)).
+			Scan(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get concession stocks: %w", err)
+		}
+	}
+
+	stockMap := make(map[string]int, len(stocks))
+	for _, stock := range stocks {
+		stockMap[stock.ItemId] = stock.Stock
+	}
+
+	return stockMap, nil
+}
+
+func CreateBookingConcessions(ctx context.Context, db bun.IDB, concessions []*models.BookingConcession) error {
+	if len(concessions) == 0 {
+		return nil
+	}
+
+	_, err := db.NewInsert().
+		Model(&concessions).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create booking concessions: %w", err)
+	}
+
+	return nil
+}
+
+// GetBookingConcessions returns the concessions of a booking, only those in
+// the given statuses if any are given.
+func GetBookingConcessions(ctx context.Context, db bun.IDB, bookingId string, statuses ...models.BookingConcessionStatus) ([]*models.BookingConcession, error) {
+	concessions := make([]*models.BookingConcession, 0)
+
+	query := db.NewSelect().
+		Model(&concessions).
+		Where("booking_id = ?", bookingId).
+		Order("created_at ASC")
+	if len(statuses) > 0 {
+		query = query.Where("status IN (?)", bun.In(statuses))
+	}
+
+	if err := query.Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get booking concessions: %w", err)
+	}
+
+	return concessions, nil
+}
+
+func UpdateBookingConcessionStatus(ctx context.Context, db bun.IDB, bookingId string, status models.BookingConcessionStatus, fromStatuses ...models.BookingConcessionStatus) error {
+	_, err := db.NewUpdate().
+		Model((*models.BookingConcession)(nil)).
+		Set("status = ?", status).
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("booking_id = ?", bookingId).
+		Where("status IN (?)", bun.In(fromStatuses)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update booking concession status: %w", err)
+	}
+
+	return nil
+}
+
+// CollectBookingConcessions flips the CONFIRMED concessions of a booking, or
+// only those in concessionIds if given, to COLLECTED and returns them.
+// Concurrent pickups hand each one over at most once.
+func CollectBookingConcessions(ctx context.Context, db bun.IDB, bookingId string, concessionIds []string, staffId string) ([]*models.BookingConcession, error) {
+	collected := make([]*models.BookingConcession, 0)
+
+	query := db.NewUpdate().
+		Model((*models.BookingConcession)(nil)).
+		Set("status = ?", models.BookingConcessionStatusCollected).
+		Set("collected_at = CURRENT_TIMESTAMP").
+		Set("collected_by = ?", staffId).
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("booking_id = ?", bookingId).
+		Where("status = ?", models.BookingConcessionStatusConfirmed)
+	if len(concessionIds) > 0 {
+		query = query.Where("id IN (?)", bun.In(concessionIds))
+	}
+
+	_, err := query.Returning("*").Exec(ctx, &collected)
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect booking concessions: %w", err)
+	}
+
+	return collected, nil
+}
@@ -0,0 +1,142 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+
+	"booking-service/internal/models"
+
+	"github.com/uptrace/bun"
+)
+
+func GetLoyaltyTiers(ctx context.Context, db bun.IDB) ([]*models.LoyaltyTier, error) {
+	var tiers []*models.LoyaltyTier
+
+	err := db.NewSelect().
+		Model(&tiers).
+		Order("min_lifetime_points ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get loyalty tiers: %w", err)
+	}
+
+	return tiers, nil
+}
+
+func UpdateLoyaltyTier(ctx context.Context, db bun.IDB, tier *models.LoyaltyTier) (bool, error) {
+	result, err := db.NewUpdate().
+		Model(tier).
+		Column("min_lifetime_points", "earn_rate").
+		Set("updated_at = CURRENT_TIMESTAMP").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to update loyalty tier: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func GetLoyaltyAccount(ctx context.Context, db bun.IDB, userId string) (*models.LoyaltyAccount, error) {
+	account := new(models.LoyaltyAccount)
+
+	err := db.NewSelect().
+		Model(account).
+		Where("user_id = ?", userId).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get loyalty account: %w", err)
+	}
+
+	return account, nil
+}
+
+// GetOrCreateLoyaltyAccountForUpdate returns the user's account locked for
+// update, opening it in the given tier first if the user has none.
+func GetOrCreateLoyaltyAccountForUpdate(ctx context.Context, db bun.IDB, userId, tier string) (*models.LoyaltyAccount, error) {
+	account := &models.LoyaltyAccount{
+		UserId: userId,
+		Tier:   tier,
+	}
+
+	_, err := db.NewInsert().
+		Model(account).
+		On("CONFLICT (user_id) DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create loyalty account: %w", err)
+	}
+
+	err = db.NewSelect().
+		Model(account).
+		Where("user_id = ?", userId).
+		For("UPDATE").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get loyalty account: %w", err)
+	}
+
+	return account, nil
+}
+
+func UpdateLoyaltyAccount(ctx context.Context, db bun.IDB, account *models.LoyaltyAccount) error {
+	_, err := db.NewUpdate().
+		Model(account).
+		Column("balance", "lifetime_points", "tier").
+		Set("updated_at = CURRENT_TIMESTAMP").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update loyalty account: %w", err)
+	}
+
+	return nil
+}
+
+func CreateLoyaltyTransaction(ctx context.Context, db bun.IDB, transaction *models.LoyaltyTransaction) error {
+	_, err := db.NewInsert().
+		Model(transaction).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create loyalty transaction: %w", err)
+	}
+
+	return nil
+}
+
+func GetLoyaltyTransactionsByBookingId(ctx context.Context, db bun.IDB, bookingId string) ([]*models.LoyaltyTransaction, error) {
+	var transactions []*models.LoyaltyTransaction
+
+	err := db.NewSelect().
+		Model(&transactions).
+		Where("booking_id = ?", bookingId).
+		Order("created_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get loyalty transactions by booking id: %w", err)
+	}
+
+	return transactions, nil
+}
+
+func GetLoyaltyTransactionsByUserId(ctx context.Context, db bun.IDB, userId string, limit, offset int) ([]*models.LoyaltyTransaction, int, error) {
+	var transactions []*models.LoyaltyTransaction
+
+	total, err := db.NewSelect().
+		Model(&transactions).
+		Where("user_id = ?", userId).
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get loyalty transactions: %w", err)
+	}
+
+	return transactions, total, nil
+}
@@ -0,0 +1,167 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"booking-service/internal/models"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+)
+
+var ErrPromotionCodeTaken = errors.New("promotion code already exists")
+
+func CreatePromotion(ctx context.Context, db bun.IDB, promotion *models.Promotion) error {
+	_, err := db.NewInsert().
+		Model(promotion).
+		Exec(ctx)
+	if err != nil {
+		var pgErr pgdriver.Error
+		if errors.As(err, &pgErr) && pgErr.Field('C') == pgUniqueViolation {
+			return fmt.Errorf("failed to create promotion: %w", ErrPromotionCodeTaken)
+		}
+		return fmt.Errorf("failed to create promotion: %w", err)
+	}
+
+	return nil
+}
+
+func GetPromotions(ctx context.Context, db bun.IDB, activeOnly bool, limit, offset int) ([]*models.Promotion, int, error) {
+	var promotions []*models.Promotion
+
+	query := db.NewSelect().
+		Model(&promotions).
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset)
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+
+	total, err := query.ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get promotions: %w", err)
+	}
+
+	return promotions, total, nil
+}
+
+func GetPromotionByCode(ctx context.Context, db bun.IDB, code string) (*models.Promotion, error) {
+	promotion := new(models.Promotion)
+
+	err := db.NewSelect().
+		Model(promotion).
+		Where("code = ?", code).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get promotion by code: %w", err)
+	}
+
+	return promotion, nil
+}
+
+func GetPromotionByCodeForUpdate(ctx context.Context, db bun.IDB, code string) (*models.Promotion, error) {
+	promotion := new(models.Promotion)
+
+	err := db.NewSelect().
+		Model(promotion).
+		Where("code = ?", code).
+		For("UPDATE").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get promotion by code: %w", err)
+	}
+
+	return promotion, nil
+}
+
+func DeactivatePromotion(ctx context.Context, db bun.IDB, promotionId string) (bool, error) {
+	result, err := db.NewUpdate().
+		Model((*models.Promotion)(nil)).
+		Set("is_active = ?", false).
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("id = ?", promotionId).
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to deactivate promotion: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func CountActiveRedemptionsByUser(ctx context.Context, db bun.IDB, promotionId, userId string) (int, error) {
+	count, err := db.NewSelect().
+		Model((*models.PromotionRedemption)(nil)).
+		Where("promotion_id = ?", promotionId).
+		Where("user_id = ?", userId).
+		Where("status = ?", models.PromotionRedemptionStatusActive).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count promotion redemptions: %w", err)
+	}
+
+	return count, nil
+}
+
+// RedeemPromotion records a redemption and bumps the promotion's usage count.
+// Callers must hold the promotion row lock from GetPromotionByCodeForUpdate.
+func RedeemPromotion(ctx context.Context, db bun.IDB, redemption *models.PromotionRedemption) error {
+	_, err := db.NewInsert().
+		Model(redemption).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create promotion redemption: %w", err)
+	}
+
+	_, err = db.NewUpdate().
+		Model((*models.Promotion)(nil)).
+		Set("usage_count = usage_count + 1").
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("id = ?", redemption.PromotionId).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update promotion usage: %w", err)
+	}
+
+	return nil
+}
+
+// ReleasePromotionRedemption gives a cancelled booking's promo code use back.
+func ReleasePromotionRedemption(ctx context.Context, db bun.IDB, bookingId string) error {
+	var promotionIds []string
+
+	err := db.NewUpdate().
+		Model((*models.PromotionRedemption)(nil)).
+		Set("status = ?", models.PromotionRedemptionStatusReleased).
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("booking_id = ?", bookingId).
+		Where("status = ?", models.PromotionRedemptionStatusActive).
+		Returning("promotion_id").
+		Scan(ctx, &promotionIds)
+	if err != nil {
+		return fmt.Errorf("failed to release promotion redemption: %w", err)
+	}
+
+	if len(promotionIds) == 0 {
+		return nil
+	}
+
+	_, err = db.NewUpdate().
+		Model((*models.Promotion)(nil)).
+		Set("usage_count = GREATEST(usage_count - 1, 0)").
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("id IN (?)", bun.In(promotionIds)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update promotion usage: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"booking-service/internal/models"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+)
+
+var ErrStaffSessionAlreadyOpen = errors.New("staff member already has an open session")
+
+type StaffSessionPaymentTotal struct {
+	PaymentMethod string  `bun:"payment_method" json:"payment_method"`
+	Transactions  int     `bun:"transactions" json:"transactions"`
+	Amount        float64 `bun:"amount" json:"amount"`
+}
+
+type StaffSessionBookingTotal struct {
+	Status        string  `bun:"status" json:"status"`
+	TotalBookings int     `bun:"total_bookings" json:"total_bookings"`
+	TotalAmount   float64 `bun:"total_amount" json:"total_amount"`
+}
+
+func CreateStaffSession(ctx context.Context, db bun.IDB, session *models.StaffSession) error {
+	_, err := db.NewInsert().
+		Model(session).
+		Exec(ctx)
+	if err != nil {
+		var pgErr pgdriver.Error
+		if errors.As(err, &pgErr) && pgErr.Field('C') == pgUniqueViolation {
+			return fmt.Errorf("failed to create staff session: %w", ErrStaffSessionAlreadyOpen)
+		}
+		return fmt.Errorf("failed to create staff session: %w", err)
+	}
+
+	return nil
+}
+
+func GetStaffSessionById(ctx context.Context, db bun.IDB, id string) (*models.StaffSession, error) {
+	session := new(models.StaffSession)
+
+	err := db.NewSelect().
+		Model(session).
+		Where("id = ?", id).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get staff session by id: %w", err)
+	}
+
+	return session, nil
+}
+
+func GetStaffSessionByIdForUpdate(ctx context.Context, db bun.IDB, id string) (*models.StaffSession, error) {
+	session := new(models.StaffSession)
+
+	err := db.NewSelect().
+		Model(session).
+		Where("id = ?", id).
+		For("UPDATE").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get staff session by id for update: %w", err)
+	}
+
+	return session, nil
+}
+
+func GetOpenStaffSessionByStaffId(ctx context.Context, db bun.IDB, staffId string) (*models.StaffSession, error) {
+	session := new(models.StaffSession)
+
+	err := db.NewSelect().
+		Model(session).
+		Where("staff_id = ?", staffId).
+		Where("status = ?", models.StaffSessionStatusOpen).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open staff session: %w", err)
+	}
+
+	return session, nil
+}
+
+// GetOpenStaffSessionByStaffIdForShare is used when recording a sale so the
+// session cannot be closed until the sale's transaction ends.
+func GetOpenStaffSessionByStaffIdForShare(ctx context.Context, db bun.IDB, staffId string) (*models.StaffSession, error) {
+	session := new(models.StaffSession)
+
+	err := db.NewSelect().
+		Model(session).
+		Where("staff_id = ?", staffId).
+		Where("status = ?", models.StaffSessionStatusOpen).
+		For("SHARE").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open staff session: %w", err)
+	}
+
+	return session, nil
+}
+
+func GetStaffSessions(ctx context.Context, db bun.IDB, staffId, status string, limit, offset int) ([]*models.StaffSession, int, error) {
+	var sessions []*models.StaffSession
+
+	query := db.NewSelect().
+		Model(&sessions).
+		Order("opened_at DESC").
+		Limit(limit).
+		Offset(offset)
+	if staffId != "" {
+		query = query.Where("staff_id = ?", staffId)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	total, err := query.ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get staff sessions: %w", err)
+	}
+
+	return sessions, total, nil
+}
+
+func CloseStaffSession(ctx context.Context, db bun.IDB, session *models.StaffSession) error {
+	_, err := db.NewUpdate().
+		Model(session).
+		Column("status", "expected_cash", "counted_cash", "variance", "note", "closed_by", "closed_at").
+		Set("updated_at = CURRENT_TIMESTAMP").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to close staff session: %w", err)
+	}
+
+	return nil
+}
+
+// GetStaffSessionPaymentTotals sums the completed payments and adjustments
+// taken in a session by payment method. A payment without its own session
+// belongs to the session of its booking; refunds count negative. The part of
+// a payment paid from gift cards is left out, as no money changed hands.
+func GetStaffSessionPaymentTotals(ctx context.Context, db bun.IDB, sessionId string) ([]*StaffSessionPaymentTotal, error) {
+	var totals []*StaffSessionPaymentTotal
+
+	err := db.NewRaw(`
+		SELECT payment_method, SUM(transactions) AS transactions, SUM(amount) AS amount
+		FROM (
+			SELECT p.payment_method, COUNT(*) AS transactions, SUM(p.amount - p.gift_card_amount) AS amount
+			FROM payments p
+			INNER JOIN bookings b ON b.id = p.booking_id
+			WHERE p.status = 'COMPLETED'
+			  AND COALESCE(p.staff_session_id, b.staff_session_id) = ?
+			GROUP BY p.payment_method
+			UNION ALL
+			SELECT pa.payment_method, COUNT(*) AS transactions,
+				SUM(CASE WHEN pa.type = 'REFUND' THEN -pa.amount ELSE pa.amount END) AS amount
+			FROM payment_adjustments pa
+			WHERE pa.status = 'COMPLETED'
+			  AND pa.staff_session_id = ?
+			GROUP BY pa.payment_method
+		) totals
+		GROUP BY payment_method
+		ORDER BY payment_method
+	`, sessionId, sessionId).Scan(ctx, &totals)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get staff session payment totals: %w", err)
+	}
+
+	return totals, nil
+}
+
+func GetStaffSessionBookingTotals(ctx context.Context, db bun.IDB, sessionId string) ([]*StaffSessionBookingTotal, error) {
+	var totals []*StaffSessionBookingTotal
+
+	err := db.NewSelect().
+		Model((*models.Booking)(nil)).
+		ColumnExpr("status").
+		ColumnExpr("COUNT(*) AS total_bookings").
+		ColumnExpr("SUM(total_amount) AS total_amount").
+		Where("staff_session_id = ?", sessionId).
+		Group("status").
+		Order("status").
+		Scan(ctx, &totals)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get staff session booking totals: %w", err)
+	}
+
+	return totals, nil
+}
@@ -38,43 +38,24 @@ func GetTicketsByBookingId(ctx context.Context, db bun.IDB, bookingId string) ([
 	return tickets, nil
 }
 
-func UpdateTicketStatus(ctx context.Context, db bun.IDB, ticketId string, status models.TicketStatus) error {
-	_, err := db.NewUpdate().
+func CancelTicketsByBookingId(ctx context.Context, db bun.IDB, bookingId string) (int, error) {
+	result, err := db.NewUpdate().
 		Model((*models.Ticket)(nil)).
-		Set("status = ?", status).
+		Set("status = ?", models.TicketStatusCancelled).
 		Set("updated_at = CURRENT_TIMESTAMP").
-		Where("id = ?", ticketId).
+		Where("booking_id = ?", bookingId).
+		Where("status != ?", models.TicketStatusCancelled).
 		Exec(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to update ticket status: %w", err)
-	}
-
-	return nil
-}
-
-func GetBookedSeatsForShowtime(ctx context.Context, db bun.IDB, showtimeId string) (map[string]string, error) {
-	var results []struct {
-		SeatId    string `bun:"seat_id"`
-		BookingId string `bun:"booking_id"`
+		return 0, fmt.Errorf("failed to cancel tickets: %w", err)
 	}
 
-	err := db.NewSelect().
-		TableExpr("tickets t").
-		Column("t.seat_id", "t.booking_id").
-		Join("INNER JOIN bookings b ON b.id = t.booking_id").
-		Where("b.showtime_id = ?", showtimeId).
-		Where("b.status IN (?, ?)", models.BookingStatusPending, models.BookingStatusConfirmed).
-		Scan(ctx, &results)
+	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get booked seats: %w", err)
-	}
-
-	bookedSeats := make(map[string]string)
-	for _, r := range results {
-		bookedSeats[r.SeatId] = r.BookingId
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
 	}
 
-	return bookedSeats, nil
+	return int(rowsAffected), nil
 }
 
 func GetTicketById(ctx context.Context, db bun.IDB, ticketId string) (*models.Ticket, error) {
@@ -107,3 +88,28 @@ func GetTicketsByShowtimeId(ctx context.Context, db bun.IDB, showtimeId string)
 
 	return tickets, nil
 }
+
+// MarkTicketUsed flips an UNUSED ticket to USED and records who admitted it.
+// It reports false when the ticket was not UNUSED, so concurrent scans of the
+// same ticket admit at most once.
+func MarkTicketUsed(ctx context.Context, db bun.IDB, ticketId, staffId string) (bool, error) {
+	result, err := db.NewUpdate().
+		Model((*models.Ticket)(nil)).
+		Set("status = ?", models.TicketStatusUsed).
+		Set("used_at = CURRENT_TIMESTAMP").
+		Set("used_by = ?", staffId).
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("id = ?", ticketId).
+		Where("status = ?", models.TicketStatusUnused).
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark ticket used: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
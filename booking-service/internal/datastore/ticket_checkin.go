@@ -0,0 +1,96 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+
+	"booking-service/internal/models"
+
+	"github.com/uptrace/bun"
+)
+
+func CreateTicketCheckin(ctx context.Context, db bun.IDB, checkin *models.TicketCheckin) error {
+	_, err := db.NewInsert().
+		Model(checkin).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create ticket checkin: %w", err)
+	}
+
+	return nil
+}
+
+func GetCheckinsByTicketId(ctx context.Context, db bun.IDB, ticketId string) ([]*models.TicketCheckin, error) {
+	var checkins []*models.TicketCheckin
+
+	err := db.NewSelect().
+		Model(&checkins).
+		Where("ticket_id = ?", ticketId).
+		Order("created_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket checkins: %w", err)
+	}
+
+	return checkins, nil
+}
+
+func GetCheckinsByShowtimeIdAndResult(ctx context.Context, db bun.IDB, showtimeId, result string) ([]*models.TicketCheckin, error) {
+	var checkins []*models.TicketCheckin
+
+	err := db.NewSelect().
+		Model(&checkins).
+		Where("showtime_id = ?", showtimeId).
+		Where("result = ?", result).
+		Order("created_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get showtime checkins: %w", err)
+	}
+
+	return checkins, nil
+}
+
+type CheckinResultCount struct {
+	Result string `bun:"result"`
+	Count  int    `bun:"count"`
+}
+
+func CountCheckinsByResult(ctx context.Context, db bun.IDB, showtimeId string) ([]*CheckinResultCount, error) {
+	var counts []*CheckinResultCount
+
+	err := db.NewSelect().
+		Model((*models.TicketCheckin)(nil)).
+		ColumnExpr("result").
+		ColumnExpr("COUNT(*) AS count").
+		Where("showtime_id = ?", showtimeId).
+		Group("result").
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count showtime checkins: %w", err)
+	}
+
+	return counts, nil
+}
+
+type ShowtimeTicketCounts struct {
+	Sold     int `bun:"sold"`
+	Admitted int `bun:"admitted"`
+}
+
+func GetShowtimeTicketCounts(ctx context.Context, db bun.IDB, showtimeId string) (*ShowtimeTicketCounts, error) {
+	counts := new(ShowtimeTicketCounts)
+
+	err := db.NewSelect().
+		Model((*models.Ticket)(nil)).
+		ColumnExpr("COUNT(*) AS sold").
+		ColumnExpr("COUNT(*) FILTER (WHERE status = ?) AS admitted", models.TicketStatusUsed).
+		Where("showtime_id = ?", showtimeId).
+		Where("status != ?", models.TicketStatusCancelled).
+		Scan(ctx, counts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count showtime tickets: %w", err)
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,168 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"booking-service/internal/models"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+)
+
+var ErrWaitlistEntryExists = errors.New("user is already on the waitlist for this showtime")
+
+func CreateWaitlistEntry(ctx context.Context, db bun.IDB, entry *models.WaitlistEntry) error {
+	_, err := db.NewInsert().
+		Model(entry).
+		Exec(ctx)
+	if err != nil {
+		var pgErr pgdriver.Error
+		if errors.As(err, &pgErr) && pgErr.Field('C') == pgUniqueViolation {
+			return fmt.Errorf("failed to create waitlist entry: %w", ErrWaitlistEntryExists)
+		}
+		return fmt.Errorf("failed to create waitlist entry: %w", err)
+	}
+
+	return nil
+}
+
+func GetWaitlistEntryByIdForUpdate(ctx context.Context, db bun.IDB, id string) (*models.WaitlistEntry, error) {
+	entry := new(models.WaitlistEntry)
+
+	err := db.NewSelect().
+		Model(entry).
+		Where("id = ?", id).
+		For("UPDATE").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get waitlist entry by id for update: %w", err)
+	}
+
+	return entry, nil
+}
+
+func GetActiveWaitlistEntriesByUserId(ctx context.Context, db bun.IDB, userId string) ([]*models.WaitlistEntry, error) {
+	var entries []*models.WaitlistEntry
+
+	err := db.NewSelect().
+		Model(&entries).
+		Where("user_id = ?", userId).
+		Where("status IN (?, ?)", models.WaitlistStatusWaiting, models.WaitlistStatusOffered).
+		Order("created_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get waitlist entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+// CountWaitlistEntriesAhead counts WAITING entries of the same showtime that
+// joined before the given one.
+func CountWaitlistEntriesAhead(ctx context.Context, db bun.IDB, entry *models.WaitlistEntry) (int, error) {
+	count, err := db.NewSelect().
+		Model((*models.WaitlistEntry)(nil)).
+		Where("showtime_id = ?", entry.ShowtimeId).
+		Where("status = ?", models.WaitlistStatusWaiting).
+		Where("created_at < ?", entry.CreatedAt).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count waitlist entries ahead: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetWaitingEntriesForUpdate returns the head of a showtime's queue, skipping
+// entries another transaction is already offering seats to.
+func GetWaitingEntriesForUpdate(ctx context.Context, db bun.IDB, showtimeId string, limit int) ([]*models.WaitlistEntry, error) {
+	var entries []*models.WaitlistEntry
+
+	err := db.NewSelect().
+		Model(&entries).
+		Where("showtime_id = ?", showtimeId).
+		Where("status = ?", models.WaitlistStatusWaiting).
+		Order("created_at ASC").
+		Limit(limit).
+		For("UPDATE SKIP LOCKED").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get waiting entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+func GetExpiredWaitlistOffersForUpdate(ctx context.Context, db bun.IDB, now time.Time, limit int) ([]*models.WaitlistEntry, error) {
+	var entries []*models.WaitlistEntry
+
+	err := db.NewSelect().
+		Model(&entries).
+		Where("status = ?", models.WaitlistStatusOffered).
+		Where("offer_expires_at < ?", now).
+		Order("offer_expires_at ASC").
+		Limit(limit).
+		For("UPDATE SKIP LOCKED").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expired waitlist offers: %w", err)
+	}
+
+	return entries, nil
+}
+
+func UpdateWaitlistEntryOffer(ctx context.Context, db bun.IDB, entry *models.WaitlistEntry) error {
+	_, err := db.NewUpdate().
+		Model(entry).
+		Column("status", "hold_id", "offered_seat_ids", "offer_expires_at").
+		Set("updated_at = CURRENT_TIMESTAMP").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update waitlist entry offer: %w", err)
+	}
+
+	return nil
+}
+
+func UpdateWaitlistEntryStatus(ctx context.Context, db bun.IDB, id string, status models.WaitlistStatus) error {
+	_, err := db.NewUpdate().
+		Model((*models.WaitlistEntry)(nil)).
+		Set("status = ?", status).
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update waitlist entry status: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimWaitlistOffer marks the offer behind a seat hold as taken by a booking
+// and reports whether it could. The update locks the entry, so an offer being
+// claimed is skipped by expiry, and an offer that expired first is not claimed.
+func ClaimWaitlistOffer(ctx context.Context, db bun.IDB, holdId, bookingId string, now time.Time) (bool, error) {
+	result, err := db.NewUpdate().
+		Model((*models.WaitlistEntry)(nil)).
+		Set("status = ?", models.WaitlistStatusClaimed).
+		Set("booking_id = ?", bookingId).
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("hold_id = ?", holdId).
+		Where("status = ?", models.WaitlistStatusOffered).
+		Where("offer_expires_at >= ?", now).
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim waitlist offer: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected == 1, nil
+}
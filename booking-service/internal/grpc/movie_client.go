@@ -4,13 +4,20 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"booking-service/proto/pb"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
+// ErrInvalidTicketCategory is returned when a seat's ticket category does not
+// exist or does not apply to the seat.
+var ErrInvalidTicketCategory = fmt.Errorf("invalid ticket category")
+
 type MovieClient struct {
 	conn   *grpc.ClientConn
 	client pb.MovieServiceClient
@@ -70,13 +77,27 @@ func (c *MovieClient) GetShowtimes(ctx context.Context, showtimeIds []string) ([
 }
 
 func (c *MovieClient) GetSeatsWithPrice(ctx context.Context, showtimeId string, seatIds []string) (*pb.GetSeatsWithPriceResponse, error) {
+	return c.QuoteSeatPrices(ctx, showtimeId, seatIds, nil, "", 0)
+}
+
+// QuoteSeatPrices prices the seats, each for its ticket category in
+// categories, and keeps the prices valid for ttl under the returned quote id.
+// Passing a quote id returns its prices while it is valid and re-arms it for
+// ttl.
+func (c *MovieClient) QuoteSeatPrices(ctx context.Context, showtimeId string, seatIds []string, categories map[string]string, quoteId string, ttl time.Duration) (*pb.GetSeatsWithPriceResponse, error) {
 	req := &pb.GetSeatsWithPriceRequest{
-		ShowtimeId: showtimeId,
-		SeatIds:    seatIds,
+		ShowtimeId:      showtimeId,
+		SeatIds:         seatIds,
+		QuoteId:         quoteId,
+		QuoteTtlSeconds: int64(ttl.Seconds()),
+		SeatCategories:  categories,
 	}
 
 	resp, err := c.client.GetSeatsWithPrice(ctx, req)
 	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidTicketCategory, status.Convert(err).Message())
+		}
 		return nil, fmt.Errorf("failed to get seats with price: %w", err)
 	}
 
@@ -103,3 +124,21 @@ func (c *MovieClient) GetSeatDetails(ctx context.Context, seatIds []string) ([]*
 
 	return resp.Data, nil
 }
+
+func (c *MovieClient) GetShowtimeSeats(ctx context.Context, showtimeId string, seatRows []string) ([]*pb.SeatDetailData, error) {
+	req := &pb.GetShowtimeSeatsRequest{
+		ShowtimeId: showtimeId,
+		SeatRows:   seatRows,
+	}
+
+	resp, err := c.client.GetShowtimeSeats(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get showtime seats: %w", err)
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("movie service error: %s", resp.Message)
+	}
+
+	return resp.Data, nil
+}
@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"booking-service/proto/pb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type PaymentClient struct {
+	conn   *grpc.ClientConn
+	client pb.PaymentServiceClient
+}
+
+func NewPaymentClient() (*PaymentClient, error) {
+	paymentServiceUrl := os.Getenv("PAYMENT_SERVICE_GRPC_URL")
+	if paymentServiceUrl == "" {
+		paymentServiceUrl = "localhost:50086"
+	}
+
+	conn, err := grpc.NewClient(paymentServiceUrl, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to payment service: %w", err)
+	}
+
+	client := pb.NewPaymentServiceClient(conn)
+
+	return &PaymentClient{
+		conn:   conn,
+		client: client,
+	}, nil
+}
+
+func (c *PaymentClient) ForceCompletePayment(ctx context.Context, bookingId string, amount float64, reason string) (*pb.ForceCompletePaymentResponse, error) {
+	req := &pb.ForceCompletePaymentRequest{
+		BookingId: bookingId,
+		Amount:    amount,
+		Reason:    reason,
+	}
+
+	resp, err := c.client.ForceCompletePayment(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete payment: %w", err)
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("payment service error: %s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+// ReleaseGiftCards returns the gift card amounts held for an unpaid booking
+// to their cards.
+func (c *PaymentClient) ReleaseGiftCards(ctx context.Context, bookingId string) (float64, error) {
+	resp, err := c.client.ReleaseGiftCards(ctx, &pb.ReleaseGiftCardsRequest{BookingId: bookingId})
+	if err != nil {
+		return 0, fmt.Errorf("failed to release gift cards: %w", err)
+	}
+
+	if !resp.Success {
+		return 0, fmt.Errorf("payment service error: %s", resp.Message)
+	}
+
+	return resp.ReleasedAmount, nil
+}
+
+func (c *PaymentClient) Close() error {
+	if c.conn != nil {
+		return c.conn.Close()
+	}
+	return nil
+}
@@ -3,8 +3,10 @@ package grpc_server
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"booking-service/internal/datastore"
+	"booking-service/internal/models"
 	"booking-service/internal/services"
 	"booking-service/proto/pb"
 
@@ -37,7 +39,7 @@ func NewBookingServer(i *do.Injector) (*BookingServer, error) {
 }
 
 func (s *BookingServer) UpdateBookingStatus(ctx context.Context, req *pb.UpdateBookingStatusRequest) (*pb.UpdateBookingStatusResponse, error) {
-	userId, err := s.bookingService.UpdateBookingStatus(ctx, req.BookingId, req.Status)
+	userId, pointsEarned, err := s.bookingService.UpdateBookingStatus(ctx, req.BookingId, req.Status)
 	if err != nil {
 		return &pb.UpdateBookingStatusResponse{
 			Success:   false,
@@ -48,10 +50,11 @@ func (s *BookingServer) UpdateBookingStatus(ctx context.Context, req *pb.UpdateB
 	}
 
 	return &pb.UpdateBookingStatusResponse{
-		Success:   true,
-		Message:   "Booking status updated successfully",
-		UserId:    userId,
-		BookingId: req.BookingId,
+		Success:      true,
+		Message:      "Booking status updated successfully",
+		UserId:       userId,
+		BookingId:    req.BookingId,
+		PointsEarned: int32(pointsEarned),
 	}, nil
 }
 
@@ -95,15 +98,36 @@ func (s *BookingServer) CreateTickets(ctx context.Context, req *pb.CreateTickets
 	}, nil
 }
 
+func (s *BookingServer) CancelBooking(ctx context.Context, req *pb.CancelBookingRequest) (*pb.CancelBookingResponse, error) {
+	isStaff := req.UserId == ""
+	booking, err := s.bookingService.CancelBooking(ctx, req.BookingId, req.UserId, isStaff, req.Reason)
+	if err != nil {
+		return &pb.CancelBookingResponse{
+			Success:   false,
+			Message:   fmt.Sprintf("failed to cancel booking: %v", err),
+			BookingId: req.BookingId,
+		}, err
+	}
+
+	logrus.Infof("[gRPC] Cancelled booking %s", booking.Id)
+	return &pb.CancelBookingResponse{
+		Success:   true,
+		Message:   "Booking cancelled successfully",
+		BookingId: booking.Id,
+		UserId:    booking.UserId,
+		Status:    string(booking.Status),
+	}, nil
+}
+
 func (s *BookingServer) GetRevenueByTime(ctx context.Context, req *pb.GetRevenueByTimeRequest) (*pb.GetRevenueByTimeResponse, error) {
-	logrus.Infof("[gRPC] GetRevenueByTime called: start=%s, end=%s, limit=%d", req.StartDate, req.EndDate, req.Limit)
+	logrus.Infof("[gRPC] GetRevenueByTime called: start=%s, end=%s, group_by=%s, limit=%d", req.StartDate, req.EndDate, req.GroupBy, req.Limit)
 
 	limit := int(req.Limit)
 	if limit <= 0 {
 		limit = 100
 	}
 
-	results, err := datastore.GetRevenueByTime(ctx, s.db, req.StartDate, req.EndDate, limit)
+	results, err := datastore.GetRevenueByTime(ctx, s.db, req.StartDate, req.EndDate, req.GroupBy, limit)
 	if err != nil {
 		logrus.Errorf("[gRPC] Failed to get revenue by time: %v", err)
 		return &pb.GetRevenueByTimeResponse{
@@ -123,7 +147,7 @@ func (s *BookingServer) GetRevenueByTime(ctx context.Context, req *pb.GetRevenue
 		})
 	}
 
-	logrus.Infof("[gRPC] Successfully retrieved %d revenue records by time (grouped by day)", len(data))
+	logrus.Infof("[gRPC] Successfully retrieved %d revenue records by time", len(data))
 	return &pb.GetRevenueByTimeResponse{
 		Success: true,
 		Message: "Revenue by time retrieved successfully",
@@ -219,3 +243,234 @@ func (s *BookingServer) GetTotalRevenue(ctx context.Context, req *pb.GetTotalRev
 		TotalRevenue: total,
 	}, nil
 }
+
+func (s *BookingServer) GetOccupancy(ctx context.Context, req *pb.GetOccupancyRequest) (*pb.GetOccupancyResponse, error) {
+	logrus.Infof("[gRPC] GetOccupancy called: start=%s, end=%s, group_by=%s, limit=%d", req.StartDate, req.EndDate, req.GroupBy, req.Limit)
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 100
+	}
+
+	results, err := s.bookingService.GetOccupancy(ctx, req.StartDate, req.EndDate, req.GroupBy, limit)
+	if err != nil {
+		logrus.Errorf("[gRPC] Failed to get occupancy: %v", err)
+		return &pb.GetOccupancyResponse{
+			Success: false,
+			Message: fmt.Sprintf("failed to get occupancy: %v", err),
+			Data:    nil,
+		}, err
+	}
+
+	data := make([]*pb.Occupancy, 0, len(results))
+	for _, r := range results {
+		data = append(data, &pb.Occupancy{
+			Key:            r.Key,
+			Label:          r.Label,
+			TotalShowtimes: int32(r.TotalShowtimes),
+			TicketsSold:    int32(r.TicketsSold),
+			SeatCapacity:   int32(r.SeatCapacity),
+			OccupancyRate:  r.OccupancyRate,
+		})
+	}
+
+	logrus.Infof("[gRPC] Successfully retrieved %d occupancy records", len(data))
+	return &pb.GetOccupancyResponse{
+		Success: true,
+		Message: "Occupancy retrieved successfully",
+		Data:    data,
+	}, nil
+}
+
+func (s *BookingServer) GetRevenueByMovie(ctx context.Context, req *pb.GetRevenueByMovieRequest) (*pb.GetRevenueByMovieResponse, error) {
+	logrus.Infof("[gRPC] GetRevenueByMovie called: start=%s, end=%s, limit=%d", req.StartDate, req.EndDate, req.Limit)
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 100
+	}
+
+	results, err := datastore.GetRevenueByMovie(ctx, s.db, req.StartDate, req.EndDate, limit)
+	if err != nil {
+		logrus.Errorf("[gRPC] Failed to get revenue by movie: %v", err)
+		return &pb.GetRevenueByMovieResponse{
+			Success: false,
+			Message: fmt.Sprintf("failed to get revenue by movie: %v", err),
+			Data:    nil,
+		}, err
+	}
+
+	data := make([]*pb.RevenueByMovie, 0, len(results))
+	for _, r := range results {
+		data = append(data, &pb.RevenueByMovie{
+			MovieId:       r.MovieId,
+			MovieTitle:    r.MovieTitle,
+			TotalRevenue:  r.TotalRevenue,
+			TotalBookings: int32(r.TotalBookings),
+			TotalTickets:  int32(r.TotalTickets),
+		})
+	}
+
+	logrus.Infof("[gRPC] Successfully retrieved %d revenue records by movie", len(data))
+	return &pb.GetRevenueByMovieResponse{
+		Success: true,
+		Message: "Revenue by movie retrieved successfully",
+		Data:    data,
+	}, nil
+}
+
+func (s *BookingServer) GetSalesHeatmap(ctx context.Context, req *pb.GetSalesHeatmapRequest) (*pb.GetSalesHeatmapResponse, error) {
+	logrus.Infof("[gRPC] GetSalesHeatmap called: start=%s, end=%s", req.StartDate, req.EndDate)
+
+	results, err := datastore.GetSalesHeatmap(ctx, s.db, req.StartDate, req.EndDate)
+	if err != nil {
+		logrus.Errorf("[gRPC] Failed to get sales heatmap: %v", err)
+		return &pb.GetSalesHeatmapResponse{
+			Success: false,
+			Message: fmt.Sprintf("failed to get sales heatmap: %v", err),
+			Data:    nil,
+		}, err
+	}
+
+	data := make([]*pb.SalesHeatmapCell, 0, len(results))
+	for _, r := range results {
+		data = append(data, &pb.SalesHeatmapCell{
+			Weekday:       int32(r.Weekday),
+			Hour:          int32(r.Hour),
+			TotalBookings: int32(r.TotalBookings),
+			TotalTickets:  int32(r.TotalTickets),
+			TotalRevenue:  r.TotalRevenue,
+		})
+	}
+
+	logrus.Infof("[gRPC] Successfully retrieved %d sales heatmap cells", len(data))
+	return &pb.GetSalesHeatmapResponse{
+		Success: true,
+		Message: "Sales heatmap retrieved successfully",
+		Data:    data,
+	}, nil
+}
+
+func (s *BookingServer) GetBookingLeadTime(ctx context.Context, req *pb.GetBookingLeadTimeRequest) (*pb.GetBookingLeadTimeResponse, error) {
+	logrus.Infof("[gRPC] GetBookingLeadTime called: start=%s, end=%s, movie_id=%s", req.StartDate, req.EndDate, req.MovieId)
+
+	result, err := datastore.GetBookingLeadTime(ctx, s.db, req.StartDate, req.EndDate, req.MovieId)
+	if err != nil {
+		logrus.Errorf("[gRPC] Failed to get booking lead time: %v", err)
+		return &pb.GetBookingLeadTimeResponse{
+			Success: false,
+			Message: fmt.Sprintf("failed to get booking lead time: %v", err),
+		}, err
+	}
+
+	logrus.Infof("[gRPC] Successfully retrieved booking lead time over %d bookings", result.TotalBookings)
+	return &pb.GetBookingLeadTimeResponse{
+		Success:           true,
+		Message:           "Booking lead time retrieved successfully",
+		AvgLeadSeconds:    result.AvgLeadSeconds,
+		MedianLeadSeconds: result.MedianLeadSeconds,
+		TotalBookings:     int32(result.TotalBookings),
+	}, nil
+}
+
+func (s *BookingServer) ProcessWaitlist(ctx context.Context, req *pb.ProcessWaitlistRequest) (*pb.ProcessWaitlistResponse, error) {
+	offers, err := s.bookingService.ProcessWaitlist(ctx, req.ShowtimeId, req.SeatIds)
+	if err != nil {
+		logrus.Errorf("[gRPC] Failed to process waitlist for showtime %s: %v", req.ShowtimeId, err)
+		return &pb.ProcessWaitlistResponse{
+			Success: false,
+			Message: fmt.Sprintf("failed to process waitlist: %v", err),
+		}, err
+	}
+
+	data := make([]*pb.WaitlistOffer, 0, len(offers))
+	for _, offer := range offers {
+		item := &pb.WaitlistOffer{
+			EntryId:    offer.Id,
+			UserId:     offer.UserId,
+			ShowtimeId: offer.ShowtimeId,
+			HoldId:     offer.HoldId,
+			SeatIds:    offer.OfferedSeatIds,
+		}
+		if offer.OfferExpiresAt != nil {
+			item.ExpiresAt = offer.OfferExpiresAt.Unix()
+		}
+		data = append(data, item)
+	}
+
+	logrus.Infof("[gRPC] Made %d waitlist offers for showtime %s", len(data), req.ShowtimeId)
+	return &pb.ProcessWaitlistResponse{
+		Success: true,
+		Message: "Waitlist processed successfully",
+		Offers:  data,
+	}, nil
+}
+
+func (s *BookingServer) ExpireWaitlistOffers(ctx context.Context, req *pb.ExpireWaitlistOffersRequest) (*pb.ExpireWaitlistOffersResponse, error) {
+	expired, err := s.bookingService.ExpireWaitlistOffers(ctx, int(req.Limit))
+	if err != nil {
+		logrus.Errorf("[gRPC] Failed to expire waitlist offers: %v", err)
+		return &pb.ExpireWaitlistOffersResponse{
+			Success: false,
+			Message: fmt.Sprintf("failed to expire waitlist offers: %v", err),
+		}, err
+	}
+
+	return &pb.ExpireWaitlistOffersResponse{
+		Success: true,
+		Message: "Waitlist offers expired successfully",
+		Expired: int32(expired),
+	}, nil
+}
+
+func (s *BookingServer) ExpireSeatHolds(ctx context.Context, req *pb.ExpireSeatHoldsRequest) (*pb.ExpireSeatHoldsResponse, error) {
+	expired, err := s.bookingService.ExpireSeatHolds(ctx, int(req.Limit))
+	if err != nil {
+		logrus.Errorf("[gRPC] Failed to expire seat holds: %v", err)
+		return &pb.ExpireSeatHoldsResponse{
+			Success: false,
+			Message: fmt.Sprintf("failed to expire seat holds: %v", err),
+		}, err
+	}
+
+	return &pb.ExpireSeatHoldsResponse{
+		Success: true,
+		Message: "Seat holds expired successfully",
+		Expired: int32(expired),
+	}, nil
+}
+
+func (s *BookingServer) ClaimBookingSeats(ctx context.Context, req *pb.ClaimBookingSeatsRequest) (*pb.ClaimBookingSeatsResponse, error) {
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	claimed, err := s.bookingService.ClaimBookingSeats(ctx, req.ShowtimeId, req.BookingId, req.PrevOwner, req.SeatIds, models.SeatState(req.State), ttl)
+	if err != nil {
+		logrus.Errorf("[gRPC] Failed to claim seats for booking %s: %v", req.BookingId, err)
+		return &pb.ClaimBookingSeatsResponse{
+			Success: false,
+			Message: fmt.Sprintf("failed to claim booking seats: %v", err),
+		}, err
+	}
+
+	return &pb.ClaimBookingSeatsResponse{
+		Success:        true,
+		Message:        "Booking seats claimed successfully",
+		ClaimedSeatIds: claimed,
+	}, nil
+}
+
+func (s *BookingServer) ReleaseBookingSeats(ctx context.Context, req *pb.ReleaseBookingSeatsRequest) (*pb.ReleaseBookingSeatsResponse, error) {
+	released, err := s.bookingService.ReleaseBookingSeats(ctx, req.ShowtimeId, req.BookingId, req.SeatIds)
+	if err != nil {
+		logrus.Errorf("[gRPC] Failed to release seats for booking %s: %v", req.BookingId, err)
+		return &pb.ReleaseBookingSeatsResponse{
+			Success: false,
+			Message: fmt.Sprintf("failed to release booking seats: %v", err),
+		}, err
+	}
+
+	return &pb.ReleaseBookingSeatsResponse{
+		Success:         true,
+		Message:         "Booking seats released successfully",
+		ReleasedSeatIds: released,
+	}, nil
+}
@@ -8,6 +8,7 @@ import (
 	"booking-service/internal/models"
 	"booking-service/internal/pkg/response"
 	"booking-service/internal/services"
+	"booking-service/internal/types"
 
 	"github.com/labstack/echo/v4"
 	"github.com/samber/do"
@@ -17,6 +18,14 @@ type BookingHandler struct {
 	container *do.Injector
 }
 
+func isStaffRole(role string) bool {
+	return role == "ticket_staff" || role == "admin" || role == "manager_staff"
+}
+
+func isManagerRole(role string) bool {
+	return role == "admin" || role == "manager_staff"
+}
+
 func NewBookingHandler(i *do.Injector) (*BookingHandler, error) {
 	return &BookingHandler{
 		container: i,
@@ -77,10 +86,16 @@ func (h *BookingHandler) CreateBooking(c echo.Context) error {
 	}
 
 	var request struct {
-		ShowtimeId  string   `json:"showtime_id" validate:"required,uuid"`
-		SeatIds     []string `json:"seat_ids" validate:"required,dive,uuid"`
-		TotalAmount int      `json:"total_amount"`
-		BookingType string   `json:"booking_type"`
+		ShowtimeId   string   `json:"showtime_id" validate:"required_without=HoldId,omitempty,uuid"`
+		SeatIds      []string `json:"seat_ids" validate:"required_without=HoldId,dive,uuid"`
+		HoldId       string   `json:"hold_id" validate:"omitempty,uuid"`
+		TotalAmount  int      `json:"total_amount"`
+		BookingType  string   `json:"booking_type"`
+		PromoCode    string   `json:"promo_code"`
+		RedeemPoints int      `json:"redeem_points"`
+		// SeatCategories maps a seat id to its ticket category, e.g. CHILD.
+		SeatCategories map[string]string       `json:"seat_categories"`
+		Concessions    []types.ConcessionOrder `json:"concessions"`
 	}
 
 	if err = c.Bind(&request); err != nil {
@@ -98,24 +113,61 @@ func (h *BookingHandler) CreateBooking(c echo.Context) error {
 	}
 
 	if bookingType == "OFFLINE" {
-		userRole, ok := c.Get("userRole").(string)
-		if !ok || (userRole != "ticket_staff" && userRole != "admin" && userRole != "manager_staff") {
+		userRole, _ := c.Get("userRole").(string)
+		if !isStaffRole(userRole) {
 			return response.Forbidden(c, "Only ticket staff, managers, and admins can create box office bookings")
 		}
 	}
 
-	booking, err := bookingService.CreateBooking(c.Request().Context(), userId, request.ShowtimeId, request.SeatIds, request.TotalAmount, models.BookingType(bookingType))
+	var booking *models.Booking
+	if request.HoldId != "" {
+		booking, err = bookingService.CreateBookingFromHold(c.Request().Context(), userId, request.HoldId, request.SeatCategories, request.Concessions, request.TotalAmount, models.BookingType(bookingType), request.PromoCode, request.RedeemPoints)
+	} else {
+		booking, err = bookingService.CreateBooking(c.Request().Context(), userId, request.ShowtimeId, request.SeatIds, request.SeatCategories, request.Concessions, request.TotalAmount, models.BookingType(bookingType), request.PromoCode, request.RedeemPoints)
+	}
 	if err != nil {
 		if errors.Is(err, services.ErrInvalidBookingData) {
 			return response.BadRequest(c, "Invalid booking data")
 		}
 
-		if errors.Is(err, services.ErrSeatAlreadyBooked) {
-			return response.BadRequest(c, "Seat already booked")
+		if errors.Is(err, services.ErrSeatHoldNotFound) {
+			return response.NotFound(c, services.ErrSeatHoldNotFound)
+		}
+
+		if errors.Is(err, services.ErrSeatHoldAccessDenied) {
+			return response.Forbidden(c, "Seat hold does not belong to user")
+		}
+
+		if errors.Is(err, services.ErrSeatHoldExpired) {
+			return response.BadRequest(c, "Seat hold has expired")
+		}
+
+		if errors.Is(err, services.ErrInvalidTicketCategory) || isPromotionError(err) || isConcessionError(err) {
+			return response.BadRequest(c, err.Error())
+		}
+
+		if errors.Is(err, services.ErrInsufficientPoints) || errors.Is(err, services.ErrPointsRedemptionTooHigh) {
+			return response.BadRequest(c, err.Error())
+		}
+
+		if errors.Is(err, services.ErrStaffSessionRequired) {
+			return response.BadRequest(c, err.Error())
 		}
 
-		if errors.Is(err, services.ErrSeatAlreadyLocked) {
-			return response.BadRequest(c, "Seat is being processed")
+		var policyErr *services.BookingPolicyError
+		if errors.As(err, &policyErr) {
+			return bookingPolicyError(c, policyErr)
+		}
+
+		var conflictErr *services.SeatConflictError
+		if errors.As(err, &conflictErr) {
+			message := "Seat is being processed"
+			if errors.Is(err, services.ErrSeatAlreadyBooked) {
+				message = "Seat already booked"
+			}
+			return response.BadRequestWithData(c, message, map[string]interface{}{
+				"conflicting_seat_ids": conflictErr.SeatIds,
+			})
 		}
 
 		return response.ErrorWithMessage(c, fmt.Sprintf("Failed to create booking: %s", err.Error()))
@@ -124,6 +176,18 @@ func (h *BookingHandler) CreateBooking(c echo.Context) error {
 	return response.SuccessWithMessage(c, "Booking created successfully", booking)
 }
 
+// bookingPolicyError reports which limit or seat rule a booking broke.
+func bookingPolicyError(c echo.Context, err *services.BookingPolicyError) error {
+	data := map[string]interface{}{}
+	if err.Limit > 0 {
+		data["limit"] = err.Limit
+	}
+	if len(err.SeatIds) > 0 {
+		data["seat_ids"] = err.SeatIds
+	}
+	return response.BadRequestWithData(c, err.Err.Error(), data)
+}
+
 func (h *BookingHandler) GetBookingByID(c echo.Context) error {
 	bookingService, err := do.Invoke[*services.BookingService](h.container)
 	if err != nil {
@@ -135,7 +199,10 @@ func (h *BookingHandler) GetBookingByID(c echo.Context) error {
 		return response.BadRequest(c, "Booking ID is required")
 	}
 
-	booking, err := bookingService.GetBookingByID(c.Request().Context(), bookingId)
+	userId, _ := c.Get("user_id").(string)
+	userRole, _ := c.Get("userRole").(string)
+
+	booking, err := bookingService.GetBookingByID(c.Request().Context(), bookingId, userId, isStaffRole(userRole))
 	if err != nil {
 		if errors.Is(err, services.ErrBookingNotFound) {
 			return response.NotFound(c, services.ErrBookingNotFound)
@@ -146,6 +213,123 @@ func (h *BookingHandler) GetBookingByID(c echo.Context) error {
 	return response.SuccessWithMessage(c, "Booking fetched successfully", booking)
 }
 
+func (h *BookingHandler) CancelBooking(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	bookingId := c.Param("id")
+	if bookingId == "" {
+		return response.BadRequest(c, "Booking ID is required")
+	}
+
+	var request struct {
+		Reason string `json:"reason"`
+	}
+	if err = c.Bind(&request); err != nil {
+		return response.BadRequest(c, "Invalid request data")
+	}
+
+	userId := c.Get("user_id").(string)
+	if userId == "" {
+		return response.Unauthorized(c, "User ID not found in token")
+	}
+
+	userRole, _ := c.Get("userRole").(string)
+
+	booking, err := bookingService.CancelBooking(c.Request().Context(), bookingId, userId, isStaffRole(userRole), request.Reason)
+	if err != nil {
+		if errors.Is(err, services.ErrBookingNotFound) {
+			return response.NotFound(c, services.ErrBookingNotFound)
+		}
+
+		if errors.Is(err, services.ErrBookingAccessDenied) {
+			return response.Forbidden(c, "You are not allowed to cancel this booking")
+		}
+
+		if errors.Is(err, services.ErrBookingAlreadyCancelled) {
+			return response.BadRequest(c, "Booking is already cancelled")
+		}
+
+		if errors.Is(err, services.ErrBookingNotCancellable) {
+			return response.BadRequest(c, "Only pending bookings can be cancelled")
+		}
+
+		if errors.Is(err, services.ErrBookingAlreadyPaid) {
+			return response.Conflict(c, "Booking has already been paid")
+		}
+
+		return response.ErrorWithMessage(c, fmt.Sprintf("Failed to cancel booking: %s", err.Error()))
+	}
+
+	return response.SuccessWithMessage(c, "Booking cancelled successfully", booking)
+}
+
+func (h *BookingHandler) ExchangeBooking(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	userRole, _ := c.Get("userRole").(string)
+	if !isStaffRole(userRole) {
+		return response.Forbidden(c, "Only staff can exchange bookings")
+	}
+
+	bookingId := c.Param("id")
+	if bookingId == "" {
+		return response.BadRequest(c, "Booking ID is required")
+	}
+
+	var request struct {
+		ShowtimeId string   `json:"showtime_id"`
+		SeatIds    []string `json:"seat_ids"`
+		// SeatCategories maps a new seat id to its ticket category, e.g. CHILD.
+		SeatCategories map[string]string `json:"seat_categories"`
+	}
+	if err = c.Bind(&request); err != nil {
+		return response.BadRequest(c, "Invalid request data")
+	}
+
+	if request.ShowtimeId == "" || len(request.SeatIds) == 0 {
+		return response.BadRequest(c, "Showtime ID and seat IDs are required")
+	}
+
+	staffId, _ := c.Get("user_id").(string)
+
+	result, err := bookingService.ExchangeBooking(c.Request().Context(), bookingId, staffId, request.ShowtimeId, request.SeatIds, request.SeatCategories)
+	if err != nil {
+		if errors.Is(err, services.ErrBookingNotFound) {
+			return response.NotFound(c, services.ErrBookingNotFound)
+		}
+
+		var conflictErr *services.SeatConflictError
+		if errors.As(err, &conflictErr) {
+			message := "Seat is being processed"
+			if errors.Is(err, services.ErrSeatAlreadyBooked) {
+				message = "Seat already booked"
+			}
+			return response.BadRequestWithData(c, message, map[string]interface{}{
+				"conflicting_seat_ids": conflictErr.SeatIds,
+			})
+		}
+
+		if errors.Is(err, services.ErrBookingNotExchangeable) ||
+			errors.Is(err, services.ErrExchangeCutoffPassed) ||
+			errors.Is(err, services.ErrExchangeInvalidShowtime) ||
+			errors.Is(err, services.ErrExchangeCategoriesUnset) ||
+			errors.Is(err, services.ErrInvalidTicketCategory) ||
+			errors.Is(err, services.ErrInvalidBookingData) {
+			return response.BadRequest(c, err.Error())
+		}
+
+		return response.ErrorWithMessage(c, fmt.Sprintf("Failed to exchange booking: %s", err.Error()))
+	}
+
+	return response.SuccessWithMessage(c, "Booking exchanged successfully", result)
+}
+
 func (h *BookingHandler) SearchTickets(c echo.Context) error {
 	bookingService, err := do.Invoke[*services.BookingService](h.container)
 	if err != nil {
@@ -177,18 +361,115 @@ func (h *BookingHandler) MarkTicketAsUsed(c echo.Context) error {
 		return response.InternalServerError(c, "Failed to get booking service")
 	}
 
+	userRole, _ := c.Get("userRole").(string)
+	if !isStaffRole(userRole) {
+		return response.Forbidden(c, "Only staff can mark tickets as used")
+	}
+
 	ticketId := c.Param("id")
 	if ticketId == "" {
 		return response.BadRequest(c, "Ticket ID is required")
 	}
 
-	err = bookingService.MarkTicketAsUsed(c.Request().Context(), ticketId)
+	staffId, _ := c.Get("user_id").(string)
+
+	err = bookingService.MarkTicketAsUsed(c.Request().Context(), ticketId, staffId)
 	if err != nil {
 		if errors.Is(err, services.ErrTicketNotFound) {
 			return response.NotFound(c, services.ErrTicketNotFound)
 		}
+		if errors.Is(err, services.ErrTicketCancelled) {
+			return response.BadRequest(c, "Ticket has been cancelled")
+		}
+		if errors.Is(err, services.ErrTicketAlreadyUsed) {
+			return response.BadRequest(c, "Ticket has already been used")
+		}
 		return response.ErrorWithMessage(c, fmt.Sprintf("Failed to mark ticket as used: %s", err.Error()))
 	}
 
 	return response.SuccessWithMessage(c, "Ticket marked as used successfully", nil)
 }
+
+func (h *BookingHandler) ScanTicket(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	userRole, _ := c.Get("userRole").(string)
+	if !isStaffRole(userRole) {
+		return response.Forbidden(c, "Only staff can scan tickets")
+	}
+
+	var request struct {
+		Token    string `json:"token"`
+		RoomId   string `json:"room_id"`
+		DeviceId string `json:"device_id"`
+	}
+	if err = c.Bind(&request); err != nil {
+		return response.BadRequest(c, "Invalid request data")
+	}
+
+	if request.Token == "" || request.RoomId == "" {
+		return response.BadRequest(c, "Token and room ID are required")
+	}
+
+	staffId, _ := c.Get("user_id").(string)
+
+	result, err := bookingService.ScanTicket(c.Request().Context(), request.Token, request.RoomId, staffId, request.DeviceId)
+	if err != nil {
+		return response.ErrorWithMessage(c, fmt.Sprintf("Failed to scan ticket: %s", err.Error()))
+	}
+
+	return response.SuccessWithMessage(c, result.Message, result)
+}
+
+func (h *BookingHandler) GetTicketCheckins(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	userRole, _ := c.Get("userRole").(string)
+	if !isStaffRole(userRole) {
+		return response.Forbidden(c, "Only staff can view ticket check-ins")
+	}
+
+	ticketId := c.Param("id")
+	if ticketId == "" {
+		return response.BadRequest(c, "Ticket ID is required")
+	}
+
+	checkins, err := bookingService.GetTicketCheckins(c.Request().Context(), ticketId)
+	if err != nil {
+		return response.ErrorWithMessage(c, fmt.Sprintf("Failed to get ticket check-ins: %s", err.Error()))
+	}
+
+	return response.SuccessWithMessage(c, "Ticket check-ins fetched successfully", map[string]interface{}{
+		"check_ins": checkins,
+	})
+}
+
+func (h *BookingHandler) GetCheckinReport(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	userRole, _ := c.Get("userRole").(string)
+	if !isManagerRole(userRole) {
+		return response.Forbidden(c, "Only managers can view check-in reports")
+	}
+
+	showtimeId := c.QueryParam("showtime_id")
+	if showtimeId == "" {
+		return response.BadRequest(c, "Showtime ID is required")
+	}
+
+	report, err := bookingService.GetCheckinReport(c.Request().Context(), showtimeId)
+	if err != nil {
+		return response.ErrorWithMessage(c, fmt.Sprintf("Failed to get check-in report: %s", err.Error()))
+	}
+
+	return response.SuccessWithMessage(c, "Check-in report fetched successfully", report)
+}
@@ -0,0 +1,215 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"booking-service/internal/pkg/response"
+	"booking-service/internal/services"
+	"booking-service/internal/types"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+func isAdminRole(role string) bool {
+	return role == "admin"
+}
+
+// parseSearchTime accepts either a date or an RFC 3339 timestamp. A bare
+// date used as the upper bound covers the whole day.
+func parseSearchTime(value string, endOfDay bool) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, nil
+	}
+
+	t, err := time.ParseInLocation("2006-01-02", value, time.Local)
+	if err != nil {
+		return nil, err
+	}
+	if endOfDay {
+		t = t.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+	return &t, nil
+}
+
+func parseSearchAmount(value string) (*float64, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &amount, nil
+}
+
+func (h *BookingHandler) SearchBookings(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	userRole, _ := c.Get("userRole").(string)
+	if !isAdminRole(userRole) {
+		return response.Forbidden(c, "Only admins can search bookings")
+	}
+
+	query := &types.BookingSearchQuery{
+		Email:       c.QueryParam("email"),
+		UserId:      c.QueryParam("user_id"),
+		MovieId:     c.QueryParam("movie_id"),
+		ShowtimeId:  c.QueryParam("showtime_id"),
+		Status:      c.QueryParam("status"),
+		BookingType: c.QueryParam("booking_type"),
+		SortBy:      c.QueryParam("sort_by"),
+		SortOrder:   c.QueryParam("order"),
+		Cursor:      c.QueryParam("cursor"),
+	}
+
+	if query.From, err = parseSearchTime(c.QueryParam("from"), false); err != nil {
+		return response.BadRequest(c, "Invalid from date")
+	}
+	if query.To, err = parseSearchTime(c.QueryParam("to"), true); err != nil {
+		return response.BadRequest(c, "Invalid to date")
+	}
+	if query.MinAmount, err = parseSearchAmount(c.QueryParam("min_amount")); err != nil {
+		return response.BadRequest(c, "Invalid min_amount")
+	}
+	if query.MaxAmount, err = parseSearchAmount(c.QueryParam("max_amount")); err != nil {
+		return response.BadRequest(c, "Invalid max_amount")
+	}
+	if size := c.QueryParam("size"); size != "" {
+		if query.Size, err = strconv.Atoi(size); err != nil {
+			return response.BadRequest(c, "Invalid size")
+		}
+	}
+
+	result, err := bookingService.SearchBookings(c.Request().Context(), query)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidSearchCursor) || errors.Is(err, services.ErrInvalidSearchQuery) {
+			return response.BadRequest(c, err.Error())
+		}
+		return response.ErrorWithMessage(c, fmt.Sprintf("Failed to search bookings: %s", err.Error()))
+	}
+
+	return response.SuccessWithMessage(c, "Bookings fetched successfully", result)
+}
+
+func (h *BookingHandler) ForceCancelBooking(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	userRole, _ := c.Get("userRole").(string)
+	if !isAdminRole(userRole) {
+		return response.Forbidden(c, "Only admins can force-cancel bookings")
+	}
+
+	bookingId := c.Param("id")
+	if bookingId == "" {
+		return response.BadRequest(c, "Booking ID is required")
+	}
+
+	var request struct {
+		Reason string `json:"reason"`
+	}
+	if err = c.Bind(&request); err != nil {
+		return response.BadRequest(c, "Invalid request data")
+	}
+
+	userId := c.Get("user_id").(string)
+	if userId == "" {
+		return response.Unauthorized(c, "User ID not found in token")
+	}
+
+	booking, err := bookingService.ForceCancelBooking(c.Request().Context(), bookingId, userId, request.Reason)
+	if err != nil {
+		return h.bookingAdminError(c, err, "Failed to cancel booking")
+	}
+
+	return response.SuccessWithMessage(c, "Booking cancelled successfully", booking)
+}
+
+func (h *BookingHandler) ForceConfirmBooking(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	userRole, _ := c.Get("userRole").(string)
+	if !isAdminRole(userRole) {
+		return response.Forbidden(c, "Only admins can force-confirm bookings")
+	}
+
+	bookingId := c.Param("id")
+	if bookingId == "" {
+		return response.BadRequest(c, "Booking ID is required")
+	}
+
+	var request struct {
+		Reason string `json:"reason"`
+	}
+	if err = c.Bind(&request); err != nil {
+		return response.BadRequest(c, "Invalid request data")
+	}
+
+	userId := c.Get("user_id").(string)
+	if userId == "" {
+		return response.Unauthorized(c, "User ID not found in token")
+	}
+
+	booking, err := bookingService.ForceConfirmBooking(c.Request().Context(), bookingId, userId, request.Reason)
+	if err != nil {
+		return h.bookingAdminError(c, err, "Failed to confirm booking")
+	}
+
+	return response.SuccessWithMessage(c, "Payment completed, booking is being confirmed", booking)
+}
+
+func (h *BookingHandler) GetBookingAuditLogs(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	userRole, _ := c.Get("userRole").(string)
+	if !isAdminRole(userRole) {
+		return response.Forbidden(c, "Only admins can view the booking audit trail")
+	}
+
+	bookingId := c.Param("id")
+	if bookingId == "" {
+		return response.BadRequest(c, "Booking ID is required")
+	}
+
+	logs, err := bookingService.GetBookingAuditLogs(c.Request().Context(), bookingId)
+	if err != nil {
+		return response.ErrorWithMessage(c, "Failed to get booking audit trail")
+	}
+
+	return response.Success(c, logs)
+}
+
+func (h *BookingHandler) bookingAdminError(c echo.Context, err error, message string) error {
+	switch {
+	case errors.Is(err, services.ErrBookingNotFound):
+		return response.NotFound(c, services.ErrBookingNotFound)
+	case errors.Is(err, services.ErrAuditReasonRequired):
+		return response.BadRequest(c, err.Error())
+	case errors.Is(err, services.ErrBookingAlreadyCancelled),
+		errors.Is(err, services.ErrBookingAlreadyPaid),
+		errors.Is(err, services.ErrBookingNotConfirmable):
+		return response.Conflict(c, err.Error())
+	}
+
+	return response.ErrorWithMessage(c, fmt.Sprintf("%s: %s", message, err.Error()))
+}
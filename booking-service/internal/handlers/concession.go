@@ -0,0 +1,231 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"booking-service/internal/models"
+	"booking-service/internal/pkg/response"
+	"booking-service/internal/services"
+	"booking-service/internal/types"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+// isConcessionError reports whether err is a concession order rejection that
+// the client can act on.
+func isConcessionError(err error) bool {
+	return errors.Is(err, services.ErrInvalidConcession) ||
+		errors.Is(err, services.ErrConcessionNotFound) ||
+		errors.Is(err, services.ErrConcessionUnavailable) ||
+		errors.Is(err, services.ErrConcessionOutOfStock)
+}
+
+type concessionComponentRequest struct {
+	ItemId   string `json:"item_id"`
+	Quantity int    `json:"quantity"`
+}
+
+func toConcessionComponents(components []concessionComponentRequest) []*models.ConcessionComboItem {
+	if components == nil {
+		return nil
+	}
+
+	comboItems := make([]*models.ConcessionComboItem, 0, len(components))
+	for _, component := range components {
+		comboItems = append(comboItems, &models.ConcessionComboItem{
+			ItemId:   component.ItemId,
+			Quantity: component.Quantity,
+		})
+	}
+
+	return comboItems
+}
+
+func (h *BookingHandler) GetConcessionItems(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	var query struct {
+		IncludeInactive bool   `query:"include_inactive"`
+		CinemaId        string `query:"cinema_id"`
+	}
+	if err = c.Bind(&query); err != nil {
+		return response.BadRequest(c, fmt.Sprintf("Invalid query parameters: %s", err.Error()))
+	}
+
+	// Only managers see items taken off sale.
+	userRole, _ := c.Get("userRole").(string)
+	activeOnly := !query.IncludeInactive || !isManagerRole(userRole)
+
+	items, err := bookingService.GetConcessionItems(c.Request().Context(), activeOnly, query.CinemaId)
+	if err != nil {
+		return response.ErrorWithMessage(c, "Failed to get concession items")
+	}
+
+	return response.SuccessWithMessage(c, "Concession items fetched successfully", map[string]interface{}{
+		"items": items,
+	})
+}
+
+func (h *BookingHandler) CreateConcessionItem(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	userRole, _ := c.Get("userRole").(string)
+	if !isManagerRole(userRole) {
+		return response.Forbidden(c, "Only managers and admins can create concession items")
+	}
+
+	var request struct {
+		Name        string                       `json:"name"`
+		Description string                       `json:"description"`
+		Category    string                       `json:"category"`
+		Price       float64                      `json:"price"`
+		Components  []concessionComponentRequest `json:"components"`
+	}
+	if err = c.Bind(&request); err != nil {
+		return response.BadRequest(c, "Invalid request data")
+	}
+
+	item, err := bookingService.CreateConcessionItem(c.Request().Context(), &models.ConcessionItem{
+		Name:        request.Name,
+		Description: request.Description,
+		Category:    models.ConcessionCategory(strings.ToUpper(request.Category)),
+		Price:       request.Price,
+		Components:  toConcessionComponents(request.Components),
+	})
+	if err != nil {
+		if isConcessionError(err) {
+			return response.BadRequest(c, err.Error())
+		}
+		return response.ErrorWithMessage(c, fmt.Sprintf("Failed to create concession item: %s", err.Error()))
+	}
+
+	return response.SuccessWithMessage(c, "Concession item created successfully", item)
+}
+
+func (h *BookingHandler) UpdateConcessionItem(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	userRole, _ := c.Get("userRole").(string)
+	if !isManagerRole(userRole) {
+		return response.Forbidden(c, "Only managers and admins can update concession items")
+	}
+
+	itemId := c.Param("id")
+	if itemId == "" {
+		return response.BadRequest(c, "Concession item ID is required")
+	}
+
+	var request struct {
+		Name        *string                      `json:"name"`
+		Description *string                      `json:"description"`
+		Price       *float64                     `json:"price"`
+		IsActive    *bool                        `json:"is_active"`
+		Components  []concessionComponentRequest `json:"components"`
+	}
+	if err = c.Bind(&request); err != nil {
+		return response.BadRequest(c, "Invalid request data")
+	}
+
+	item, err := bookingService.UpdateConcessionItem(c.Request().Context(), itemId, &types.ConcessionItemUpdate{
+		Name:        request.Name,
+		Description: request.Description,
+		Price:       request.Price,
+		IsActive:    request.IsActive,
+		Components:  toConcessionComponents(request.Components),
+	})
+	if err != nil {
+		if errors.Is(err, services.ErrConcessionNotFound) {
+			return response.NotFound(c, services.ErrConcessionNotFound)
+		}
+		if isConcessionError(err) {
+			return response.BadRequest(c, err.Error())
+		}
+		return response.ErrorWithMessage(c, fmt.Sprintf("Failed to update concession item: %s", err.Error()))
+	}
+
+	return response.SuccessWithMessage(c, "Concession item updated successfully", item)
+}
+
+func (h *BookingHandler) AdjustConcessionStock(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	userRole, _ := c.Get("userRole").(string)
+	if !isManagerRole(userRole) {
+		return response.Forbidden(c, "Only managers and admins can adjust concession stock")
+	}
+
+	itemId := c.Param("id")
+	if itemId == "" {
+		return response.BadRequest(c, "Concession item ID is required")
+	}
+
+	var request struct {
+		CinemaId string `json:"cinema_id"`
+		Delta    int    `json:"delta"`
+	}
+	if err = c.Bind(&request); err != nil {
+		return response.BadRequest(c, "Invalid request data")
+	}
+
+	item, err := bookingService.AdjustConcessionStock(c.Request().Context(), itemId, request.CinemaId, request.Delta)
+	if err != nil {
+		if errors.Is(err, services.ErrConcessionNotFound) {
+			return response.NotFound(c, services.ErrConcessionNotFound)
+		}
+		if isConcessionError(err) {
+			return response.BadRequest(c, err.Error())
+		}
+		return response.ErrorWithMessage(c, fmt.Sprintf("Failed to adjust concession stock: %s", err.Error()))
+	}
+
+	return response.SuccessWithMessage(c, "Concession stock adjusted successfully", item)
+}
+
+func (h *BookingHandler) CollectConcessions(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	userRole, _ := c.Get("userRole").(string)
+	if !isStaffRole(userRole) {
+		return response.Forbidden(c, "Only staff can hand over concessions")
+	}
+
+	var request struct {
+		Token         string   `json:"token"`
+		BookingId     string   `json:"booking_id"`
+		ConcessionIds []string `json:"concession_ids"`
+	}
+	if err = c.Bind(&request); err != nil {
+		return response.BadRequest(c, "Invalid request data")
+	}
+
+	if request.Token == "" && request.BookingId == "" {
+		return response.BadRequest(c, "Token or booking ID is required")
+	}
+
+	staffId, _ := c.Get("user_id").(string)
+
+	result, err := bookingService.CollectConcessions(c.Request().Context(), request.Token, request.BookingId, staffId, request.ConcessionIds)
+	if err != nil {
+		return response.ErrorWithMessage(c, fmt.Sprintf("Failed to collect concessions: %s", err.Error()))
+	}
+
+	return response.SuccessWithMessage(c, result.Message, result)
+}
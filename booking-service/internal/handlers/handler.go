@@ -2,14 +2,18 @@ package handlers
 
 import (
 	"net/http"
+	"time"
 
 	"booking-service/internal/grpc"
 	internalMiddleware "booking-service/internal/middleware"
 	"booking-service/internal/pkg/caching"
+	"booking-service/internal/pkg/idempotency"
+	"booking-service/internal/utils/env"
 
 	"github.com/labstack/echo-contrib/pprof"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/redis/go-redis/v9"
 	"github.com/samber/do"
 )
 
@@ -35,7 +39,8 @@ func New(cfg *Config) (http.Handler, error) {
 	r.Use(middleware.Recover())
 	r.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins:     cfg.Origins,
-		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization},
+		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization, idempotency.HeaderKey},
+		ExposeHeaders:    []string{idempotency.HeaderReplayed},
 		AllowCredentials: true,
 		MaxAge:           60 * 60,
 	}))
@@ -57,16 +62,83 @@ func New(cfg *Config) (http.Handler, error) {
 			return nil, err
 		}
 
+		redisClient, err := do.InvokeNamed[redis.UniversalClient](cfg.Container, "redis-db")
+		if err != nil {
+			return nil, err
+		}
+		idempotency := internalMiddleware.Idempotency(redisClient, env.GetDuration("IDEMPOTENCY_KEY_TTL", 24*time.Hour))
+
 		routesBooking := routesAPIv1.Group("/bookings")
 		{
 			routesBooking.GET("/me", bookingHandler.GetBookings, internalMiddleware.RequireAuth(authClient, cacheService))
+			routesBooking.GET("/me/loyalty", bookingHandler.GetLoyaltySummary, internalMiddleware.RequireAuth(authClient, cacheService))
+			routesBooking.GET("/me/loyalty/history", bookingHandler.GetLoyaltyHistory, internalMiddleware.RequireAuth(authClient, cacheService))
 			routesBooking.GET("/:id", bookingHandler.GetBookingByID, internalMiddleware.RequireAuth(authClient, cacheService))
-			routesBooking.POST("", bookingHandler.CreateBooking, internalMiddleware.RequireAuth(authClient, cacheService))
+			routesBooking.POST("", bookingHandler.CreateBooking, internalMiddleware.RequireAuth(authClient, cacheService), idempotency)
+			routesBooking.POST("/:id/cancel", bookingHandler.CancelBooking, internalMiddleware.RequireAuth(authClient, cacheService))
+			routesBooking.POST("/:id/exchange", bookingHandler.ExchangeBooking, internalMiddleware.RequireAuth(authClient, cacheService))
+		}
+
+		routesHold := routesAPIv1.Group("/holds")
+		{
+			routesHold.POST("", bookingHandler.CreateSeatHold, internalMiddleware.RequireAuth(authClient, cacheService))
+			routesHold.PATCH("/:id/extend", bookingHandler.ExtendSeatHold, internalMiddleware.RequireAuth(authClient, cacheService))
+			routesHold.DELETE("/:id", bookingHandler.ReleaseSeatHold, internalMiddleware.RequireAuth(authClient, cacheService))
+		}
+
+		routesWaitlist := routesAPIv1.Group("/waitlist")
+		{
+			routesWaitlist.POST("", bookingHandler.JoinWaitlist, internalMiddleware.RequireAuth(authClient, cacheService))
+			routesWaitlist.GET("/me", bookingHandler.GetMyWaitlist, internalMiddleware.RequireAuth(authClient, cacheService))
+			routesWaitlist.DELETE("/:id", bookingHandler.LeaveWaitlist, internalMiddleware.RequireAuth(authClient, cacheService))
+		}
+
+		routesPromotion := routesAPIv1.Group("/promotions")
+		{
+			routesPromotion.POST("/validate", bookingHandler.ValidatePromotion, internalMiddleware.RequireAuth(authClient, cacheService))
+			routesPromotion.GET("", bookingHandler.GetPromotions, internalMiddleware.RequireAuth(authClient, cacheService))
+			routesPromotion.POST("", bookingHandler.CreatePromotion, internalMiddleware.RequireAuth(authClient, cacheService))
+			routesPromotion.DELETE("/:id", bookingHandler.DeactivatePromotion, internalMiddleware.RequireAuth(authClient, cacheService))
+		}
+
+		routesLoyalty := routesAPIv1.Group("/loyalty")
+		{
+			routesLoyalty.GET("/tiers", bookingHandler.GetLoyaltyTiers, internalMiddleware.RequireAuth(authClient, cacheService))
+			routesLoyalty.PUT("/tiers/:name", bookingHandler.UpdateLoyaltyTier, internalMiddleware.RequireAuth(authClient, cacheService))
+		}
+
+		routesStaffSession := routesAPIv1.Group("/staff-sessions")
+		{
+			routesStaffSession.POST("", bookingHandler.OpenStaffSession, internalMiddleware.RequireAuth(authClient, cacheService))
+			routesStaffSession.GET("", bookingHandler.GetStaffSessions, internalMiddleware.RequireAuth(authClient, cacheService))
+			routesStaffSession.GET("/me/current", bookingHandler.GetCurrentStaffSession, internalMiddleware.RequireAuth(authClient, cacheService))
+			routesStaffSession.GET("/:id/report", bookingHandler.GetStaffSessionReport, internalMiddleware.RequireAuth(authClient, cacheService))
+			routesStaffSession.POST("/:id/close", bookingHandler.CloseStaffSession, internalMiddleware.RequireAuth(authClient, cacheService))
+		}
+
+		routesConcession := routesAPIv1.Group("/concessions")
+		{
+			routesConcession.GET("", bookingHandler.GetConcessionItems, internalMiddleware.RequireAuth(authClient, cacheService))
+			routesConcession.POST("", bookingHandler.CreateConcessionItem, internalMiddleware.RequireAuth(authClient, cacheService))
+			routesConcession.POST("/pickup", bookingHandler.CollectConcessions, internalMiddleware.RequireAuth(authClient, cacheService))
+			routesConcession.PUT("/:id", bookingHandler.UpdateConcessionItem, internalMiddleware.RequireAuth(authClient, cacheService))
+			routesConcession.POST("/:id/stock", bookingHandler.AdjustConcessionStock, internalMiddleware.RequireAuth(authClient, cacheService))
+		}
+
+		routesBookingAdmin := routesAPIv1.Group("/admin/bookings")
+		{
+			routesBookingAdmin.GET("", bookingHandler.SearchBookings, internalMiddleware.RequireAuth(authClient, cacheService))
+			routesBookingAdmin.GET("/:id/audit", bookingHandler.GetBookingAuditLogs, internalMiddleware.RequireAuth(authClient, cacheService))
+			routesBookingAdmin.POST("/:id/force-cancel", bookingHandler.ForceCancelBooking, internalMiddleware.RequireAuth(authClient, cacheService))
+			routesBookingAdmin.POST("/:id/force-confirm", bookingHandler.ForceConfirmBooking, internalMiddleware.RequireAuth(authClient, cacheService))
 		}
 
 		routesTicket := routesAPIv1.Group("/tickets")
 		{
 			routesTicket.GET("/search", bookingHandler.SearchTickets, internalMiddleware.RequireAuth(authClient, cacheService))
+			routesTicket.POST("/scan", bookingHandler.ScanTicket, internalMiddleware.RequireAuth(authClient, cacheService))
+			routesTicket.GET("/check-ins/report", bookingHandler.GetCheckinReport, internalMiddleware.RequireAuth(authClient, cacheService))
+			routesTicket.GET("/:id/check-ins", bookingHandler.GetTicketCheckins, internalMiddleware.RequireAuth(authClient, cacheService))
 			routesTicket.PATCH("/:id/mark-used", bookingHandler.MarkTicketAsUsed, internalMiddleware.RequireAuth(authClient, cacheService))
 		}
 	}
@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"booking-service/internal/models"
+	"booking-service/internal/pkg/response"
+	"booking-service/internal/services"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+func (h *BookingHandler) GetLoyaltySummary(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	userId := c.Get("user_id").(string)
+	if userId == "" {
+		return response.Unauthorized(c, "User ID not found in token")
+	}
+
+	summary, err := bookingService.GetLoyaltySummary(c.Request().Context(), userId)
+	if err != nil {
+		return response.ErrorWithMessage(c, "Failed to get loyalty points")
+	}
+
+	return response.SuccessWithMessage(c, "Loyalty points fetched successfully", summary)
+}
+
+func (h *BookingHandler) GetLoyaltyHistory(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	var query struct {
+		Page int `query:"page"`
+		Size int `query:"size"`
+	}
+	if err = c.Bind(&query); err != nil {
+		return response.BadRequest(c, fmt.Sprintf("Invalid query parameters: %s", err.Error()))
+	}
+
+	userId := c.Get("user_id").(string)
+	if userId == "" {
+		return response.Unauthorized(c, "User ID not found in token")
+	}
+
+	if query.Page == 0 {
+		query.Page = 1
+	}
+	if query.Size == 0 {
+		query.Size = 10
+	}
+
+	transactions, total, err := bookingService.GetLoyaltyHistory(c.Request().Context(), userId, query.Page, query.Size)
+	if err != nil {
+		return response.ErrorWithMessage(c, "Failed to get loyalty history")
+	}
+
+	responseData := map[string]interface{}{
+		"transactions": transactions,
+		"total":        total,
+		"page":         query.Page,
+		"size":         query.Size,
+	}
+
+	return response.SuccessWithMessage(c, "Loyalty history fetched successfully", responseData)
+}
+
+func (h *BookingHandler) GetLoyaltyTiers(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	tiers, err := bookingService.GetLoyaltyTiers(c.Request().Context())
+	if err != nil {
+		return response.ErrorWithMessage(c, "Failed to get loyalty tiers")
+	}
+
+	return response.SuccessWithMessage(c, "Loyalty tiers fetched successfully", tiers)
+}
+
+func (h *BookingHandler) UpdateLoyaltyTier(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	userRole, _ := c.Get("userRole").(string)
+	if !isManagerRole(userRole) {
+		return response.Forbidden(c, "Only managers and admins can update loyalty tiers")
+	}
+
+	var request struct {
+		MinLifetimePoints int     `json:"min_lifetime_points"`
+		EarnRate          float64 `json:"earn_rate"`
+	}
+	if err = c.Bind(&request); err != nil {
+		return response.BadRequest(c, "Invalid request data")
+	}
+
+	tier, err := bookingService.UpdateLoyaltyTier(c.Request().Context(), &models.LoyaltyTier{
+		Name:              strings.ToUpper(c.Param("name")),
+		MinLifetimePoints: request.MinLifetimePoints,
+		EarnRate:          request.EarnRate,
+	})
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidLoyaltyTier) {
+			return response.BadRequest(c, "Invalid loyalty tier data")
+		}
+
+		if errors.Is(err, services.ErrLoyaltyTierNotFound) {
+			return response.NotFound(c, services.ErrLoyaltyTierNotFound)
+		}
+
+		return response.ErrorWithMessage(c, fmt.Sprintf("Failed to update loyalty tier: %s", err.Error()))
+	}
+
+	return response.SuccessWithMessage(c, "Loyalty tier updated successfully", tier)
+}
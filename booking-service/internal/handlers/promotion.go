@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"booking-service/internal/datastore"
+	"booking-service/internal/models"
+	"booking-service/internal/pkg/response"
+	"booking-service/internal/services"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+// isPromotionError reports whether err is a promo code rejection that the
+// client can act on.
+func isPromotionError(err error) bool {
+	return errors.Is(err, services.ErrPromotionNotFound) ||
+		errors.Is(err, services.ErrPromotionInactive) ||
+		errors.Is(err, services.ErrPromotionNotApplicable) ||
+		errors.Is(err, services.ErrPromotionUsageLimitReached) ||
+		errors.Is(err, services.ErrPromotionUserLimitReached)
+}
+
+func (h *BookingHandler) ValidatePromotion(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	var request struct {
+		PromoCode  string   `json:"promo_code" validate:"required"`
+		ShowtimeId string   `json:"showtime_id" validate:"required,uuid"`
+		SeatIds    []string `json:"seat_ids" validate:"required,dive,uuid"`
+	}
+	if err = c.Bind(&request); err != nil {
+		return response.BadRequest(c, "Invalid request data")
+	}
+
+	userId := c.Get("user_id").(string)
+	if userId == "" {
+		return response.Unauthorized(c, "User ID not found in token")
+	}
+
+	quote, err := bookingService.QuotePromotion(c.Request().Context(), userId, request.PromoCode, request.ShowtimeId, request.SeatIds)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidBookingData) {
+			return response.BadRequest(c, "Invalid request data")
+		}
+
+		if isPromotionError(err) {
+			return response.BadRequest(c, err.Error())
+		}
+
+		return response.ErrorWithMessage(c, fmt.Sprintf("Failed to validate promo code: %s", err.Error()))
+	}
+
+	return response.SuccessWithMessage(c, "Promo code applied successfully", quote)
+}
+
+func (h *BookingHandler) CreatePromotion(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	userRole, _ := c.Get("userRole").(string)
+	if !isManagerRole(userRole) {
+		return response.Forbidden(c, "Only managers and admins can create promotions")
+	}
+
+	var request struct {
+		Code              string     `json:"code"`
+		Description       string     `json:"description"`
+		DiscountType      string     `json:"discount_type"`
+		DiscountValue     float64    `json:"discount_value"`
+		MaxDiscount       float64    `json:"max_discount"`
+		BuyQuantity       int        `json:"buy_quantity"`
+		GetQuantity       int        `json:"get_quantity"`
+		MinTickets        int        `json:"min_tickets"`
+		MovieIds          []string   `json:"movie_ids"`
+		Formats           []string   `json:"formats"`
+		Weekdays          []int      `json:"weekdays"`
+		FirstBookingOnly  bool       `json:"first_booking_only"`
+		UsageLimit        int        `json:"usage_limit"`
+		UsageLimitPerUser int        `json:"usage_limit_per_user"`
+		ValidFrom         *time.Time `json:"valid_from"`
+		ValidUntil        *time.Time `json:"valid_until"`
+	}
+	if err = c.Bind(&request); err != nil {
+		return response.BadRequest(c, "Invalid request data")
+	}
+
+	userId, _ := c.Get("user_id").(string)
+
+	promotion, err := bookingService.CreatePromotion(c.Request().Context(), userId, &models.Promotion{
+		Code:              request.Code,
+		Description:       request.Description,
+		DiscountType:      models.DiscountType(strings.ToUpper(request.DiscountType)),
+		DiscountValue:     request.DiscountValue,
+		MaxDiscount:       request.MaxDiscount,
+		BuyQuantity:       request.BuyQuantity,
+		GetQuantity:       request.GetQuantity,
+		MinTickets:        request.MinTickets,
+		MovieIds:          request.MovieIds,
+		Formats:           request.Formats,
+		Weekdays:          request.Weekdays,
+		FirstBookingOnly:  request.FirstBookingOnly,
+		UsageLimit:        request.UsageLimit,
+		UsageLimitPerUser: request.UsageLimitPerUser,
+		ValidFrom:         request.ValidFrom,
+		ValidUntil:        request.ValidUntil,
+	})
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidPromotion) {
+			return response.BadRequest(c, "Invalid promotion data")
+		}
+
+		if errors.Is(err, datastore.ErrPromotionCodeTaken) {
+			return response.BadRequest(c, "Promotion code already exists")
+		}
+
+		return response.ErrorWithMessage(c, fmt.Sprintf("Failed to create promotion: %s", err.Error()))
+	}
+
+	return response.SuccessWithMessage(c, "Promotion created successfully", promotion)
+}
+
+func (h *BookingHandler) GetPromotions(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	userRole, _ := c.Get("userRole").(string)
+	if !isManagerRole(userRole) {
+		return response.Forbidden(c, "Only managers and admins can view promotions")
+	}
+
+	var query struct {
+		Page       int  `query:"page"`
+		Size       int  `query:"size"`
+		ActiveOnly bool `query:"active_only"`
+	}
+	if err = c.Bind(&query); err != nil {
+		return response.BadRequest(c, fmt.Sprintf("Invalid query parameters: %s", err.Error()))
+	}
+
+	if query.Page == 0 {
+		query.Page = 1
+	}
+	if query.Size == 0 {
+		query.Size = 10
+	}
+
+	promotions, total, err := bookingService.GetPromotions(c.Request().Context(), query.ActiveOnly, query.Page, query.Size)
+	if err != nil {
+		return response.ErrorWithMessage(c, "Failed to get promotions")
+	}
+
+	responseData := map[string]interface{}{
+		"promotions": promotions,
+		"total":      total,
+		"page":       query.Page,
+		"size":       query.Size,
+	}
+
+	return response.SuccessWithMessage(c, "Promotions fetched successfully", responseData)
+}
+
+func (h *BookingHandler) DeactivatePromotion(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	userRole, _ := c.Get("userRole").(string)
+	if !isManagerRole(userRole) {
+		return response.Forbidden(c, "Only managers and admins can deactivate promotions")
+	}
+
+	promotionId := c.Param("id")
+	if promotionId == "" {
+		return response.BadRequest(c, "Promotion ID is required")
+	}
+
+	if err = bookingService.DeactivatePromotion(c.Request().Context(), promotionId); err != nil {
+		if errors.Is(err, services.ErrPromotionNotFound) {
+			return response.NotFound(c, services.ErrPromotionNotFound)
+		}
+		return response.ErrorWithMessage(c, fmt.Sprintf("Failed to deactivate promotion: %s", err.Error()))
+	}
+
+	return response.SuccessWithMessage(c, "Promotion deactivated successfully", nil)
+}
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	"booking-service/internal/pkg/response"
+	"booking-service/internal/services"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+func (h *BookingHandler) CreateSeatHold(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	var request struct {
+		ShowtimeId string   `json:"showtime_id" validate:"required,uuid"`
+		SeatIds    []string `json:"seat_ids" validate:"required,dive,uuid"`
+	}
+	if err = c.Bind(&request); err != nil {
+		return response.BadRequest(c, "Invalid request data")
+	}
+
+	userId := c.Get("user_id").(string)
+	if userId == "" {
+		return response.Unauthorized(c, "User ID not found in token")
+	}
+
+	hold, err := bookingService.CreateSeatHold(c.Request().Context(), userId, request.ShowtimeId, request.SeatIds)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidBookingData) {
+			return response.BadRequest(c, "Invalid hold data")
+		}
+
+		if errors.Is(err, services.ErrSeatHoldLimitReached) {
+			return response.BadRequest(c, "Maximum number of active seat holds reached")
+		}
+
+		var policyErr *services.BookingPolicyError
+		if errors.As(err, &policyErr) {
+			return bookingPolicyError(c, policyErr)
+		}
+
+		var conflictErr *services.SeatConflictError
+		if errors.As(err, &conflictErr) {
+			message := "Seat is being processed"
+			if errors.Is(err, services.ErrSeatAlreadyBooked) {
+				message = "Seat already booked"
+			}
+			return response.BadRequestWithData(c, message, map[string]interface{}{
+				"conflicting_seat_ids": conflictErr.SeatIds,
+			})
+		}
+
+		return response.ErrorWithMessage(c, fmt.Sprintf("Failed to hold seats: %s", err.Error()))
+	}
+
+	return response.SuccessWithMessage(c, "Seats held successfully", hold)
+}
+
+func (h *BookingHandler) ExtendSeatHold(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	holdId := c.Param("id")
+	if holdId == "" {
+		return response.BadRequest(c, "Hold ID is required")
+	}
+
+	userId := c.Get("user_id").(string)
+	if userId == "" {
+		return response.Unauthorized(c, "User ID not found in token")
+	}
+
+	hold, err := bookingService.ExtendSeatHold(c.Request().Context(), userId, holdId)
+	if err != nil {
+		return h.seatHoldError(c, err, "Failed to extend seat hold")
+	}
+
+	return response.SuccessWithMessage(c, "Seat hold extended successfully", hold)
+}
+
+func (h *BookingHandler) ReleaseSeatHold(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	holdId := c.Param("id")
+	if holdId == "" {
+		return response.BadRequest(c, "Hold ID is required")
+	}
+
+	userId := c.Get("user_id").(string)
+	if userId == "" {
+		return response.Unauthorized(c, "User ID not found in token")
+	}
+
+	if err = bookingService.ReleaseSeatHold(c.Request().Context(), userId, holdId); err != nil {
+		return h.seatHoldError(c, err, "Failed to release seat hold")
+	}
+
+	return response.SuccessWithMessage(c, "Seat hold released successfully", nil)
+}
+
+func (h *BookingHandler) seatHoldError(c echo.Context, err error, message string) error {
+	if errors.Is(err, services.ErrSeatHoldNotFound) || errors.Is(err, services.ErrWaitlistEntryNotFound) {
+		return response.NotFound(c, services.ErrSeatHoldNotFound)
+	}
+
+	if errors.Is(err, services.ErrSeatHoldAccessDenied) {
+		return response.Forbidden(c, "Seat hold does not belong to user")
+	}
+
+	if errors.Is(err, services.ErrSeatHoldNotExtendable) {
+		return response.BadRequest(c, services.ErrSeatHoldNotExtendable.Error())
+	}
+
+	var conflictErr *services.SeatConflictError
+	if errors.As(err, &conflictErr) {
+		return response.BadRequestWithData(c, "Seat hold has expired", map[string]interface{}{
+			"conflicting_seat_ids": conflictErr.SeatIds,
+		})
+	}
+
+	return response.ErrorWithMessage(c, fmt.Sprintf("%s: %s", message, err.Error()))
+}
@@ -0,0 +1,199 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	"booking-service/internal/pkg/response"
+	"booking-service/internal/services"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+func (h *BookingHandler) OpenStaffSession(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	userRole, _ := c.Get("userRole").(string)
+	if !isStaffRole(userRole) {
+		return response.Forbidden(c, "Only ticket staff, managers, and admins can open a staff session")
+	}
+
+	var request struct {
+		OpeningFloat float64 `json:"opening_float"`
+		Note         string  `json:"note"`
+	}
+	if err = c.Bind(&request); err != nil {
+		return response.BadRequest(c, "Invalid request data")
+	}
+
+	userId := c.Get("user_id").(string)
+	if userId == "" {
+		return response.Unauthorized(c, "User ID not found in token")
+	}
+
+	session, err := bookingService.OpenStaffSession(c.Request().Context(), userId, request.OpeningFloat, request.Note)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidStaffSession) {
+			return response.BadRequest(c, err.Error())
+		}
+		if errors.Is(err, services.ErrStaffSessionAlreadyOpen) {
+			return response.Conflict(c, err.Error())
+		}
+		return response.ErrorWithMessage(c, fmt.Sprintf("Failed to open staff session: %s", err.Error()))
+	}
+
+	return response.SuccessWithMessage(c, "Staff session opened successfully", session)
+}
+
+func (h *BookingHandler) GetCurrentStaffSession(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	userId := c.Get("user_id").(string)
+	if userId == "" {
+		return response.Unauthorized(c, "User ID not found in token")
+	}
+
+	session, err := bookingService.GetCurrentStaffSession(c.Request().Context(), userId)
+	if err != nil {
+		if errors.Is(err, services.ErrStaffSessionNotFound) {
+			return response.NotFound(c, services.ErrStaffSessionNotFound)
+		}
+		return response.ErrorWithMessage(c, fmt.Sprintf("Failed to get staff session: %s", err.Error()))
+	}
+
+	return response.Success(c, session)
+}
+
+func (h *BookingHandler) GetStaffSessions(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	userRole, _ := c.Get("userRole").(string)
+	if !isManagerRole(userRole) {
+		return response.Forbidden(c, "Only managers and admins can view staff sessions")
+	}
+
+	var query struct {
+		Page    int    `query:"page"`
+		Size    int    `query:"size"`
+		StaffId string `query:"staff_id"`
+		Status  string `query:"status"`
+	}
+	if err = c.Bind(&query); err != nil {
+		return response.BadRequest(c, fmt.Sprintf("Invalid query parameters: %s", err.Error()))
+	}
+
+	if query.Page == 0 {
+		query.Page = 1
+	}
+	if query.Size == 0 {
+		query.Size = 10
+	}
+
+	sessions, total, err := bookingService.GetStaffSessions(c.Request().Context(), query.StaffId, query.Status, query.Page, query.Size)
+	if err != nil {
+		return response.ErrorWithMessage(c, "Failed to get staff sessions")
+	}
+
+	responseData := map[string]interface{}{
+		"sessions": sessions,
+		"total":    total,
+		"page":     query.Page,
+		"size":     query.Size,
+	}
+
+	return response.SuccessWithMessage(c, "Staff sessions fetched successfully", responseData)
+}
+
+func (h *BookingHandler) GetStaffSessionReport(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	sessionId := c.Param("id")
+	if sessionId == "" {
+		return response.BadRequest(c, "Session ID is required")
+	}
+
+	userId := c.Get("user_id").(string)
+	if userId == "" {
+		return response.Unauthorized(c, "User ID not found in token")
+	}
+
+	userRole, _ := c.Get("userRole").(string)
+	if !isStaffRole(userRole) {
+		return response.Forbidden(c, "Only ticket staff, managers, and admins can view staff session reports")
+	}
+
+	report, err := bookingService.GetStaffSessionReport(c.Request().Context(), sessionId, userId, isManagerRole(userRole))
+	if err != nil {
+		return h.staffSessionError(c, err, "Failed to get staff session report")
+	}
+
+	return response.Success(c, report)
+}
+
+func (h *BookingHandler) CloseStaffSession(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	sessionId := c.Param("id")
+	if sessionId == "" {
+		return response.BadRequest(c, "Session ID is required")
+	}
+
+	var request struct {
+		CountedCash *float64 `json:"counted_cash"`
+		Note        string   `json:"note"`
+	}
+	if err = c.Bind(&request); err != nil {
+		return response.BadRequest(c, "Invalid request data")
+	}
+	if request.CountedCash == nil {
+		return response.BadRequest(c, "Counted cash is required")
+	}
+
+	userId := c.Get("user_id").(string)
+	if userId == "" {
+		return response.Unauthorized(c, "User ID not found in token")
+	}
+
+	userRole, _ := c.Get("userRole").(string)
+	if !isStaffRole(userRole) {
+		return response.Forbidden(c, "Only ticket staff, managers, and admins can close a staff session")
+	}
+
+	report, err := bookingService.CloseStaffSession(c.Request().Context(), sessionId, userId, isManagerRole(userRole), *request.CountedCash, request.Note)
+	if err != nil {
+		return h.staffSessionError(c, err, "Failed to close staff session")
+	}
+
+	return response.SuccessWithMessage(c, "Staff session closed successfully", report)
+}
+
+func (h *BookingHandler) staffSessionError(c echo.Context, err error, message string) error {
+	switch {
+	case errors.Is(err, services.ErrStaffSessionNotFound):
+		return response.NotFound(c, services.ErrStaffSessionNotFound)
+	case errors.Is(err, services.ErrStaffSessionAccessDenied):
+		return response.Forbidden(c, "Staff session does not belong to user")
+	case errors.Is(err, services.ErrStaffSessionClosed):
+		return response.Conflict(c, err.Error())
+	case errors.Is(err, services.ErrInvalidStaffSession):
+		return response.BadRequest(c, err.Error())
+	}
+
+	return response.ErrorWithMessage(c, fmt.Sprintf("%s: %s", message, err.Error()))
+}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	"booking-service/internal/pkg/response"
+	"booking-service/internal/services"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+)
+
+func (h *BookingHandler) JoinWaitlist(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	var request struct {
+		ShowtimeId string `json:"showtime_id" validate:"required,uuid"`
+		SeatCount  int    `json:"seat_count"`
+		SeatType   string `json:"seat_type"`
+	}
+	if err = c.Bind(&request); err != nil {
+		return response.BadRequest(c, "Invalid request data")
+	}
+
+	userId := c.Get("user_id").(string)
+	if userId == "" {
+		return response.Unauthorized(c, "User ID not found in token")
+	}
+
+	entry, err := bookingService.JoinWaitlist(c.Request().Context(), userId, request.ShowtimeId, request.SeatCount, request.SeatType)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidBookingData):
+			return response.BadRequest(c, "Invalid waitlist data")
+		case errors.Is(err, services.ErrInvalidWaitlistRequest),
+			errors.Is(err, services.ErrWaitlistClosed):
+			return response.BadRequest(c, err.Error())
+		case errors.Is(err, services.ErrWaitlistAlreadyJoined):
+			return response.Conflict(c, err.Error())
+		}
+		return response.ErrorWithMessage(c, fmt.Sprintf("Failed to join waitlist: %s", err.Error()))
+	}
+
+	return response.SuccessWithMessage(c, "Joined waitlist successfully", entry)
+}
+
+func (h *BookingHandler) GetMyWaitlist(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	userId := c.Get("user_id").(string)
+	if userId == "" {
+		return response.Unauthorized(c, "User ID not found in token")
+	}
+
+	entries, err := bookingService.GetUserWaitlistEntries(c.Request().Context(), userId)
+	if err != nil {
+		return response.ErrorWithMessage(c, fmt.Sprintf("Failed to get waitlist entries: %s", err.Error()))
+	}
+
+	return response.Success(c, entries)
+}
+
+func (h *BookingHandler) LeaveWaitlist(c echo.Context) error {
+	bookingService, err := do.Invoke[*services.BookingService](h.container)
+	if err != nil {
+		return response.InternalServerError(c, "Failed to get booking service")
+	}
+
+	entryId := c.Param("id")
+	if entryId == "" {
+		return response.BadRequest(c, "Waitlist entry ID is required")
+	}
+
+	userId := c.Get("user_id").(string)
+	if userId == "" {
+		return response.Unauthorized(c, "User ID not found in token")
+	}
+
+	if err = bookingService.LeaveWaitlist(c.Request().Context(), userId, entryId); err != nil {
+		if errors.Is(err, services.ErrWaitlistEntryNotFound) {
+			return response.NotFound(c, services.ErrWaitlistEntryNotFound)
+		}
+		if errors.Is(err, services.ErrWaitlistAccessDenied) {
+			return response.Forbidden(c, "Waitlist entry does not belong to user")
+		}
+		return response.ErrorWithMessage(c, fmt.Sprintf("Failed to leave waitlist: %s", err.Error()))
+	}
+
+	return response.SuccessWithMessage(c, "Left waitlist successfully", nil)
+}
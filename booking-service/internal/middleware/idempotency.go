@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"booking-service/internal/pkg/idempotency"
+	"booking-service/internal/pkg/response"
+
+	"github.com/labstack/echo/v4"
+	"github.com/redis/go-redis/v9"
+)
+
+// bodyRecorder tees the response body so it can be stored for replays.
+type bodyRecorder struct {
+	http.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (r *bodyRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// Idempotency replays the stored response when a request is retried with the
+// same Idempotency-Key header and body. Keys are scoped to the authenticated
+// user, so it must run after RequireAuth. Reusing a key with a different body,
+// or while the first request is in flight, is rejected with 409.
+func Idempotency(redisClient redis.UniversalClient, ttl time.Duration) echo.MiddlewareFunc {
+	store := idempotency.NewStore(redisClient, "booking", ttl)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			idempotencyKey := c.Request().Header.Get(idempotency.HeaderKey)
+			if idempotencyKey == "" {
+				return next(c)
+			}
+
+			body, err := io.ReadAll(c.Request().Body)
+			if err != nil {
+				return response.BadRequest(c, "Invalid request body")
+			}
+			c.Request().Body = io.NopCloser(bytes.NewReader(body))
+
+			userId, _ := c.Get("user_id").(string)
+			ctx := c.Request().Context()
+			requestHash := idempotency.RequestHash(c.Request().Method, c.Path(), body)
+
+			lease, record, err := store.Begin(ctx, userId, idempotencyKey, requestHash)
+			switch {
+			case errors.Is(err, idempotency.ErrKeyTooLong):
+				return response.BadRequest(c, "Idempotency-Key header is too long")
+			case errors.Is(err, idempotency.ErrInProgress):
+				return response.Conflict(c, "A request with this Idempotency-Key is being processed")
+			case errors.Is(err, idempotency.ErrKeyMismatch):
+				return response.Conflict(c, "Idempotency-Key was already used with a different request")
+			case err != nil:
+				return response.InternalServerError(c, "Failed to process Idempotency-Key")
+			}
+
+			if record != nil {
+				c.Response().Header().Set(idempotency.HeaderReplayed, "true")
+				return c.Blob(record.StatusCode, record.ContentType, record.Body)
+			}
+
+			recorder := &bodyRecorder{ResponseWriter: c.Response().Writer, body: new(bytes.Buffer)}
+			c.Response().Writer = recorder
+
+			handlerErr := next(c)
+			if handlerErr != nil {
+				c.Error(handlerErr)
+			}
+
+			// Use a fresh context so a cancelled request still settles its key.
+			lease.Complete(context.WithoutCancel(ctx), c.Response().Status,
+				c.Response().Header().Get(echo.HeaderContentType), recorder.body.Bytes())
+
+			return nil
+		}
+	}
+}
@@ -24,15 +24,22 @@ const (
 type Booking struct {
 	bun.BaseModel `bun:"table:bookings,alias:b"`
 
-	Id          string        `bun:"id,pk" json:"id"`
-	UserId      string        `bun:"user_id,notnull" json:"user_id"`
-	ShowtimeId  string        `bun:"showtime_id,notnull" json:"showtime_id"`
-	TotalAmount float64       `bun:"total_amount,notnull,type:decimal(10,2)" json:"total_amount"`
-	Status      BookingStatus `bun:"status,notnull,default:'PENDING'" json:"status"`
-	StaffId     string        `bun:"staff_id" json:"staff_id,omitempty"`
-	BookingType BookingType   `bun:"booking_type,notnull" json:"booking_type"`
-	CreatedAt   time.Time     `bun:"created_at,nullzero,default:current_timestamp" json:"created_at"`
-	UpdatedAt   *time.Time    `bun:"updated_at" json:"updated_at,omitempty"`
-
-	Ticket []*Ticket `bun:"rel:has-many,join:id=booking_id" json:"ticket,omitempty"`
+	Id               string        `bun:"id,pk" json:"id"`
+	UserId           string        `bun:"user_id,notnull" json:"user_id"`
+	ShowtimeId       string        `bun:"showtime_id,notnull" json:"showtime_id"`
+	TotalAmount      float64       `bun:"total_amount,notnull,type:decimal(10,2)" json:"total_amount"`
+	PromoCode        string        `bun:"promo_code,nullzero" json:"promo_code,omitempty"`
+	DiscountAmount   float64       `bun:"discount_amount,notnull,default:0,type:decimal(10,2)" json:"discount_amount"`
+	PointsRedeemed   int           `bun:"points_redeemed,notnull,default:0" json:"points_redeemed"`
+	PointsDiscount   float64       `bun:"points_discount,notnull,default:0,type:decimal(10,2)" json:"points_discount"`
+	ConcessionAmount float64       `bun:"concession_amount,notnull,default:0,type:decimal(10,2)" json:"concession_amount"`
+	Status           BookingStatus `bun:"status,notnull,default:'PENDING'" json:"status"`
+	StaffId          string        `bun:"staff_id" json:"staff_id,omitempty"`
+	StaffSessionId   string        `bun:"staff_session_id,nullzero" json:"staff_session_id,omitempty"`
+	BookingType      BookingType   `bun:"booking_type,notnull" json:"booking_type"`
+	CreatedAt        time.Time     `bun:"created_at,nullzero,default:current_timestamp" json:"created_at"`
+	UpdatedAt        *time.Time    `bun:"updated_at" json:"updated_at,omitempty"`
+
+	Ticket      []*Ticket            `bun:"rel:has-many,join:id=booking_id" json:"ticket,omitempty"`
+	Concessions []*BookingConcession `bun:"rel:has-many,join:id=booking_id" json:"concessions,omitempty"`
 }
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type BookingAuditAction string
+
+const (
+	BookingAuditActionForceCancel  BookingAuditAction = "FORCE_CANCEL"
+	BookingAuditActionForceConfirm BookingAuditAction = "FORCE_CONFIRM"
+)
+
+// BookingAuditLog records a manual status change made by an admin.
+type BookingAuditLog struct {
+	bun.BaseModel `bun:"table:booking_audit_logs,alias:bal"`
+
+	Id         string             `bun:"id,pk" json:"id"`
+	BookingId  string             `bun:"booking_id,notnull" json:"booking_id"`
+	ActorId    string             `bun:"actor_id,notnull" json:"actor_id"`
+	Action     BookingAuditAction `bun:"action,notnull" json:"action"`
+	FromStatus BookingStatus      `bun:"from_status,notnull" json:"from_status"`
+	ToStatus   BookingStatus      `bun:"to_status,notnull" json:"to_status"`
+	Reason     string             `bun:"reason,notnull" json:"reason"`
+	CreatedAt  time.Time          `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
+}
@@ -9,3 +9,32 @@ type BookingEventData struct {
 	TotalAmount float64       `json:"total_amount"`
 	Status      BookingStatus `json:"status"`
 }
+
+const (
+	SeatReleaseReasonWaitlistOfferExpired  = "WAITLIST_OFFER_EXPIRED"
+	SeatReleaseReasonWaitlistOfferDeclined = "WAITLIST_OFFER_DECLINED"
+	SeatReleaseReasonSeatHoldReleased      = "SEAT_HOLD_RELEASED"
+	SeatReleaseReasonSeatHoldExpired       = "SEAT_HOLD_EXPIRED"
+)
+
+type SeatReleasedEventData struct {
+	BookingId  string   `json:"booking_id"`
+	UserId     string   `json:"user_id"`
+	ShowtimeId string   `json:"showtime_id"`
+	SeatIds    []string `json:"seat_ids"`
+	Reason     string   `json:"reason,omitempty"`
+}
+
+type BookingExchangedEventData struct {
+	ExchangeId      string   `json:"exchange_id"`
+	BookingId       string   `json:"booking_id"`
+	UserId          string   `json:"user_id"`
+	StaffId         string   `json:"staff_id"`
+	OldShowtimeId   string   `json:"old_showtime_id"`
+	OldSeatIds      []string `json:"old_seat_ids"`
+	NewShowtimeId   string   `json:"new_showtime_id"`
+	NewSeatIds      []string `json:"new_seat_ids"`
+	OldAmount       float64  `json:"old_amount"`
+	NewAmount       float64  `json:"new_amount"`
+	PriceDifference float64  `json:"price_difference"`
+}
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type ConcessionCategory string
+
+const (
+	ConcessionCategoryFood  ConcessionCategory = "FOOD"
+	ConcessionCategoryDrink ConcessionCategory = "DRINK"
+	ConcessionCategoryCombo ConcessionCategory = "COMBO"
+)
+
+// ConcessionItem is a food or drink sold with a booking. Each cinema keeps its
+// own stock of it. A combo holds no stock of its own; selling one draws on the
+// stock of its components.
+type ConcessionItem struct {
+	bun.BaseModel `bun:"table:concession_items,alias:ci"`
+
+	Id          string             `bun:"id,pk" json:"id"`
+	Name        string             `bun:"name,notnull" json:"name"`
+	Description string             `bun:"description" json:"description,omitempty"`
+	Category    ConcessionCategory `bun:"category,notnull" json:"category"`
+	Price       float64            `bun:"price,notnull,type:decimal(10,2)" json:"price"`
+	IsActive    bool               `bun:"is_active,notnull,default:true" json:"is_active"`
+	CreatedAt   time.Time          `bun:"created_at,nullzero,default:current_timestamp" json:"created_at"`
+	UpdatedAt   *time.Time         `bun:"updated_at" json:"updated_at,omitempty"`
+
+	Components []*ConcessionComboItem `bun:"rel:has-many,join:id=combo_id" json:"components,omitempty"`
+	Stocks     []*ConcessionStock     `bun:"rel:has-many,join:id=item_id" json:"stocks,omitempty"`
+}
+
+// ConcessionStock is how much of an item a cinema has left.
+type ConcessionStock struct {
+	bun.BaseModel `bun:"table:concession_stocks,alias:cs"`
+
+	ItemId    string     `bun:"item_id,pk" json:"item_id"`
+	CinemaId  string     `bun:"cinema_id,pk" json:"cinema_id"`
+	Stock     int        `bun:"stock,notnull,default:0" json:"stock"`
+	UpdatedAt *time.Time `bun:"updated_at" json:"updated_at,omitempty"`
+}
+
+type ConcessionComboItem struct {
+	bun.BaseModel `bun:"table:concession_combo_items,alias:cci"`
+
+	ComboId  string `bun:"combo_id,pk" json:"combo_id"`
+	ItemId   string `bun:"item_id,pk" json:"item_id"`
+	Quantity int    `bun:"quantity,notnull" json:"quantity"`
+}
+
+type BookingConcessionStatus string
+
+const (
+	// BookingConcessionStatusPending is ordered with an unpaid booking. Its
+	// stock is already taken, so paying for it cannot oversell the item.
+	BookingConcessionStatusPending   BookingConcessionStatus = "PENDING"
+	BookingConcessionStatusConfirmed BookingConcessionStatus = "CONFIRMED"
+	BookingConcessionStatusCollected BookingConcessionStatus = "COLLECTED"
+	BookingConcessionStatusCancelled BookingConcessionStatus = "CANCELLED"
+)
+
+type BookingConcession struct {
+	bun.BaseModel `bun:"table:booking_concessions,alias:bc"`
+
+	Id          string                  `bun:"id,pk" json:"id"`
+	BookingId   string                  `bun:"booking_id,notnull" json:"booking_id"`
+	ItemId      string                  `bun:"item_id,notnull" json:"item_id"`
+	ItemName    string                  `bun:"item_name,notnull" json:"item_name"`
+	CinemaId    string                  `bun:"cinema_id,notnull" json:"cinema_id"`
+	Quantity    int                     `bun:"quantity,notnull" json:"quantity"`
+	UnitPrice   float64                 `bun:"unit_price,notnull,type:decimal(10,2)" json:"unit_price"`
+	TotalPrice  float64                 `bun:"total_price,notnull,type:decimal(10,2)" json:"total_price"`
+	Status      BookingConcessionStatus `bun:"status,notnull,default:'PENDING'" json:"status"`
+	StockUsage  map[string]int          `bun:"stock_usage,type:jsonb" json:"-"`
+	CollectedAt *time.Time              `bun:"collected_at" json:"collected_at,omitempty"`
+	CollectedBy string                  `bun:"collected_by,nullzero" json:"collected_by,omitempty"`
+	CreatedAt   time.Time               `bun:"created_at,nullzero,default:current_timestamp" json:"created_at"`
+	UpdatedAt   *time.Time              `bun:"updated_at" json:"updated_at,omitempty"`
+}
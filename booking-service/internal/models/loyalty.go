@@ -0,0 +1,54 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// LoyaltyTier is a membership level. Accounts move up once their lifetime
+// points reach MinLifetimePoints; EarnRate is points per 1,000 spent.
+type LoyaltyTier struct {
+	bun.BaseModel `bun:"table:loyalty_tiers,alias:lt"`
+
+	Name              string     `bun:"name,pk" json:"name"`
+	MinLifetimePoints int        `bun:"min_lifetime_points,notnull,default:0" json:"min_lifetime_points"`
+	EarnRate          float64    `bun:"earn_rate,notnull,type:decimal(6,2)" json:"earn_rate"`
+	CreatedAt         time.Time  `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
+	UpdatedAt         *time.Time `bun:"updated_at" json:"updated_at,omitempty"`
+}
+
+type LoyaltyAccount struct {
+	bun.BaseModel `bun:"table:loyalty_accounts,alias:la"`
+
+	UserId         string     `bun:"user_id,pk" json:"user_id"`
+	Balance        int        `bun:"balance,notnull,default:0" json:"balance"`
+	LifetimePoints int        `bun:"lifetime_points,notnull,default:0" json:"lifetime_points"`
+	Tier           string     `bun:"tier,notnull" json:"tier"`
+	CreatedAt      time.Time  `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
+	UpdatedAt      *time.Time `bun:"updated_at" json:"updated_at,omitempty"`
+}
+
+type LoyaltyTransactionType string
+
+const (
+	LoyaltyTransactionEarn         LoyaltyTransactionType = "EARN"
+	LoyaltyTransactionEarnReversal LoyaltyTransactionType = "EARN_REVERSAL"
+	LoyaltyTransactionRedeem       LoyaltyTransactionType = "REDEEM"
+	LoyaltyTransactionRedeemRefund LoyaltyTransactionType = "REDEEM_REFUND"
+)
+
+// LoyaltyTransaction is one ledger entry. Points are signed; a booking has at
+// most one entry of each type.
+type LoyaltyTransaction struct {
+	bun.BaseModel `bun:"table:loyalty_transactions,alias:ltx"`
+
+	Id           string                 `bun:"id,pk" json:"id"`
+	UserId       string                 `bun:"user_id,notnull" json:"user_id"`
+	BookingId    string                 `bun:"booking_id,nullzero" json:"booking_id,omitempty"`
+	Type         LoyaltyTransactionType `bun:"type,notnull" json:"type"`
+	Points       int                    `bun:"points,notnull" json:"points"`
+	BalanceAfter int                    `bun:"balance_after,notnull" json:"balance_after"`
+	Description  string                 `bun:"description" json:"description,omitempty"`
+	CreatedAt    time.Time              `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
+}
@@ -33,4 +33,5 @@ const (
 	EventTypeSeatReserved     OutboxEventType = "SEAT_RESERVED"
 	EventTypeSeatReleased     OutboxEventType = "SEAT_RELEASED"
 	EventTypeNotificationSent OutboxEventType = "NOTIFICATION_SENT"
+	EventTypeBookingExchanged OutboxEventType = "BOOKING_EXCHANGED"
 )
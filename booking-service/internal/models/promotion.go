@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type DiscountType string
+
+const (
+	DiscountTypePercentage DiscountType = "PERCENTAGE"
+	DiscountTypeFixed      DiscountType = "FIXED"
+	DiscountTypeBuyXGetY   DiscountType = "BUY_X_GET_Y"
+)
+
+// Promotion is a promo code and the rules it applies under. Empty MovieIds,
+// Formats and Weekdays mean no restriction; zero limits mean unlimited.
+// Weekdays follow time.Weekday, so Sunday is 0.
+type Promotion struct {
+	bun.BaseModel `bun:"table:promotions,alias:pr"`
+
+	Id                string       `bun:"id,pk" json:"id"`
+	Code              string       `bun:"code,notnull,unique" json:"code"`
+	Description       string       `bun:"description" json:"description,omitempty"`
+	DiscountType      DiscountType `bun:"discount_type,notnull" json:"discount_type"`
+	DiscountValue     float64      `bun:"discount_value,notnull,default:0,type:decimal(10,2)" json:"discount_value"`
+	MaxDiscount       float64      `bun:"max_discount,notnull,default:0,type:decimal(10,2)" json:"max_discount"`
+	BuyQuantity       int          `bun:"buy_quantity,notnull,default:0" json:"buy_quantity"`
+	GetQuantity       int          `bun:"get_quantity,notnull,default:0" json:"get_quantity"`
+	MinTickets        int          `bun:"min_tickets,notnull,default:0" json:"min_tickets"`
+	MovieIds          []string     `bun:"movie_ids,array" json:"movie_ids"`
+	Formats           []string     `bun:"formats,array" json:"formats"`
+	Weekdays          []int        `bun:"weekdays,array" json:"weekdays"`
+	FirstBookingOnly  bool         `bun:"first_booking_only,notnull,default:false" json:"first_booking_only"`
+	UsageLimit        int          `bun:"usage_limit,notnull,default:0" json:"usage_limit"`
+	UsageLimitPerUser int          `bun:"usage_limit_per_user,notnull,default:0" json:"usage_limit_per_user"`
+	UsageCount        int          `bun:"usage_count,notnull,default:0" json:"usage_count"`
+	ValidFrom         *time.Time   `bun:"valid_from" json:"valid_from,omitempty"`
+	ValidUntil        *time.Time   `bun:"valid_until" json:"valid_until,omitempty"`
+	IsActive          bool         `bun:"is_active,notnull,default:true" json:"is_active"`
+	CreatedBy         string       `bun:"created_by" json:"created_by,omitempty"`
+	CreatedAt         time.Time    `bun:"created_at,nullzero,default:current_timestamp" json:"created_at"`
+	UpdatedAt         *time.Time   `bun:"updated_at" json:"updated_at,omitempty"`
+}
+
+type PromotionRedemptionStatus string
+
+const (
+	PromotionRedemptionStatusActive   PromotionRedemptionStatus = "ACTIVE"
+	PromotionRedemptionStatusReleased PromotionRedemptionStatus = "RELEASED"
+)
+
+type PromotionRedemption struct {
+	bun.BaseModel `bun:"table:promotion_redemptions,alias:prr"`
+
+	Id             string                    `bun:"id,pk" json:"id"`
+	PromotionId    string                    `bun:"promotion_id,notnull" json:"promotion_id"`
+	BookingId      string                    `bun:"booking_id,notnull" json:"booking_id"`
+	UserId         string                    `bun:"user_id,notnull" json:"user_id"`
+	DiscountAmount float64                   `bun:"discount_amount,notnull,type:decimal(10,2)" json:"discount_amount"`
+	Status         PromotionRedemptionStatus `bun:"status,notnull,default:'ACTIVE'" json:"status"`
+	CreatedAt      time.Time                 `bun:"created_at,nullzero,default:current_timestamp" json:"created_at"`
+	UpdatedAt      *time.Time                `bun:"updated_at" json:"updated_at,omitempty"`
+}
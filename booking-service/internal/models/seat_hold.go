@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+type SeatHold struct {
+	Id              string    `json:"id"`
+	UserId          string    `json:"user_id"`
+	ShowtimeId      string    `json:"showtime_id"`
+	SeatIds         []string  `json:"seat_ids"`
+	WaitlistEntryId string    `json:"waitlist_entry_id,omitempty"`
+	QuoteId         string    `json:"quote_id,omitempty"`
+	TotalAmount     float64   `json:"total_amount"`
+	CreatedAt       time.Time `json:"created_at"`
+	ExpiresAt       time.Time `json:"expires_at"`
+}
@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+type SeatState string
+
+const (
+	SeatStateHeld     SeatState = "HELD"
+	SeatStateReleased SeatState = "RELEASED"
+	SeatStateBooked   SeatState = "BOOKED"
+)
+
+// SeatStateChange is pushed to seat map subscribers of a showtime whenever
+// seats are held, released or booked. ExpiresAt is set for held seats, which
+// clients should treat as released once it has passed.
+type SeatStateChange struct {
+	ShowtimeId string     `json:"showtime_id"`
+	SeatIds    []string   `json:"seat_ids"`
+	State      SeatState  `json:"state"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	Timestamp  int64      `json:"timestamp"`
+}
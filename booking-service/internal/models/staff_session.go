@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type StaffSessionStatus string
+
+const (
+	StaffSessionStatusOpen   StaffSessionStatus = "OPEN"
+	StaffSessionStatusClosed StaffSessionStatus = "CLOSED"
+)
+
+// StaffSession is a box-office shift of one staff member. The drawer starts
+// with OpeningFloat in cash; on close the counted cash is compared with the
+// float plus the cash taken during the session.
+type StaffSession struct {
+	bun.BaseModel `bun:"table:staff_sessions,alias:ss"`
+
+	Id           string             `bun:"id,pk" json:"id"`
+	StaffId      string             `bun:"staff_id,notnull" json:"staff_id"`
+	Status       StaffSessionStatus `bun:"status,notnull,default:'OPEN'" json:"status"`
+	OpeningFloat float64            `bun:"opening_float,notnull,default:0,type:decimal(12,2)" json:"opening_float"`
+	ExpectedCash *float64           `bun:"expected_cash,type:decimal(12,2)" json:"expected_cash,omitempty"`
+	CountedCash  *float64           `bun:"counted_cash,type:decimal(12,2)" json:"counted_cash,omitempty"`
+	Variance     *float64           `bun:"variance,type:decimal(12,2)" json:"variance,omitempty"`
+	Note         string             `bun:"note,nullzero" json:"note,omitempty"`
+	ClosedBy     string             `bun:"closed_by,nullzero" json:"closed_by,omitempty"`
+	OpenedAt     time.Time          `bun:"opened_at,nullzero,notnull,default:current_timestamp" json:"opened_at"`
+	ClosedAt     *time.Time         `bun:"closed_at" json:"closed_at,omitempty"`
+	UpdatedAt    *time.Time         `bun:"updated_at" json:"updated_at,omitempty"`
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type WaitlistStatus string
+
+const (
+	WaitlistStatusWaiting   WaitlistStatus = "WAITING"
+	WaitlistStatusOffered   WaitlistStatus = "OFFERED"
+	WaitlistStatusClaimed   WaitlistStatus = "CLAIMED"
+	WaitlistStatusExpired   WaitlistStatus = "EXPIRED"
+	WaitlistStatusCancelled WaitlistStatus = "CANCELLED"
+)
+
+// WaitlistEntry is a user's place in the queue for a showtime. While OFFERED
+// the user holds OfferedSeatIds through the seat hold HoldId until
+// OfferExpiresAt.
+type WaitlistEntry struct {
+	bun.BaseModel `bun:"table:waitlist_entries,alias:wl"`
+
+	Id             string         `bun:"id,pk" json:"id"`
+	UserId         string         `bun:"user_id,notnull" json:"user_id"`
+	ShowtimeId     string         `bun:"showtime_id,notnull" json:"showtime_id"`
+	SeatCount      int            `bun:"seat_count,notnull,default:1" json:"seat_count"`
+	SeatType       string         `bun:"seat_type,nullzero" json:"seat_type,omitempty"`
+	Status         WaitlistStatus `bun:"status,notnull,default:'WAITING'" json:"status"`
+	HoldId         string         `bun:"hold_id,nullzero" json:"hold_id,omitempty"`
+	OfferedSeatIds []string       `bun:"offered_seat_ids,array" json:"offered_seat_ids,omitempty"`
+	OfferExpiresAt *time.Time     `bun:"offer_expires_at" json:"offer_expires_at,omitempty"`
+	BookingId      string         `bun:"booking_id,nullzero" json:"booking_id,omitempty"`
+	CreatedAt      time.Time      `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
+	UpdatedAt      *time.Time     `bun:"updated_at" json:"updated_at,omitempty"`
+}
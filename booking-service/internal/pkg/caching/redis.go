@@ -80,9 +80,13 @@ func deleteKeys(ctx context.Context, client RedisClient, pattern string) {
 		return
 	}
 
-	cursor := uint64(0)
+	var (
+		keys   []string
+		cursor uint64
+		err    error
+	)
 	for {
-		keys, cursor, err := client.Scan(ctx, cursor, pattern, 10000).Result()
+		keys, cursor, err = client.Scan(ctx, cursor, pattern, 10000).Result()
 		for _, key := range keys {
 			err := client.Del(ctx, key).Err()
 			logrus.Println("Deleted key", key, err)
@@ -0,0 +1,211 @@
+// Package idempotency stores the outcome of requests sent with an
+// Idempotency-Key header so retries replay the first response. Each service
+// is its own Go module with no shared library module, so, like caching,
+// pubsub and the proto files, the package is copied into every service that
+// accepts the header. The copies must stay identical; only the HTTP
+// middleware around it is framework specific.
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	HeaderKey      = "Idempotency-Key"
+	HeaderReplayed = "Idempotent-Replayed"
+	MaxKeyLength   = 255
+
+	// leaseTTL bounds how long a key stays locked if its service dies
+	// mid-request. The lease is renewed while the handler is still running.
+	leaseTTL           = 30 * time.Second
+	leaseRenewInterval = leaseTTL / 3
+)
+
+var (
+	ErrKeyTooLong  = fmt.Errorf("idempotency key is too long")
+	ErrInProgress  = fmt.Errorf("a request with this idempotency key is being processed")
+	ErrKeyMismatch = fmt.Errorf("idempotency key was already used with a different request")
+)
+
+// Record is what is kept per key. StatusCode is zero while the first request
+// is still being handled, and Lease identifies the request holding the key.
+type Record struct {
+	RequestHash string `json:"request_hash"`
+	Lease       string `json:"lease,omitempty"`
+	StatusCode  int    `json:"status_code,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+}
+
+// renewLeaseScript extends the processing record only while it still belongs
+// to the lease, so a late renewal cannot keep someone else's key alive.
+var renewLeaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// settleLeaseScript replaces the processing record with the response, or
+// deletes it when ARGV[2] is empty, if the lease still holds the key.
+var settleLeaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) ~= ARGV[1] then
+	return 0
+end
+if ARGV[2] == "" then
+	return redis.call("DEL", KEYS[1])
+end
+redis.call("SET", KEYS[1], ARGV[2], "PX", ARGV[3])
+return 1
+`)
+
+type Store struct {
+	client redis.UniversalClient
+	scope  string
+	ttl    time.Duration
+}
+
+// NewStore keeps responses under "idempotency:<scope>:<user>:<key>" for ttl.
+func NewStore(client redis.UniversalClient, scope string, ttl time.Duration) *Store {
+	return &Store{client: client, scope: scope, ttl: ttl}
+}
+
+// RequestHash fingerprints a request so a key cannot be reused for another one.
+func RequestHash(method, path string, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(method + " " + path + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func (s *Store) key(userId, idempotencyKey string) string {
+	return fmt.Sprintf("idempotency:%s:%s:%s", s.scope, userId, idempotencyKey)
+}
+
+// Begin claims the key for a request. If the key already holds a response for
+// the same request, that record is returned for replay. Otherwise the caller
+// gets a lease, must run the request and then call Complete or Release.
+// Keys are scoped to the user, so the same key from two users never collides.
+func (s *Store) Begin(ctx context.Context, userId, idempotencyKey, requestHash string) (*Lease, *Record, error) {
+	if len(idempotencyKey) > MaxKeyLength {
+		return nil, nil, ErrKeyTooLong
+	}
+
+	key := s.key(userId, idempotencyKey)
+	processing, err := json.Marshal(&Record{RequestHash: requestHash, Lease: uuid.New().String()})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	acquired, err := s.client.SetNX(ctx, key, processing, leaseTTL).Result()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if acquired {
+		lease := &Lease{store: s, key: key, value: string(processing), requestHash: requestHash, done: make(chan struct{})}
+		go lease.keepAlive(context.WithoutCancel(ctx))
+		return lease, nil, nil
+	}
+
+	data, err := s.client.Get(ctx, key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil, ErrInProgress
+		}
+		return nil, nil, err
+	}
+
+	record := new(Record)
+	if err = json.Unmarshal(data, record); err != nil {
+		return nil, nil, err
+	}
+
+	if record.RequestHash != requestHash {
+		return nil, nil, ErrKeyMismatch
+	}
+
+	if record.StatusCode == 0 {
+		return nil, nil, ErrInProgress
+	}
+
+	return nil, record, nil
+}
+
+// Lease is held by the request that claimed a key. It keeps the processing
+// record alive until the request settles it, however long the handler runs.
+type Lease struct {
+	store       *Store
+	key         string
+	value       string
+	requestHash string
+	done        chan struct{}
+	once        sync.Once
+}
+
+func (l *Lease) keepAlive(ctx context.Context) {
+	ticker := time.NewTicker(leaseRenewInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-l.done:
+			return
+		case <-ticker.C:
+			renewLeaseScript.Run(ctx, l.store.client, []string{l.key}, l.value, leaseTTL.Milliseconds())
+		}
+	}
+}
+
+// Complete stores the response for replays. Server errors are not stored so
+// the client can retry them.
+func (l *Lease) Complete(ctx context.Context, statusCode int, contentType string, body []byte) error {
+	if statusCode >= http.StatusInternalServerError {
+		return l.Release(ctx)
+	}
+
+	l.stop()
+
+	completed, err := json.Marshal(&Record{
+		RequestHash: l.requestHash,
+		StatusCode:  statusCode,
+		ContentType: contentType,
+		Body:        body,
+	})
+	if err != nil {
+		l.settle(ctx, "")
+		return err
+	}
+
+	if err = l.settle(ctx, string(completed)); err != nil {
+		l.settle(ctx, "")
+		return err
+	}
+
+	return nil
+}
+
+// Release frees the key without storing a response.
+func (l *Lease) Release(ctx context.Context) error {
+	l.stop()
+	return l.settle(ctx, "")
+}
+
+func (l *Lease) stop() {
+	l.once.Do(func() { close(l.done) })
+}
+
+func (l *Lease) settle(ctx context.Context, value string) error {
+	return settleLeaseScript.Run(ctx, l.store.client, []string{l.key}, l.value, value, l.store.ttl.Milliseconds()).Err()
+}
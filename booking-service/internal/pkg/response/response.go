@@ -13,9 +13,10 @@ type APIResponse struct {
 }
 
 type ErrorResponse struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
-	Error   string `json:"error,omitempty"`
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Error   string      `json:"error,omitempty"`
+	Data    interface{} `json:"data,omitempty"`
 }
 
 func Success(c echo.Context, data interface{}) error {
@@ -54,6 +55,23 @@ func BadRequest(c echo.Context, message string) error {
 	})
 }
 
+func BadRequestWithData(c echo.Context, message string, data interface{}) error {
+	return c.JSON(http.StatusBadRequest, ErrorResponse{
+		Code:    http.StatusBadRequest,
+		Message: "Bad Request",
+		Error:   message,
+		Data:    data,
+	})
+}
+
+func Conflict(c echo.Context, message string) error {
+	return c.JSON(http.StatusConflict, ErrorResponse{
+		Code:    http.StatusConflict,
+		Message: "Conflict",
+		Error:   message,
+	})
+}
+
 func NotFound(c echo.Context, err error) error {
 	return c.JSON(http.StatusNotFound, ErrorResponse{
 		Code:    http.StatusNotFound,
@@ -0,0 +1,89 @@
+package tickettoken
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const minSecretLength = 32
+
+var (
+	ErrMalformedToken   = errors.New("malformed ticket token")
+	ErrInvalidSignature = errors.New("invalid ticket token signature")
+)
+
+type Claims struct {
+	TicketId   string `json:"tid"`
+	BookingId  string `json:"bid"`
+	ShowtimeId string `json:"sid"`
+	SeatId     string `json:"seat"`
+}
+
+// Signer issues and verifies the HMAC-SHA256 tokens encoded in ticket QR
+// codes. A token is "<base64url(claims)>.<base64url(signature)>".
+type Signer struct {
+	secret []byte
+}
+
+func NewSigner(secret string) (*Signer, error) {
+	if len(secret) < minSecretLength {
+		return nil, fmt.Errorf("ticket signing secret must be at least %d bytes", minSecretLength)
+	}
+
+	return &Signer{secret: []byte(secret)}, nil
+}
+
+func (s *Signer) Sign(claims *Claims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal ticket claims: %w", err)
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	signature := base64.RawURLEncoding.EncodeToString(s.sign(encoded))
+
+	return encoded + "." + signature, nil
+}
+
+func (s *Signer) Verify(token string) (*Claims, error) {
+	parts := strings.Split(strings.TrimSpace(token), ".")
+	if len(parts) != 2 {
+		return nil, ErrMalformedToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrMalformedToken
+	}
+
+	if !hmac.Equal(signature, s.sign(parts[0])) {
+		return nil, ErrInvalidSignature
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, ErrMalformedToken
+	}
+
+	claims := new(Claims)
+	if err = json.Unmarshal(payload, claims); err != nil {
+		return nil, ErrMalformedToken
+	}
+
+	if claims.TicketId == "" {
+		return nil, ErrMalformedToken
+	}
+
+	return claims, nil
+}
+
+func (s *Signer) sign(data string) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
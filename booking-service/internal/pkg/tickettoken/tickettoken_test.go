@@ -0,0 +1,127 @@
+package tickettoken
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+func newTestSigner(t *testing.T, secret string) *Signer {
+	t.Helper()
+
+	signer, err := NewSigner(secret)
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+	return signer
+}
+
+func TestNewSigner(t *testing.T) {
+	tests := []struct {
+		name    string
+		secret  string
+		wantErr bool
+	}{
+		{name: "empty secret", secret: "", wantErr: true},
+		{name: "short secret", secret: testSecret[:minSecretLength-1], wantErr: true},
+		{name: "minimum length secret", secret: testSecret},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewSigner(tt.secret)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Expected error=%v, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSignAndVerify(t *testing.T) {
+	signer := newTestSigner(t, testSecret)
+
+	claims := &Claims{
+		TicketId:   "ticket-1",
+		BookingId:  "booking-1",
+		ShowtimeId: "showtime-1",
+		SeatId:     "seat-1",
+	}
+
+	token, err := signer.Sign(claims)
+	if err != nil {
+		t.Fatalf("Failed to sign claims: %v", err)
+	}
+
+	if strings.Count(token, ".") != 1 {
+		t.Fatalf("Expected token of the form payload.signature, got: %s", token)
+	}
+
+	again, err := signer.Sign(claims)
+	if err != nil {
+		t.Fatalf("Failed to sign claims: %v", err)
+	}
+	if again != token {
+		t.Errorf("Expected signing to be deterministic, got %s and %s", token, again)
+	}
+
+	verified, err := signer.Verify("  " + token + "\n")
+	if err != nil {
+		t.Fatalf("Failed to verify token: %v", err)
+	}
+	if *verified != *claims {
+		t.Errorf("Expected claims %+v, got %+v", *claims, *verified)
+	}
+}
+
+func TestVerifyRejectsBadTokens(t *testing.T) {
+	signer := newTestSigner(t, testSecret)
+
+	token, err := signer.Sign(&Claims{TicketId: "ticket-1", BookingId: "booking-1", ShowtimeId: "showtime-1", SeatId: "seat-1"})
+	if err != nil {
+		t.Fatalf("Failed to sign claims: %v", err)
+	}
+	payload, signature, _ := strings.Cut(token, ".")
+
+	otherSigner := newTestSigner(t, strings.ToUpper(testSecret))
+	foreignToken, err := otherSigner.Sign(&Claims{TicketId: "ticket-1"})
+	if err != nil {
+		t.Fatalf("Failed to sign claims: %v", err)
+	}
+
+	forgedPayload := base64.RawURLEncoding.EncodeToString([]byte(`{"tid":"ticket-2","bid":"booking-1","sid":"showtime-1","seat":"seat-1"}`))
+
+	signWith := func(payload string) string {
+		return payload + "." + base64.RawURLEncoding.EncodeToString(signer.sign(payload))
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{name: "empty token", token: "", wantErr: ErrMalformedToken},
+		{name: "missing signature", token: payload, wantErr: ErrMalformedToken},
+		{name: "extra segment", token: token + ".extra", wantErr: ErrMalformedToken},
+		{name: "signature not base64", token: payload + ".!!!", wantErr: ErrMalformedToken},
+		{name: "tampered payload", token: forgedPayload + "." + signature, wantErr: ErrInvalidSignature},
+		{name: "tampered signature", token: payload + "." + strings.Repeat("A", len(signature)), wantErr: ErrInvalidSignature},
+		{name: "signed with another secret", token: foreignToken, wantErr: ErrInvalidSignature},
+		{name: "signed payload not json", token: signWith(base64.RawURLEncoding.EncodeToString([]byte("not json"))), wantErr: ErrMalformedToken},
+		{name: "signed payload without ticket id", token: signWith(base64.RawURLEncoding.EncodeToString([]byte(`{"bid":"booking-1"}`))), wantErr: ErrMalformedToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := signer.Verify(tt.token)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Expected error %v, got: %v", tt.wantErr, err)
+			}
+			if claims != nil {
+				t.Errorf("Expected no claims, got: %+v", claims)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"booking-service/internal/datastore"
+	"booking-service/internal/types"
+)
+
+var ErrInvalidOccupancyGroup = fmt.Errorf("group_by must be one of showtime, room, format")
+
+const (
+	OccupancyGroupShowtime = "showtime"
+	OccupancyGroupRoom     = "room"
+	OccupancyGroupFormat   = "format"
+)
+
+// GetOccupancy compares the tickets sold for showtimes starting in the range
+// with the seat capacity of their rooms, per showtime, room or format. Rows
+// are ordered by occupancy rate, highest first.
+func (s *BookingService) GetOccupancy(ctx context.Context, startDate, endDate, groupBy string, limit int) ([]*types.OccupancyStat, error) {
+	if groupBy == "" {
+		groupBy = OccupancyGroupShowtime
+	}
+	if groupBy != OccupancyGroupShowtime && groupBy != OccupancyGroupRoom && groupBy != OccupancyGroupFormat {
+		return nil, ErrInvalidOccupancyGroup
+	}
+
+	// Showtimes are only limited up front when each one is its own row.
+	queryLimit := 0
+	if groupBy == OccupancyGroupShowtime {
+		queryLimit = limit
+	}
+
+	counts, err := datastore.GetShowtimeOccupancy(ctx, s.roDb, startDate, endDate, queryLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get showtime occupancy: %w", err)
+	}
+
+	if len(counts) == 0 {
+		return []*types.OccupancyStat{}, nil
+	}
+
+	showtimeIds := make([]string, 0, len(counts))
+	for _, count := range counts {
+		showtimeIds = append(showtimeIds, count.ShowtimeId)
+	}
+
+	showtimes, err := s.movieClient.GetShowtimes(ctx, showtimeIds)
+	if err != nil {
+		return nil, err
+	}
+
+	capacities := make(map[string]int, len(showtimes))
+	labels := make(map[string]string, len(showtimes))
+	for _, showtime := range showtimes {
+		capacities[showtime.Id] = int(showtime.SeatCapacity)
+		switch groupBy {
+		case OccupancyGroupShowtime:
+			labels[showtime.Id] = fmt.Sprintf("%s %s %s", showtime.MovieTitle, showtime.ShowtimeDate, showtime.ShowtimeTime)
+		case OccupancyGroupRoom:
+			labels[showtime.RoomId] = fmt.Sprintf("Room %s", showtime.RoomNumber)
+		}
+	}
+
+	stats := make([]*types.OccupancyStat, 0)
+	statsByKey := make(map[string]*types.OccupancyStat)
+	for _, count := range counts {
+		key := count.ShowtimeId
+		switch groupBy {
+		case OccupancyGroupRoom:
+			key = count.RoomId
+		case OccupancyGroupFormat:
+			key = count.Format
+		}
+
+		stat, ok := statsByKey[key]
+		if !ok {
+			label, ok := labels[key]
+			if !ok {
+				label = key
+			}
+			stat = &types.OccupancyStat{Key: key, Label: label}
+			statsByKey[key] = stat
+			stats = append(stats, stat)
+		}
+
+		stat.TotalShowtimes++
+		stat.TicketsSold += count.TicketsSold
+		stat.SeatCapacity += capacities[count.ShowtimeId]
+	}
+
+	for _, stat := range stats {
+		if stat.SeatCapacity > 0 {
+			stat.OccupancyRate = math.Round(float64(stat.TicketsSold)/float64(stat.SeatCapacity)*10000) / 100
+		}
+	}
+
+	sort.SliceStable(stats, func(i, j int) bool {
+		return stats[i].OccupancyRate > stats[j].OccupancyRate
+	})
+
+	if limit > 0 && len(stats) > limit {
+		stats = stats[:limit]
+	}
+
+	return stats, nil
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"strings"
@@ -10,7 +11,10 @@ import (
 	"booking-service/internal/datastore"
 	"booking-service/internal/grpc"
 	"booking-service/internal/models"
+	"booking-service/internal/pkg/pubsub"
+	"booking-service/internal/pkg/tickettoken"
 	"booking-service/internal/types"
+	"booking-service/internal/utils/env"
 	"booking-service/proto/pb"
 
 	"github.com/google/uuid"
@@ -23,18 +27,55 @@ import (
 var (
 	ErrInvalidBookingData = fmt.Errorf("invalid booking data")
 	ErrBookingNotFound    = fmt.Errorf("booking not found")
+	ErrInvalidSeatState   = fmt.Errorf("seat state must be HELD or BOOKED")
 	ErrTicketNotFound     = fmt.Errorf("ticket not found")
 	ErrSeatAlreadyLocked  = fmt.Errorf("one or more seats are already locked")
 	ErrSeatAlreadyBooked  = fmt.Errorf("one or more seats are already booked")
+
+	ErrBookingAccessDenied     = fmt.Errorf("booking does not belong to user")
+	ErrBookingAlreadyCancelled = fmt.Errorf("booking is already cancelled")
+	ErrBookingNotCancellable   = fmt.Errorf("booking cannot be cancelled")
+	ErrBookingAlreadyPaid      = fmt.Errorf("booking has already been paid")
+	ErrBookingNotPending       = fmt.Errorf("booking is not pending")
+	ErrTicketCancelled         = fmt.Errorf("ticket has been cancelled")
+	ErrInvalidTicketCategory   = grpc.ErrInvalidTicketCategory
 )
 
+type SeatConflictError struct {
+	Err     error
+	SeatIds []string
+}
+
+func (e *SeatConflictError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Err.Error(), strings.Join(e.SeatIds, ", "))
+}
+
+func (e *SeatConflictError) Unwrap() error {
+	return e.Err
+}
+
 type BookingService struct {
-	container    *do.Injector
-	db           *bun.DB
-	roDb         *bun.DB
-	movieClient  *grpc.MovieClient
-	outboxClient *grpc.OutboxClient
-	redisClient  redis.UniversalClient
+	container     *do.Injector
+	db            *bun.DB
+	roDb          *bun.DB
+	movieClient   *grpc.MovieClient
+	paymentClient *grpc.PaymentClient
+	redisClient   redis.UniversalClient
+	pubsub        pubsub.PubSub
+
+	ticketSigner *tickettoken.Signer
+
+	holdTTL         time.Duration
+	maxHoldsPerUser int
+	doorOpenBefore  time.Duration
+	exchangeCutoff  time.Duration
+
+	waitlistOfferTTL time.Duration
+
+	loyaltyPointValue     float64
+	loyaltyMaxRedeemRatio float64
+
+	policy bookingPolicy
 }
 
 func NewBookingService(container *do.Injector) (*BookingService, error) {
@@ -53,7 +94,7 @@ func NewBookingService(container *do.Injector) (*BookingService, error) {
 		return nil, err
 	}
 
-	outboxClient, err := do.Invoke[*grpc.OutboxClient](container)
+	paymentClient, err := do.Invoke[*grpc.PaymentClient](container)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +104,41 @@ func NewBookingService(container *do.Injector) (*BookingService, error) {
 		return nil, err
 	}
 
+	ticketSigner, err := do.Invoke[*tickettoken.Signer](container)
+	if err != nil {
+		return nil, err
+	}
+
+	pubsub, err := do.Invoke[pubsub.PubSub](container)
+	if err != nil {
+		return nil, err
+	}
+
 	return &BookingService{
-		container:    container,
-		db:           db,
-		roDb:         roDb,
-		movieClient:  movieClient,
-		outboxClient: outboxClient,
-		redisClient:  redisClient,
+		container:       container,
+		db:              db,
+		roDb:            roDb,
+		movieClient:     movieClient,
+		paymentClient:   paymentClient,
+		redisClient:     redisClient,
+		pubsub:          pubsub,
+		ticketSigner:    ticketSigner,
+		holdTTL:         env.GetDuration("SEAT_HOLD_TTL", 10*time.Minute),
+		maxHoldsPerUser: env.GetInt("SEAT_HOLD_MAX_PER_USER", 3),
+		doorOpenBefore:  env.GetDuration("TICKET_DOOR_OPEN_BEFORE", 30*time.Minute),
+		exchangeCutoff:  env.GetDuration("BOOKING_EXCHANGE_CUTOFF", 2*time.Hour),
+
+		waitlistOfferTTL: env.GetDuration("WAITLIST_OFFER_TTL", 10*time.Minute),
+
+		loyaltyPointValue:     float64(env.GetInt("LOYALTY_POINT_VALUE", 10)),
+		loyaltyMaxRedeemRatio: float64(min(env.GetInt("LOYALTY_MAX_REDEEM_PERCENT", 50), 100)) / 100,
+
+		policy: bookingPolicy{
+			maxSeatsPerBooking:  env.GetInt("BOOKING_MAX_SEATS", 8),
+			maxPendingBookings:  env.GetInt("BOOKING_MAX_PENDING_PER_USER", 2),
+			maxSeatsPerShowtime: env.GetInt("BOOKING_MAX_SEATS_PER_SHOWTIME", 10),
+			seatGapRule:         env.GetInt("BOOKING_SEAT_GAP_RULE", 1) != 0,
+		},
 	}, nil
 }
 
@@ -182,157 +251,583 @@ func (s *BookingService) isValidStatus(status string) bool {
 	}
 }
 
-func (s *BookingService) checkSeatAvailability(ctx context.Context, showtimeId string, seatIds []string, userId string) error {
-	for _, seatId := range seatIds {
-		concurrentLockKey := fmt.Sprintf("seat:concurrent_lock:%s:%s", showtimeId, seatId)
-		lockValue, err := s.redisClient.Get(ctx, concurrentLockKey).Result()
-		if err != nil && !errors.Is(err, redis.Nil) {
-			return err
-		}
-		if lockValue != "" && lockValue != userId {
-			return ErrSeatAlreadyLocked
-		}
-
-		seatLockKey := fmt.Sprintf("seat_lock:%s:%s", showtimeId, seatId)
-		exists, err := s.redisClient.Exists(ctx, seatLockKey).Result()
-		if err != nil {
-			return err
-		}
-		if exists > 0 {
-			return ErrSeatAlreadyBooked
-		}
+// bookedSeatsConflict reports which seats lost the race to another booking,
+// reading from the primary since the replica may not have the winner yet.
+func (s *BookingService) bookedSeatsConflict(ctx context.Context, showtimeId string, seatIds []string) error {
+	err := s.checkBookedSeats(ctx, s.db, showtimeId, seatIds)
+	if err == nil {
+		return &SeatConflictError{Err: ErrSeatAlreadyBooked, SeatIds: seatIds}
 	}
+	return err
+}
 
-	bookedSeats, err := datastore.GetBookedSeatsForShowtime(ctx, s.roDb, showtimeId)
+func (s *BookingService) checkBookedSeats(ctx context.Context, db bun.IDB, showtimeId string, seatIds []string) error {
+	bookedSeats, err := datastore.GetBookedSeatsForShowtime(ctx, db, showtimeId)
 	if err != nil {
 		return fmt.Errorf("failed to check booked seats: %w", err)
 	}
 
+	conflicts := make([]string, 0)
 	for _, seatId := range seatIds {
 		if _, alreadyBooked := bookedSeats[seatId]; alreadyBooked {
-			return ErrSeatAlreadyBooked
+			conflicts = append(conflicts, seatId)
 		}
 	}
 
+	if len(conflicts) > 0 {
+		return &SeatConflictError{Err: ErrSeatAlreadyBooked, SeatIds: conflicts}
+	}
+
 	return nil
 }
 
-func (s *BookingService) CreateBooking(ctx context.Context, userId string, showtimeId string, seatIds []string, totalAmount int, bookingType models.BookingType) (*models.Booking, error) {
-	if err := s.checkSeatAvailability(ctx, showtimeId, seatIds, userId); err != nil {
+const bookingLockDuration = 5 * time.Minute
+
+// CreateBooking locks the seats and books them. Online bookings are checked
+// against the per-user booking limits before any seat is locked; box-office
+// bookings are exempt. Every booking must respect the seat adjacency rules.
+func (s *BookingService) CreateBooking(ctx context.Context, userId string, showtimeId string, seatIds []string, seatCategories map[string]string, concessions []types.ConcessionOrder, totalAmount int, bookingType models.BookingType, promoCode string, redeemPoints int) (*models.Booking, error) {
+	if bookingType == models.BookingTypeOffline {
+		if _, err := s.requireOpenStaffSession(ctx, s.roDb, userId, false); err != nil {
+			return nil, err
+		}
+	} else if err := s.checkBookingLimits(ctx, s.roDb, userId, showtimeId, seatIds); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkSeatAdjacency(ctx, userId, showtimeId, seatIds); err != nil {
+		return nil, err
+	}
+
+	// The seats are locked for the booking itself, so they never mix with
+	// the user's holds.
+	bookingId := uuid.New().String()
+	err := s.acquireDistributedSeatLocks(ctx, showtimeId, seatIds, bookingId, bookingLockDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.checkBookedSeats(ctx, s.roDb, showtimeId, seatIds); err != nil {
+		s.releaseDistributedSeatLocks(ctx, showtimeId, bookingId, seatIds)
+		return nil, err
+	}
+
+	return s.createBooking(ctx, bookingId, userId, showtimeId, seatIds, seatCategories, concessions, nil, totalAmount, bookingType, promoCode, redeemPoints)
+}
+
+// CreateBookingFromHold books the seats of an existing hold at the prices
+// quoted when the hold was taken, adjusted for any ticket categories. The hold
+// already owns the seat locks, so availability is not checked again; once
+// the booking exists they are handed over to it.
+func (s *BookingService) CreateBookingFromHold(ctx context.Context, userId string, holdId string, seatCategories map[string]string, concessions []types.ConcessionOrder, totalAmount int, bookingType models.BookingType, promoCode string, redeemPoints int) (*models.Booking, error) {
+	if bookingType == models.BookingTypeOffline {
+		if _, err := s.requireOpenStaffSession(ctx, s.roDb, userId, false); err != nil {
+			return nil, err
+		}
+	}
+
+	hold, err := s.claimSeatHold(ctx, userId, holdId, bookingLockDuration)
+	if err != nil {
 		return nil, err
 	}
 
-	lockDuration := 5 * time.Minute
-	_, err := s.acquireDistributedSeatLocks(ctx, showtimeId, seatIds, userId, lockDuration)
+	booking, err := s.createBooking(ctx, uuid.New().String(), userId, hold.ShowtimeId, hold.SeatIds, seatCategories, concessions, hold, totalAmount, bookingType, promoCode, redeemPoints)
 	if err != nil {
 		return nil, err
 	}
 
-	seatsWithPrice, err := s.movieClient.GetSeatsWithPrice(ctx, showtimeId, seatIds)
+	lost, err := s.claimSeatStates(ctx, hold.ShowtimeId, models.SeatStateHeld, booking.Id, hold.Id, hold.SeatIds, bookingLockDuration)
 	if err != nil {
+		logrus.WithError(err).WithField("booking_id", booking.Id).Error("Failed to hand seat locks to booking")
+	} else if len(lost) > 0 {
+		logrus.WithField("booking_id", booking.Id).WithField("seat_ids", lost).Error("Seat locks lost before booking took them over")
+	}
+
+	if err = s.deleteSeatHold(ctx, hold); err != nil {
+		logrus.WithError(err).WithField("hold_id", hold.Id).Error("Failed to delete converted seat hold")
+	}
+
+	return booking, nil
+}
+
+// createBooking prices the seats, from the quote if one is given and for
+// their ticket categories, adds the concessions, applies the promo code and
+// loyalty points if given and persists the booking. The promo code discounts
+// the seats only. Both are redeemed, and the concessions' stock is taken from
+// the showtime's cinema, in the booking transaction so usage limits, point
+// balances and stock hold under concurrent bookings. Box-office bookings are
+// recorded against the staff member's open drawer session. When the seats
+// come from a hold they are priced from its quote, and a waitlist offer hold
+// is claimed in the same transaction, failing the booking if the offer
+// expired first.
+func (s *BookingService) createBooking(ctx context.Context, bookingId, userId string, showtimeId string, seatIds []string, seatCategories map[string]string, concessionOrders []types.ConcessionOrder, hold *models.SeatHold, totalAmount int, bookingType models.BookingType, promoCode string, redeemPoints int) (*models.Booking, error) {
+	if redeemPoints < 0 || (redeemPoints > 0 && bookingType != models.BookingTypeOnline) {
+		return nil, ErrInvalidBookingData
+	}
+
+	quoteId, seatOwner := "", bookingId
+	if hold != nil {
+		quoteId, seatOwner = hold.QuoteId, hold.Id
+	}
+
+	seatsWithPrice, err := s.movieClient.QuoteSeatPrices(ctx, showtimeId, seatIds, seatCategories, quoteId, 0)
+	if err != nil {
+		if errors.Is(err, ErrInvalidTicketCategory) {
+			return nil, err
+		}
 		return nil, fmt.Errorf("failed to validate seat prices: %w", err)
 	}
 
+	seatPrices := make(map[string]*pb.SeatPriceData, len(seatsWithPrice.Data))
 	for _, seat := range seatsWithPrice.Data {
 		if !seat.Available {
 			return nil, fmt.Errorf("seat %s (%s) is not available", seat.SeatNumber, seat.SeatId)
 		}
+		seatPrices[seat.SeatId] = seat
 	}
 
-	expectedTotal := seatsWithPrice.TotalAmount
+	promoCode = normalizePromoCode(promoCode)
 
-	if bookingType == models.BookingTypeOnline {
-		clientTotal := float64(totalAmount)
-		if clientTotal < expectedTotal-0.01 || clientTotal > expectedTotal+0.01 {
-			return nil, fmt.Errorf("invalid total amount: expected %.2f, got %.2f", expectedTotal, clientTotal)
+	var showtime *pb.ShowtimeData
+	if promoCode != "" || len(concessionOrders) > 0 {
+		showtime, err = s.movieClient.GetShowtime(ctx, showtimeId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get showtime: %w", err)
+		}
+	}
+
+	var concessions []*models.BookingConcession
+	var concessionAmount float64
+	if len(concessionOrders) > 0 {
+		concessions, concessionAmount, err = s.priceConcessions(ctx, s.roDb, showtimeCinemaId(showtime), concessionOrders)
+		if err != nil {
+			return nil, err
 		}
 	}
 
+	var promoTarget *promotionTarget
+	if promoCode != "" {
+		promoTarget = newPromotionTarget(userId, showtime, seatsWithPrice)
+	}
+
 	booking := &models.Booking{
-		Id:          uuid.New().String(),
+		Id:          bookingId,
 		UserId:      userId,
 		ShowtimeId:  showtimeId,
-		TotalAmount: expectedTotal,
+		TotalAmount: roundAmount(seatsWithPrice.TotalAmount + concessionAmount),
 		Status:      models.BookingStatusPending,
 		BookingType: bookingType,
+
+		ConcessionAmount: concessionAmount,
 	}
 
-	eventData := &models.BookingEventData{
-		BookingId:   booking.Id,
-		UserId:      booking.UserId,
-		ShowtimeId:  booking.ShowtimeId,
-		SeatIds:     seatIds,
-		TotalAmount: booking.TotalAmount,
-		Status:      booking.Status,
+	for _, concession := range concessions {
+		concession.BookingId = booking.Id
+	}
+
+	bookingSeats := make([]*models.BookingSeat, 0, len(seatIds))
+	for _, seatId := range seatIds {
+		bookingSeat := &models.BookingSeat{
+			Id:         uuid.New().String(),
+			BookingId:  booking.Id,
+			ShowtimeId: showtimeId,
+			SeatId:     seatId,
+			Status:     models.BookingSeatStatusActive,
+		}
+		if seat, ok := seatPrices[seatId]; ok {
+			bookingSeat.TicketCategory = seat.TicketCategory
+			bookingSeat.RequiresIdCheck = seat.RequiresIdCheck
+			bookingSeat.MinAge, bookingSeat.MaxAge = int(seat.MinAge), int(seat.MaxAge)
+		}
+		bookingSeats = append(bookingSeats, bookingSeat)
 	}
 
 	err = s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
-		return datastore.CreateBooking(ctx, tx, booking)
+		if bookingType == models.BookingTypeOffline {
+			session, err := s.requireOpenStaffSession(ctx, tx, userId, true)
+			if err != nil {
+				return err
+			}
+			booking.StaffId = userId
+			booking.StaffSessionId = session.Id
+		} else {
+			if err := datastore.LockUserBookings(ctx, tx, userId); err != nil {
+				return err
+			}
+			if err := s.checkBookingLimits(ctx, tx, userId, showtimeId, seatIds); err != nil {
+				return err
+			}
+		}
+
+		var redemption *models.PromotionRedemption
+		if promoTarget != nil {
+			promotion, discount, err := s.resolvePromotion(ctx, tx, promoCode, promoTarget, true)
+			if err != nil {
+				return err
+			}
+
+			booking.PromoCode = promotion.Code
+			booking.DiscountAmount = discount
+			booking.TotalAmount = roundAmount(seatsWithPrice.TotalAmount + concessionAmount - discount)
+
+			redemption = &models.PromotionRedemption{
+				Id:             uuid.New().String(),
+				PromotionId:    promotion.Id,
+				BookingId:      booking.Id,
+				UserId:         userId,
+				DiscountAmount: discount,
+				Status:         models.PromotionRedemptionStatusActive,
+			}
+		}
+
+		if redeemPoints > 0 {
+			pointsDiscount, err := s.pointsDiscountFor(redeemPoints, booking.TotalAmount)
+			if err != nil {
+				return err
+			}
+
+			booking.PointsRedeemed = redeemPoints
+			booking.PointsDiscount = pointsDiscount
+			booking.TotalAmount = roundAmount(booking.TotalAmount - pointsDiscount)
+		}
+
+		if bookingType == models.BookingTypeOnline {
+			clientTotal := float64(totalAmount)
+			if clientTotal < booking.TotalAmount-0.01 || clientTotal > booking.TotalAmount+0.01 {
+				return fmt.Errorf("invalid total amount: expected %.2f, got %.2f", booking.TotalAmount, clientTotal)
+			}
+		}
+
+		if err := datastore.CreateBooking(ctx, tx, booking); err != nil {
+			return err
+		}
+
+		if hold != nil && hold.WaitlistEntryId != "" {
+			claimed, err := datastore.ClaimWaitlistOffer(ctx, tx, hold.Id, booking.Id, time.Now())
+			if err != nil {
+				return err
+			}
+			if !claimed {
+				return ErrSeatHoldExpired
+			}
+		}
+
+		if err := datastore.CreateBookingSeats(ctx, tx, bookingSeats); err != nil {
+			return err
+		}
+
+		if err := datastore.CreateBookingConcessions(ctx, tx, concessions); err != nil {
+			return err
+		}
+
+		if err := s.takeConcessionStock(ctx, tx, concessions); err != nil {
+			return err
+		}
+
+		if redemption != nil {
+			if err := datastore.RedeemPromotion(ctx, tx, redemption); err != nil {
+				return err
+			}
+		}
+
+		if booking.PointsRedeemed > 0 {
+			_, err := s.postLoyaltyTransaction(ctx, tx, userId, booking.Id, models.LoyaltyTransactionRedeem, -booking.PointsRedeemed,
+				fmt.Sprintf("Redeemed for booking %s", booking.Id))
+			if err != nil {
+				return err
+			}
+		}
+
+		eventData := &models.BookingEventData{
+			BookingId:   booking.Id,
+			UserId:      booking.UserId,
+			ShowtimeId:  booking.ShowtimeId,
+			SeatIds:     seatIds,
+			TotalAmount: booking.TotalAmount,
+			Status:      booking.Status,
+		}
+
+		return datastore.CreateOutboxEvent(ctx, tx, models.EventTypeBookingCreated, eventData)
 	})
 	if err != nil {
+		if errors.Is(err, datastore.ErrBookingSeatTaken) {
+			s.releaseDistributedSeatLocks(ctx, showtimeId, seatOwner, seatIds)
+			return nil, s.bookedSeatsConflict(ctx, showtimeId, seatIds)
+		}
 		return nil, err
 	}
 
-	_ = s.outboxClient.CreateOutboxEvent(ctx, string(models.EventTypeBookingCreated), eventData)
-
 	return booking, nil
 }
 
-func (s *BookingService) acquireDistributedSeatLocks(ctx context.Context, showtimeId string, seatIds []string, userId string, lockDuration time.Duration) ([]string, error) {
-	lockedKeys := make([]string, 0)
+// acquireDistributedSeatLocks holds the seats for owner, the hold or booking
+// taking them.
+func (s *BookingService) acquireDistributedSeatLocks(ctx context.Context, showtimeId string, seatIds []string, owner string, lockDuration time.Duration) error {
+	result, err := s.runAcquireSeatStates(ctx, showtimeId, owner, seatIds, lockDuration)
+	if err != nil {
+		return fmt.Errorf("failed to acquire distributed seat locks: %w", err)
+	}
 
-	for _, seatId := range seatIds {
-		lockKey := fmt.Sprintf("seat:concurrent_lock:%s:%s", showtimeId, seatId)
+	if len(result) != 2 {
+		return fmt.Errorf("unexpected seat lock script result: %v", result)
+	}
 
-		acquired, err := s.redisClient.SetNX(ctx, lockKey, userId, lockDuration).Result()
-		if err != nil {
-			s.releaseDistributedSeatLocks(ctx, lockedKeys)
-			return nil, fmt.Errorf("failed to acquire distributed lock for seat %s: %w", seatId, err)
-		}
+	lockedSeats := s.seatIdsAtPositions(result[0], seatIds)
+	bookedSeats := s.seatIdsAtPositions(result[1], seatIds)
+
+	if len(bookedSeats) > 0 {
+		return &SeatConflictError{Err: ErrSeatAlreadyBooked, SeatIds: append(bookedSeats, lockedSeats...)}
+	}
+
+	if len(lockedSeats) > 0 {
+		return &SeatConflictError{Err: ErrSeatAlreadyLocked, SeatIds: lockedSeats}
+	}
+
+	expiresAt := time.Now().Add(lockDuration)
+	s.publishSeatStates(ctx, showtimeId, seatIds, models.SeatStateHeld, &expiresAt)
+
+	return nil
+}
 
-		if !acquired {
-			s.releaseDistributedSeatLocks(ctx, lockedKeys)
-			return nil, fmt.Errorf("%w: seat %s is currently being processed", ErrSeatAlreadyLocked, seatId)
+func (s *BookingService) seatIdsAtPositions(positions interface{}, seatIds []string) []string {
+	values, _ := positions.([]interface{})
+
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		position, ok := value.(int64)
+		if !ok || position < 1 || int(position) > len(seatIds) {
+			continue
 		}
-		lockedKeys = append(lockedKeys, lockKey)
+		result = append(result, seatIds[position-1])
+	}
+
+	return result
+}
+
+// releaseDistributedSeatLocks releases the seats still owned by owner and
+// tells seat map subscribers which of them are free again.
+func (s *BookingService) releaseDistributedSeatLocks(ctx context.Context, showtimeId, owner string, seatIds []string) {
+	released, err := s.releaseOwnedSeatStates(ctx, showtimeId, owner, seatIds)
+	if err != nil {
+		logrus.WithError(err).WithField("showtime_id", showtimeId).Error("Failed to release distributed lock")
+		return
 	}
 
-	return lockedKeys, nil
+	s.publishSeatStates(ctx, showtimeId, released, models.SeatStateReleased, nil)
+}
+
+// releaseBookingSeatLocks releases the seats the booking owns.
+func (s *BookingService) releaseBookingSeatLocks(ctx context.Context, booking *models.Booking, seatIds []string) {
+	s.releaseDistributedSeatLocks(ctx, booking.ShowtimeId, booking.Id, seatIds)
 }
 
-func (s *BookingService) releaseDistributedSeatLocks(ctx context.Context, lockKeys []string) {
-	for _, key := range lockKeys {
-		if err := s.redisClient.Del(ctx, key).Err(); err != nil {
-			logrus.WithError(err).WithField("lock_key", key).Error("Failed to release distributed lock")
+// releaseBookingGiftCards returns the gift card amounts held for an unpaid
+// booking. It runs while the booking is locked, so a failure aborts the
+// cancellation instead of leaving the amounts held.
+func (s *BookingService) releaseBookingGiftCards(ctx context.Context, tx bun.Tx, bookingId string) error {
+	amount, err := datastore.GetBookingGiftCardAmount(ctx, tx, bookingId)
+	if err != nil {
+		return err
+	}
+	if amount == 0 {
+		return nil
+	}
+
+	_, err = s.paymentClient.ReleaseGiftCards(ctx, bookingId)
+	return err
+}
+
+// CancelBooking moves a booking to CANCELLED, invalidates its tickets and
+// releases its seats and any gift card amounts held for it. Customers may only
+// cancel their own PENDING bookings; staff can void any booking that is not
+// already cancelled.
+func (s *BookingService) CancelBooking(ctx context.Context, bookingId, userId string, isStaff bool, reason string) (*models.Booking, error) {
+	return s.cancelBooking(ctx, bookingId, userId, isStaff, reason, nil)
+}
+
+// cancelBooking cancels the booking and, when audit is given, records the
+// change in the booking's audit trail in the same transaction.
+func (s *BookingService) cancelBooking(ctx context.Context, bookingId, userId string, isStaff bool, reason string, audit *models.BookingAuditLog) (*models.Booking, error) {
+	if bookingId == "" {
+		return nil, ErrInvalidBookingData
+	}
+
+	var booking *models.Booking
+	var seatIds []string
+
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var err error
+		booking, err = datastore.GetBookingByIdForUpdate(ctx, tx, bookingId)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrBookingNotFound
+			}
+			return err
+		}
+
+		if !isStaff && booking.UserId != userId {
+			return ErrBookingAccessDenied
+		}
+
+		switch booking.Status {
+		case models.BookingStatusCancelled:
+			return ErrBookingAlreadyCancelled
+		case models.BookingStatusConfirmed:
+			if !isStaff {
+				return ErrBookingNotCancellable
+			}
+		}
+
+		// Expire the payment first, as the sweeper does, so a late webhook
+		// cannot confirm the booking once its seats are released. It is
+		// committed on its own: releasing gift cards below locks the same
+		// payment row in payment-service.
+		if booking.Status == models.BookingStatusPending {
+			paid, err := datastore.ExpireBookingPayment(ctx, s.db, booking.Id)
+			if err != nil {
+				return err
+			}
+			if paid {
+				return ErrBookingAlreadyPaid
+			}
+		}
+
+		seatIds, err = datastore.GetSeatIdsByBookingId(ctx, tx, booking.Id)
+		if err != nil {
+			return err
+		}
+
+		if err = datastore.UpdateBookingStatus(ctx, tx, booking.Id, models.BookingStatusCancelled); err != nil {
+			return err
+		}
+
+		if err = datastore.ReleaseBookingSeats(ctx, tx, booking.Id); err != nil {
+			return err
+		}
+
+		if _, err = datastore.CancelTicketsByBookingId(ctx, tx, booking.Id); err != nil {
+			return err
+		}
+
+		if err = s.releaseBookingConcessions(ctx, tx, booking.Id); err != nil {
+			return err
+		}
+
+		if err = datastore.ReleasePromotionRedemption(ctx, tx, booking.Id); err != nil {
+			return err
 		}
+
+		if err = s.reverseLoyaltyPoints(ctx, tx, booking); err != nil {
+			return err
+		}
+
+		if booking.Status == models.BookingStatusPending {
+			if err = s.releaseBookingGiftCards(ctx, tx, booking.Id); err != nil {
+				return err
+			}
+		}
+
+		if audit != nil {
+			audit.BookingId = booking.Id
+			audit.FromStatus = booking.Status
+			audit.ToStatus = models.BookingStatusCancelled
+			if err = datastore.CreateBookingAuditLog(ctx, tx, audit); err != nil {
+				return err
+			}
+		}
+
+		eventData := &models.SeatReleasedEventData{
+			BookingId:  booking.Id,
+			UserId:     booking.UserId,
+			ShowtimeId: booking.ShowtimeId,
+			SeatIds:    seatIds,
+			Reason:     reason,
+		}
+
+		return datastore.CreateOutboxEvent(ctx, tx, models.EventTypeSeatReleased, eventData)
+	})
+	if err != nil {
+		return nil, err
 	}
+
+	booking.Status = models.BookingStatusCancelled
+
+	s.releaseBookingSeatLocks(ctx, booking, seatIds)
+
+	return booking, nil
 }
 
-func (s *BookingService) GetBookingByID(ctx context.Context, bookingId string) (*models.Booking, error) {
-	booking, err := datastore.GetBookingById(ctx, s.roDb, bookingId)
+// GetBookingByID returns the booking with its tickets. Ticket tokens are only
+// attached for the booking owner and staff.
+func (s *BookingService) GetBookingByID(ctx context.Context, bookingId, userId string, isStaff bool) (*models.Booking, error) {
+	booking, err := datastore.GetBookingByIdWithTickets(ctx, s.roDb, bookingId)
 	if err != nil {
 		return nil, ErrBookingNotFound
 	}
 
+	if isStaff || booking.UserId == userId {
+		if err = s.signTicketTokens(booking.Ticket); err != nil {
+			return nil, err
+		}
+	}
+
 	return booking, nil
 }
 
-func (s *BookingService) UpdateBookingStatus(ctx context.Context, bookingId string, status string) (string, error) {
+// UpdateBookingStatus sets a booking's status on behalf of the payment flow.
+// Confirming an online booking earns its owner loyalty points, which are
+// returned, and readies its concessions for pickup; cancelling gives back its
+// promo code use, points and concession stock. Only PENDING bookings can be
+// confirmed.
+func (s *BookingService) UpdateBookingStatus(ctx context.Context, bookingId string, status string) (string, int, error) {
 	if !s.isValidStatus(status) {
-		return "", fmt.Errorf("invalid booking status: %s", status)
+		return "", 0, fmt.Errorf("invalid booking status: %s", status)
 	}
 
-	err := datastore.UpdateBookingStatus(ctx, s.db, bookingId, models.BookingStatus(status))
-	if err != nil {
-		return "", fmt.Errorf("failed to update booking status: %w", err)
-	}
+	var booking *models.Booking
+	var pointsEarned int
 
-	booking, err := datastore.GetBookingById(ctx, s.roDb, bookingId)
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var err error
+		booking, err = datastore.GetBookingByIdForUpdate(ctx, tx, bookingId)
+		if err != nil {
+			return err
+		}
+
+		// A payment completing after the booking was cancelled must not
+		// bring it back: its seats may already be sold again.
+		if models.BookingStatus(status) == models.BookingStatusConfirmed && booking.Status != models.BookingStatusPending {
+			return ErrBookingNotPending
+		}
+
+		if err = datastore.UpdateBookingStatus(ctx, tx, bookingId, models.BookingStatus(status)); err != nil {
+			return err
+		}
+
+		switch models.BookingStatus(status) {
+		case models.BookingStatusConfirmed:
+			pointsEarned, err = s.earnLoyaltyPoints(ctx, tx, booking)
+			if err != nil {
+				return err
+			}
+			return s.confirmBookingConcessions(ctx, tx, bookingId)
+		case models.BookingStatusCancelled:
+			if err = s.releaseBookingConcessions(ctx, tx, bookingId); err != nil {
+				return err
+			}
+			if err = datastore.ReleasePromotionRedemption(ctx, tx, bookingId); err != nil {
+				return err
+			}
+			return s.reverseLoyaltyPoints(ctx, tx, booking)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return "", fmt.Errorf("failed to get booking: %w", err)
+		return "", 0, fmt.Errorf("failed to update booking status: %w", err)
 	}
 
-	return booking.UserId, nil
+	return booking.UserId, pointsEarned, nil
 }
 
 type BookingDetailsResult struct {
@@ -356,6 +851,16 @@ type ShowtimeDetail struct {
 }
 
 func (s *BookingService) CreateTicketsForBooking(ctx context.Context, bookingId, showtimeId string, seatIds []string) (int, error) {
+	bookingSeats, err := datastore.GetActiveBookingSeats(ctx, s.db, bookingId)
+	if err != nil {
+		return 0, err
+	}
+
+	seatMap := make(map[string]*models.BookingSeat, len(bookingSeats))
+	for _, bookingSeat := range bookingSeats {
+		seatMap[bookingSeat.SeatId] = bookingSeat
+	}
+
 	tickets := make([]*models.Ticket, 0, len(seatIds))
 	for _, seatId := range seatIds {
 		ticket := &models.Ticket{
@@ -365,9 +870,18 @@ func (s *BookingService) CreateTicketsForBooking(ctx context.Context, bookingId,
 			SeatId:     seatId,
 			Status:     models.TicketStatusUnused,
 		}
+		if bookingSeat, ok := seatMap[seatId]; ok {
+			ticket.TicketCategory = bookingSeat.TicketCategory
+			ticket.RequiresIdCheck = bookingSeat.RequiresIdCheck
+			ticket.MinAge, ticket.MaxAge = bookingSeat.MinAge, bookingSeat.MaxAge
+		}
 		tickets = append(tickets, ticket)
 	}
 
+	if err := s.signTicketTokens(tickets); err != nil {
+		return 0, err
+	}
+
 	if err := datastore.CreateTickets(ctx, s.db, tickets); err != nil {
 		return 0, fmt.Errorf("failed to create tickets: %w", err)
 	}
@@ -502,6 +1016,11 @@ func (s *BookingService) enrichTicketsWithBookingData(ctx context.Context, ticke
 			Status:     string(ticket.Status),
 			CreatedAt:  ticket.CreatedAt,
 			UpdatedAt:  ticket.UpdatedAt,
+
+			TicketCategory:  ticket.TicketCategory,
+			RequiresIdCheck: ticket.RequiresIdCheck,
+			MinAge:          ticket.MinAge,
+			MaxAge:          ticket.MaxAge,
 		}
 
 		if booking, exists := bookingMap[ticket.BookingId]; exists {
@@ -527,18 +1046,32 @@ func (s *BookingService) enrichTicketsWithBookingData(ctx context.Context, ticke
 	return enrichedTickets, nil
 }
 
-func (s *BookingService) MarkTicketAsUsed(ctx context.Context, ticketId string) error {
+func (s *BookingService) MarkTicketAsUsed(ctx context.Context, ticketId, staffId string) error {
 	if ticketId == "" {
 		return ErrInvalidBookingData
 	}
 
 	ticket, err := datastore.GetTicketById(ctx, s.db, ticketId)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTicketNotFound
+		}
 		return fmt.Errorf("failed to get ticket: %w", err)
 	}
-	if ticket == nil {
-		return ErrTicketNotFound
+	if ticket.Status == models.TicketStatusCancelled {
+		return ErrTicketCancelled
 	}
 
-	return datastore.UpdateTicketStatus(ctx, s.db, ticketId, models.TicketStatusUsed)
+	marked, err := datastore.MarkTicketUsed(ctx, s.db, ticketId, staffId)
+	if err != nil {
+		return err
+	}
+	if !marked {
+		s.recordCheckin(ctx, ticket.Id, ticket.ShowtimeId, staffId, "", types.TicketScanAlreadyUsed)
+		return ErrTicketAlreadyUsed
+	}
+
+	s.recordCheckin(ctx, ticket.Id, ticket.ShowtimeId, staffId, "", types.TicketScanAdmitted)
+
+	return nil
 }
@@ -0,0 +1,217 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"booking-service/internal/datastore"
+	"booking-service/internal/models"
+	"booking-service/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+var (
+	ErrInvalidSearchCursor   = fmt.Errorf("invalid search cursor")
+	ErrInvalidSearchQuery    = fmt.Errorf("invalid search query")
+	ErrAuditReasonRequired   = fmt.Errorf("a reason is required")
+	ErrBookingNotConfirmable = fmt.Errorf("only pending bookings can be confirmed")
+)
+
+type bookingSearchCursor struct {
+	CreatedAt   *time.Time `json:"c,omitempty"`
+	TotalAmount *float64   `json:"a,omitempty"`
+	Id          string     `json:"i"`
+}
+
+func encodeBookingSearchCursor(sortBy string, booking *models.Booking) string {
+	cursor := bookingSearchCursor{Id: booking.Id}
+	if sortBy == datastore.BookingSortTotalAmount {
+		cursor.TotalAmount = &booking.TotalAmount
+	} else {
+		cursor.CreatedAt = &booking.CreatedAt
+	}
+
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeBookingSearchCursor(value, sortBy string, filter *datastore.BookingSearchFilter) error {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return ErrInvalidSearchCursor
+	}
+
+	cursor := new(bookingSearchCursor)
+	if err = json.Unmarshal(data, cursor); err != nil || cursor.Id == "" {
+		return ErrInvalidSearchCursor
+	}
+
+	if sortBy == datastore.BookingSortTotalAmount {
+		if cursor.TotalAmount == nil {
+			return ErrInvalidSearchCursor
+		}
+		filter.AfterAmount = cursor.TotalAmount
+	} else {
+		if cursor.CreatedAt == nil {
+			return ErrInvalidSearchCursor
+		}
+		filter.AfterCreatedAt = cursor.CreatedAt
+	}
+	filter.AfterId = cursor.Id
+
+	return nil
+}
+
+// SearchBookings is the admin booking search. Pages are cursor based: pass
+// the returned NextCursor to continue with the same filters and sort.
+func (s *BookingService) SearchBookings(ctx context.Context, query *types.BookingSearchQuery) (*types.BookingSearchResult, error) {
+	_, size, _, _ := s.normalizePagination(1, query.Size)
+
+	filter := &datastore.BookingSearchFilter{
+		Email:      strings.TrimSpace(query.Email),
+		UserId:     query.UserId,
+		MovieId:    query.MovieId,
+		ShowtimeId: query.ShowtimeId,
+		From:       query.From,
+		To:         query.To,
+		MinAmount:  query.MinAmount,
+		MaxAmount:  query.MaxAmount,
+		Ascending:  strings.EqualFold(query.SortOrder, "asc"),
+		Limit:      size + 1,
+	}
+
+	if query.Status != "" {
+		status := strings.ToUpper(query.Status)
+		if !s.isValidStatus(status) {
+			return nil, ErrInvalidSearchQuery
+		}
+		filter.Status = models.BookingStatus(status)
+	}
+
+	switch strings.ToUpper(query.BookingType) {
+	case "":
+	case string(models.BookingTypeOnline), string(models.BookingTypeOffline):
+		filter.BookingType = models.BookingType(strings.ToUpper(query.BookingType))
+	default:
+		return nil, ErrInvalidSearchQuery
+	}
+
+	switch query.SortBy {
+	case "", datastore.BookingSortCreatedAt:
+		filter.SortBy = datastore.BookingSortCreatedAt
+	case datastore.BookingSortTotalAmount:
+		filter.SortBy = datastore.BookingSortTotalAmount
+	default:
+		return nil, ErrInvalidSearchQuery
+	}
+
+	if query.Cursor != "" {
+		if err := decodeBookingSearchCursor(query.Cursor, filter.SortBy, filter); err != nil {
+			return nil, err
+		}
+	}
+
+	bookings, err := datastore.SearchBookings(ctx, s.roDb, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &types.BookingSearchResult{Bookings: []*types.BookingHistory{}}
+	if len(bookings) > size {
+		bookings = bookings[:size]
+		result.NextCursor = encodeBookingSearchCursor(filter.SortBy, bookings[len(bookings)-1])
+	}
+
+	if len(bookings) == 0 {
+		return result, nil
+	}
+
+	result.Bookings, err = s.enrichBookingsWithShowtimeData(ctx, bookings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enrich bookings: %w", err)
+	}
+
+	return result, nil
+}
+
+func (s *BookingService) ForceCancelBooking(ctx context.Context, bookingId, adminId, reason string) (*models.Booking, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrAuditReasonRequired
+	}
+
+	audit := &models.BookingAuditLog{
+		Id:        uuid.New().String(),
+		ActorId:   adminId,
+		Action:    models.BookingAuditActionForceCancel,
+		Reason:    reason,
+		CreatedAt: time.Now(),
+	}
+
+	return s.cancelBooking(ctx, bookingId, adminId, true, reason, audit)
+}
+
+// ForceConfirmBooking completes a pending booking's payment without the
+// payment gateway, e.g. when a transfer arrived outside it. The booking is
+// then confirmed by the worker like any other paid booking: seats are kept
+// until the movie ends, tickets are issued, loyalty points earned and the
+// customer notified. A webhook arriving later is rejected by payment-service
+// since the payment is no longer pending.
+func (s *BookingService) ForceConfirmBooking(ctx context.Context, bookingId, adminId, reason string) (*models.Booking, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrAuditReasonRequired
+	}
+
+	var booking *models.Booking
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var err error
+		booking, err = datastore.GetBookingByIdForUpdate(ctx, tx, bookingId)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrBookingNotFound
+			}
+			return err
+		}
+
+		if booking.Status != models.BookingStatusPending {
+			return ErrBookingNotConfirmable
+		}
+
+		audit := &models.BookingAuditLog{
+			Id:         uuid.New().String(),
+			BookingId:  booking.Id,
+			ActorId:    adminId,
+			Action:     models.BookingAuditActionForceConfirm,
+			FromStatus: booking.Status,
+			ToStatus:   models.BookingStatusConfirmed,
+			Reason:     reason,
+			CreatedAt:  time.Now(),
+		}
+		if err = datastore.CreateBookingAuditLog(ctx, tx, audit); err != nil {
+			return err
+		}
+
+		// The booking stays locked until the payment is completed so it
+		// cannot expire in between.
+		_, err = s.paymentClient.ForceCompletePayment(ctx, booking.Id, booking.TotalAmount, reason)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return booking, nil
+}
+
+func (s *BookingService) GetBookingAuditLogs(ctx context.Context, bookingId string) ([]*models.BookingAuditLog, error) {
+	return datastore.GetBookingAuditLogs(ctx, s.roDb, bookingId)
+}
@@ -0,0 +1,297 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"booking-service/internal/datastore"
+	"booking-service/internal/models"
+	"booking-service/internal/types"
+	"booking-service/proto/pb"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+var (
+	ErrBookingNotExchangeable  = fmt.Errorf("only confirmed bookings can be exchanged")
+	ErrExchangeCutoffPassed    = fmt.Errorf("exchange cut-off for the original showtime has passed")
+	ErrExchangeInvalidShowtime = fmt.Errorf("booking can only be exchanged to another upcoming showtime of the same movie")
+	ErrExchangeCategoriesUnset = fmt.Errorf("seat_categories is required when the booking's ticket categories cannot be carried over")
+)
+
+func parseShowtimeStart(showtime *pb.ShowtimeData) (time.Time, error) {
+	return time.ParseInLocation(showtimeLayout, showtime.ShowtimeDate+" "+showtime.ShowtimeTime, time.Local)
+}
+
+// ExchangeBooking moves a confirmed booking to new seats of another showtime
+// of the same movie. The booking keeps its id; its old tickets are cancelled
+// and new ones issued. seatCategories maps the new seats to their ticket
+// categories; without it the old categories are carried over when that is
+// unambiguous. The price difference is settled with payment-service by the
+// outbox worker from the BOOKING_EXCHANGED event.
+func (s *BookingService) ExchangeBooking(ctx context.Context, bookingId, staffId, newShowtimeId string, newSeatIds []string, seatCategories map[string]string) (*types.BookingExchangeResult, error) {
+	if bookingId == "" || newShowtimeId == "" || len(newSeatIds) == 0 {
+		return nil, ErrInvalidBookingData
+	}
+
+	booking, err := datastore.GetBookingById(ctx, s.roDb, bookingId)
+	if err != nil {
+		return nil, ErrBookingNotFound
+	}
+
+	if booking.Status != models.BookingStatusConfirmed {
+		return nil, ErrBookingNotExchangeable
+	}
+
+	if booking.ShowtimeId == newShowtimeId {
+		return nil, ErrExchangeInvalidShowtime
+	}
+
+	if err = s.checkExchangeShowtimes(ctx, booking.ShowtimeId, newShowtimeId); err != nil {
+		return nil, err
+	}
+
+	oldSeats, err := datastore.GetActiveBookingSeats(ctx, s.roDb, booking.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	oldSeatIds := make([]string, 0, len(oldSeats))
+	oldCategories := make(map[string]string, len(oldSeats))
+	for _, seat := range oldSeats {
+		oldSeatIds = append(oldSeatIds, seat.SeatId)
+		if seat.TicketCategory != "" {
+			oldCategories[seat.SeatId] = seat.TicketCategory
+		}
+	}
+
+	newCategories, err := exchangeSeatCategories(oldSeatIds, oldCategories, newSeatIds, seatCategories)
+	if err != nil {
+		return nil, err
+	}
+
+	oldPrice, err := s.movieClient.QuoteSeatPrices(ctx, booking.ShowtimeId, oldSeatIds, oldCategories, "", 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to price original seats: %w", err)
+	}
+
+	err = s.acquireDistributedSeatLocks(ctx, newShowtimeId, newSeatIds, booking.Id, bookingLockDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.checkBookedSeats(ctx, s.roDb, newShowtimeId, newSeatIds); err != nil {
+		s.releaseDistributedSeatLocks(ctx, newShowtimeId, booking.Id, newSeatIds)
+		return nil, err
+	}
+
+	newPrice, err := s.movieClient.QuoteSeatPrices(ctx, newShowtimeId, newSeatIds, newCategories, "", 0)
+	if err != nil {
+		s.releaseDistributedSeatLocks(ctx, newShowtimeId, booking.Id, newSeatIds)
+		return nil, fmt.Errorf("failed to price new seats: %w", err)
+	}
+
+	newSeatPrices := make(map[string]*pb.SeatPriceData, len(newPrice.Data))
+	for _, seat := range newPrice.Data {
+		if !seat.Available {
+			s.releaseDistributedSeatLocks(ctx, newShowtimeId, booking.Id, newSeatIds)
+			return nil, fmt.Errorf("seat %s (%s) is not available", seat.SeatNumber, seat.SeatId)
+		}
+		newSeatPrices[seat.SeatId] = seat
+	}
+
+	difference := newPrice.TotalAmount - oldPrice.TotalAmount
+	oldShowtimeId := booking.ShowtimeId
+
+	bookingSeats := make([]*models.BookingSeat, 0, len(newSeatIds))
+	tickets := make([]*models.Ticket, 0, len(newSeatIds))
+	for _, seatId := range newSeatIds {
+		var category string
+		var requiresIdCheck bool
+		var minAge, maxAge int
+		if seat, ok := newSeatPrices[seatId]; ok {
+			category, requiresIdCheck = seat.TicketCategory, seat.RequiresIdCheck
+			minAge, maxAge = int(seat.MinAge), int(seat.MaxAge)
+		}
+
+		bookingSeats = append(bookingSeats, &models.BookingSeat{
+			Id:              uuid.New().String(),
+			BookingId:       booking.Id,
+			ShowtimeId:      newShowtimeId,
+			SeatId:          seatId,
+			Status:          models.BookingSeatStatusActive,
+			TicketCategory:  category,
+			RequiresIdCheck: requiresIdCheck,
+			MinAge:          minAge,
+			MaxAge:          maxAge,
+		})
+		tickets = append(tickets, &models.Ticket{
+			Id:              uuid.New().String(),
+			BookingId:       booking.Id,
+			ShowtimeId:      newShowtimeId,
+			SeatId:          seatId,
+			Status:          models.TicketStatusUnused,
+			TicketCategory:  category,
+			RequiresIdCheck: requiresIdCheck,
+			MinAge:          minAge,
+			MaxAge:          maxAge,
+		})
+	}
+
+	if err = s.signTicketTokens(tickets); err != nil {
+		s.releaseDistributedSeatLocks(ctx, newShowtimeId, booking.Id, newSeatIds)
+		return nil, err
+	}
+
+	eventData := &models.BookingExchangedEventData{
+		ExchangeId:      uuid.New().String(),
+		BookingId:       booking.Id,
+		UserId:          booking.UserId,
+		StaffId:         staffId,
+		OldShowtimeId:   oldShowtimeId,
+		OldSeatIds:      oldSeatIds,
+		NewShowtimeId:   newShowtimeId,
+		NewSeatIds:      newSeatIds,
+		OldAmount:       booking.TotalAmount,
+		NewAmount:       booking.TotalAmount + difference,
+		PriceDifference: difference,
+	}
+
+	err = s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		current, err := datastore.GetBookingByIdForUpdate(ctx, tx, booking.Id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrBookingNotFound
+			}
+			return err
+		}
+
+		if current.Status != models.BookingStatusConfirmed || current.ShowtimeId != oldShowtimeId {
+			return ErrBookingNotExchangeable
+		}
+
+		if _, err = datastore.CancelTicketsByBookingId(ctx, tx, booking.Id); err != nil {
+			return err
+		}
+
+		if err = datastore.ReleaseBookingSeats(ctx, tx, booking.Id); err != nil {
+			return err
+		}
+
+		if err = datastore.CreateBookingSeats(ctx, tx, bookingSeats); err != nil {
+			return err
+		}
+
+		if err = datastore.CreateTickets(ctx, tx, tickets); err != nil {
+			return err
+		}
+
+		if err = datastore.UpdateBookingShowtime(ctx, tx, booking.Id, newShowtimeId, eventData.NewAmount); err != nil {
+			return err
+		}
+
+		return datastore.CreateOutboxEvent(ctx, tx, models.EventTypeBookingExchanged, eventData)
+	})
+	if err != nil {
+		s.releaseDistributedSeatLocks(ctx, newShowtimeId, booking.Id, newSeatIds)
+		if errors.Is(err, datastore.ErrBookingSeatTaken) {
+			return nil, s.bookedSeatsConflict(ctx, newShowtimeId, newSeatIds)
+		}
+		return nil, err
+	}
+
+	s.releaseBookingSeatLocks(ctx, booking, oldSeatIds)
+
+	booking.ShowtimeId = newShowtimeId
+	booking.TotalAmount = eventData.NewAmount
+	booking.Ticket = tickets
+
+	return &types.BookingExchangeResult{
+		ExchangeId:      eventData.ExchangeId,
+		Booking:         booking,
+		OldShowtimeId:   oldShowtimeId,
+		OldSeatIds:      oldSeatIds,
+		OldAmount:       eventData.OldAmount,
+		NewAmount:       eventData.NewAmount,
+		PriceDifference: difference,
+	}, nil
+}
+
+// exchangeSeatCategories returns the ticket categories of the new seats. The
+// given categories win, and may only name new seats. Otherwise the old
+// categories carry over only when every old seat had the same one and the
+// seat count is unchanged, as there is no telling which new seat takes which
+// old seat's category.
+func exchangeSeatCategories(oldSeatIds []string, oldCategories map[string]string, newSeatIds []string, seatCategories map[string]string) (map[string]string, error) {
+	if len(seatCategories) > 0 {
+		for seatId := range seatCategories {
+			if !slices.Contains(newSeatIds, seatId) {
+				return nil, ErrInvalidBookingData
+			}
+		}
+		return seatCategories, nil
+	}
+
+	if len(oldCategories) == 0 {
+		return nil, nil
+	}
+
+	category := oldCategories[oldSeatIds[0]]
+	for _, seatId := range oldSeatIds {
+		if oldCategories[seatId] != category {
+			return nil, ErrExchangeCategoriesUnset
+		}
+	}
+	if len(newSeatIds) != len(oldSeatIds) {
+		return nil, ErrExchangeCategoriesUnset
+	}
+
+	newCategories := make(map[string]string, len(newSeatIds))
+	for _, seatId := range newSeatIds {
+		newCategories[seatId] = category
+	}
+	return newCategories, nil
+}
+
+func (s *BookingService) checkExchangeShowtimes(ctx context.Context, oldShowtimeId, newShowtimeId string) error {
+	showtimes, err := s.movieClient.GetShowtimes(ctx, []string{oldShowtimeId, newShowtimeId})
+	if err != nil {
+		return fmt.Errorf("failed to get showtimes: %w", err)
+	}
+
+	showtimeMap := make(map[string]*pb.ShowtimeData, len(showtimes))
+	for _, showtime := range showtimes {
+		showtimeMap[showtime.Id] = showtime
+	}
+
+	oldShowtime, newShowtime := showtimeMap[oldShowtimeId], showtimeMap[newShowtimeId]
+	if oldShowtime == nil || newShowtime == nil || oldShowtime.MovieId != newShowtime.MovieId {
+		return ErrExchangeInvalidShowtime
+	}
+
+	oldStart, err := parseShowtimeStart(oldShowtime)
+	if err != nil {
+		return fmt.Errorf("failed to parse showtime start: %w", err)
+	}
+
+	newStart, err := parseShowtimeStart(newShowtime)
+	if err != nil {
+		return fmt.Errorf("failed to parse showtime start: %w", err)
+	}
+
+	now := time.Now()
+	if now.After(oldStart.Add(-s.exchangeCutoff)) {
+		return ErrExchangeCutoffPassed
+	}
+
+	if !newStart.After(now) {
+		return ErrExchangeInvalidShowtime
+	}
+
+	return nil
+}
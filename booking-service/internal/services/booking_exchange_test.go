@@ -0,0 +1,74 @@
+package services
+
+import (
+	"errors"
+	"maps"
+	"testing"
+)
+
+func TestExchangeSeatCategories(t *testing.T) {
+	tests := []struct {
+		name           string
+		oldSeatIds     []string
+		oldCategories  map[string]string
+		newSeatIds     []string
+		seatCategories map[string]string
+		want           map[string]string
+		wantErr        error
+	}{
+		{
+			name:       "no categories",
+			oldSeatIds: []string{"a1", "a2"},
+			newSeatIds: []string{"b1", "b2", "b3"},
+			want:       map[string]string{},
+		},
+		{
+			name:          "same category on every seat",
+			oldSeatIds:    []string{"a1", "a2"},
+			oldCategories: map[string]string{"a1": "CHILD", "a2": "CHILD"},
+			newSeatIds:    []string{"b2", "b1"},
+			want:          map[string]string{"b1": "CHILD", "b2": "CHILD"},
+		},
+		{
+			name:          "mixed categories",
+			oldSeatIds:    []string{"a1", "a2"},
+			oldCategories: map[string]string{"a1": "CHILD"},
+			newSeatIds:    []string{"b1", "b2"},
+			wantErr:       ErrExchangeCategoriesUnset,
+		},
+		{
+			name:          "seat count changed",
+			oldSeatIds:    []string{"a1", "a2"},
+			oldCategories: map[string]string{"a1": "SENIOR", "a2": "SENIOR"},
+			newSeatIds:    []string{"b1"},
+			wantErr:       ErrExchangeCategoriesUnset,
+		},
+		{
+			name:           "given categories win",
+			oldSeatIds:     []string{"a1", "a2"},
+			oldCategories:  map[string]string{"a1": "CHILD"},
+			newSeatIds:     []string{"b1", "b2"},
+			seatCategories: map[string]string{"b2": "CHILD"},
+			want:           map[string]string{"b2": "CHILD"},
+		},
+		{
+			name:           "given category for another seat",
+			oldSeatIds:     []string{"a1"},
+			newSeatIds:     []string{"b1"},
+			seatCategories: map[string]string{"a1": "CHILD"},
+			wantErr:        ErrInvalidBookingData,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := exchangeSeatCategories(tt.oldSeatIds, tt.oldCategories, tt.newSeatIds, tt.seatCategories)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr == nil && !maps.Equal(got, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,263 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"booking-service/internal/datastore"
+	"booking-service/proto/pb"
+
+	"github.com/uptrace/bun"
+)
+
+var (
+	ErrTooManySeats               = fmt.Errorf("too many seats in one booking")
+	ErrTooManyPendingBookings     = fmt.Errorf("too many unpaid bookings")
+	ErrShowtimePurchaseCapReached = fmt.Errorf("seat limit for this showtime reached")
+	ErrSeatGapNotAllowed          = fmt.Errorf("seat selection would leave a single empty seat")
+	ErrCoupleSeatSplit            = fmt.Errorf("both halves of a couple seat must be booked together")
+)
+
+const (
+	seatStatusAvailable = "AVAILABLE"
+	seatTypeCouple      = "COUPLE"
+)
+
+// BookingPolicyError reports a booking rejected by the booking policy. Limit
+// is set for quota violations, SeatIds for seat selection violations.
+type BookingPolicyError struct {
+	Err     error
+	Limit   int
+	SeatIds []string
+}
+
+func (e *BookingPolicyError) Error() string {
+	if len(e.SeatIds) > 0 {
+		return fmt.Sprintf("%s: %s", e.Err.Error(), strings.Join(e.SeatIds, ", "))
+	}
+	if e.Limit > 0 {
+		return fmt.Sprintf("%s (limit %d)", e.Err.Error(), e.Limit)
+	}
+	return e.Err.Error()
+}
+
+func (e *BookingPolicyError) Unwrap() error {
+	return e.Err
+}
+
+// bookingPolicy caps how much of a showtime one user can tie up. A limit of
+// zero disables it.
+type bookingPolicy struct {
+	maxSeatsPerBooking  int
+	maxPendingBookings  int
+	maxSeatsPerShowtime int
+	seatGapRule         bool
+}
+
+// checkBookingLimits applies the per-user quotas to a new selection of seats.
+// Run it inside the booking transaction after datastore.LockUserBookings for
+// a race-free answer.
+func (s *BookingService) checkBookingLimits(ctx context.Context, db bun.IDB, userId, showtimeId string, seatIds []string) error {
+	if err := s.checkSeatCount(seatIds); err != nil {
+		return err
+	}
+
+	if s.policy.maxPendingBookings > 0 {
+		pending, err := datastore.CountPendingBookingsByUserId(ctx, db, userId)
+		if err != nil {
+			return err
+		}
+		if pending >= s.policy.maxPendingBookings {
+			return &BookingPolicyError{Err: ErrTooManyPendingBookings, Limit: s.policy.maxPendingBookings}
+		}
+	}
+
+	if s.policy.maxSeatsPerShowtime > 0 {
+		booked, err := datastore.CountUserSeatsForShowtime(ctx, db, userId, showtimeId)
+		if err != nil {
+			return err
+		}
+		if booked+len(seatIds) > s.policy.maxSeatsPerShowtime {
+			return &BookingPolicyError{Err: ErrShowtimePurchaseCapReached, Limit: s.policy.maxSeatsPerShowtime}
+		}
+	}
+
+	return nil
+}
+
+func (s *BookingService) checkSeatCount(seatIds []string) error {
+	if s.policy.maxSeatsPerBooking > 0 && len(seatIds) > s.policy.maxSeatsPerBooking {
+		return &BookingPolicyError{Err: ErrTooManySeats, Limit: s.policy.maxSeatsPerBooking}
+	}
+	return nil
+}
+
+// checkSeatAdjacency rejects selections that split a couple seat or leave a
+// single free seat stranded between taken seats, aisles or the row edge. A
+// single seat may still be left when nothing else remains of the gap the
+// selection is taken from. Seats in userId's own holds count as free.
+func (s *BookingService) checkSeatAdjacency(ctx context.Context, userId, showtimeId string, seatIds []string) error {
+	details, err := s.movieClient.GetSeatDetails(ctx, seatIds)
+	if err != nil {
+		return err
+	}
+
+	rowSet := make(map[string]struct{}, len(details))
+	rows := make([]string, 0, len(details))
+	for _, seat := range details {
+		if _, ok := rowSet[seat.SeatRow]; !ok {
+			rowSet[seat.SeatRow] = struct{}{}
+			rows = append(rows, seat.SeatRow)
+		}
+	}
+
+	rowSeats, err := s.movieClient.GetShowtimeSeats(ctx, showtimeId, rows)
+	if err != nil {
+		return err
+	}
+
+	requested := make(map[string]struct{}, len(seatIds))
+	for _, seatId := range seatIds {
+		requested[seatId] = struct{}{}
+	}
+
+	found := 0
+	byRow := make(map[string][]*pb.SeatDetailData, len(rows))
+	for _, seat := range rowSeats {
+		byRow[seat.SeatRow] = append(byRow[seat.SeatRow], seat)
+		if _, ok := requested[seat.SeatId]; ok {
+			found++
+		}
+	}
+	if found != len(requested) {
+		return ErrInvalidBookingData
+	}
+
+	taken, err := s.takenSeatStates(ctx, showtimeId, userId)
+	if err != nil {
+		return err
+	}
+
+	booked, err := datastore.GetBookedSeatsForShowtime(ctx, s.roDb, showtimeId)
+	if err != nil {
+		return err
+	}
+	for seatId := range booked {
+		taken[seatId] = struct{}{}
+	}
+
+	splitCouples := make([]string, 0)
+	strandedSeats := make([]string, 0)
+	for _, row := range rows {
+		seats := byRow[row]
+		sort.Slice(seats, func(i, j int) bool {
+			return seats[i].SeatNumber < seats[j].SeatNumber
+		})
+
+		for _, block := range seatBlocks(seats) {
+			splitCouples = append(splitCouples, splitCoupleSeats(block, requested)...)
+			if s.policy.seatGapRule {
+				strandedSeats = append(strandedSeats, strandedSingleSeats(block, requested, taken)...)
+			}
+		}
+	}
+
+	if len(splitCouples) > 0 {
+		return &BookingPolicyError{Err: ErrCoupleSeatSplit, SeatIds: splitCouples}
+	}
+
+	if len(strandedSeats) > 0 {
+		return &BookingPolicyError{Err: ErrSeatGapNotAllowed, SeatIds: strandedSeats}
+	}
+
+	return nil
+}
+
+// seatBlocks splits a row, sorted by seat number, into runs of physically
+// adjacent seats. A jump in seat numbers is an aisle.
+func seatBlocks(seats []*pb.SeatDetailData) [][]*pb.SeatDetailData {
+	blocks := make([][]*pb.SeatDetailData, 0)
+	start := 0
+	for i := 1; i <= len(seats); i++ {
+		if i == len(seats) || seats[i].SeatNumber != seats[i-1].SeatNumber+1 {
+			blocks = append(blocks, seats[start:i])
+			start = i
+		}
+	}
+	return blocks
+}
+
+// splitCoupleSeats pairs consecutive COUPLE seats of a block from the left
+// and returns the partners missing from the selection.
+func splitCoupleSeats(block []*pb.SeatDetailData, requested map[string]struct{}) []string {
+	missing := make([]string, 0)
+	for i := 0; i+1 < len(block); i++ {
+		if block[i].SeatType != seatTypeCouple || block[i+1].SeatType != seatTypeCouple {
+			continue
+		}
+
+		_, left := requested[block[i].SeatId]
+		_, right := requested[block[i+1].SeatId]
+		if left && !right {
+			missing = append(missing, block[i+1].SeatId)
+		}
+		if right && !left {
+			missing = append(missing, block[i].SeatId)
+		}
+		i++
+	}
+	return missing
+}
+
+// strandedSingleSeats returns the free seats of a block that the selection
+// would leave isolated.
+func strandedSingleSeats(block []*pb.SeatDetailData, requested, taken map[string]struct{}) []string {
+	stranded := make([]string, 0)
+
+	open := func(seat *pb.SeatDetailData) bool {
+		if seat.Status != seatStatusAvailable {
+			return false
+		}
+		_, isTaken := taken[seat.SeatId]
+		return !isTaken
+	}
+
+	for i := 0; i < len(block); {
+		if !open(block[i]) {
+			i++
+			continue
+		}
+
+		// block[i:j] is a gap that is free before this booking.
+		j := i
+		selected := 0
+		for j < len(block) && open(block[j]) {
+			if _, ok := requested[block[j].SeatId]; ok {
+				selected++
+			}
+			j++
+		}
+
+		if selected > 0 && j-i != selected+1 {
+			run := 0
+			for k := i; k <= j; k++ {
+				if k < j {
+					if _, ok := requested[block[k].SeatId]; !ok {
+						run++
+						continue
+					}
+				}
+				if run == 1 {
+					stranded = append(stranded, block[k-1].SeatId)
+				}
+				run = 0
+			}
+		}
+
+		i = j
+	}
+
+	return stranded
+}
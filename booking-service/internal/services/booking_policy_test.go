@@ -0,0 +1,140 @@
+package services
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"booking-service/proto/pb"
+)
+
+// seatRow builds one block of adjacent available seats s1, s2, ... with the
+// given seat types.
+func seatRow(seatTypes ...string) []*pb.SeatDetailData {
+	seats := make([]*pb.SeatDetailData, 0, len(seatTypes))
+	for i, seatType := range seatTypes {
+		seats = append(seats, &pb.SeatDetailData{
+			SeatId:     fmt.Sprintf("s%d", i+1),
+			SeatRow:    "A",
+			SeatNumber: int32(i + 1),
+			SeatType:   seatType,
+			Status:     seatStatusAvailable,
+		})
+	}
+	return seats
+}
+
+func seatSet(seatIds ...string) map[string]struct{} {
+	set := make(map[string]struct{}, len(seatIds))
+	for _, seatId := range seatIds {
+		set[seatId] = struct{}{}
+	}
+	return set
+}
+
+func TestSeatBlocks(t *testing.T) {
+	seats := seatRow("STANDARD", "STANDARD", "STANDARD", "STANDARD")
+	seats[2].SeatNumber = 5
+	seats[3].SeatNumber = 6
+
+	blocks := seatBlocks(seats)
+	if len(blocks) != 2 || len(blocks[0]) != 2 || len(blocks[1]) != 2 {
+		t.Fatalf("Expected two blocks of two seats, got %v", blocks)
+	}
+}
+
+func TestSplitCoupleSeats(t *testing.T) {
+	block := seatRow("STANDARD", seatTypeCouple, seatTypeCouple, seatTypeCouple, seatTypeCouple)
+
+	tests := []struct {
+		name        string
+		block       []*pb.SeatDetailData
+		requested   []string
+		wantMissing []string
+	}{
+		{name: "whole couple seat", block: block, requested: []string{"s2", "s3"}, wantMissing: []string{}},
+		{name: "both couple seats", block: block, requested: []string{"s2", "s3", "s4", "s5"}, wantMissing: []string{}},
+		{name: "right half only", block: block, requested: []string{"s3"}, wantMissing: []string{"s2"}},
+		{name: "left half only", block: block, requested: []string{"s4"}, wantMissing: []string{"s5"}},
+		{name: "halves of two couple seats", block: block, requested: []string{"s3", "s4"}, wantMissing: []string{"s2", "s5"}},
+		{name: "standard seat", block: block, requested: []string{"s1"}, wantMissing: []string{}},
+		{
+			name:        "unpaired couple seat at the end",
+			block:       seatRow(seatTypeCouple, seatTypeCouple, seatTypeCouple),
+			requested:   []string{"s3"},
+			wantMissing: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			missing := splitCoupleSeats(tt.block, seatSet(tt.requested...))
+			if !slices.Equal(missing, tt.wantMissing) {
+				t.Errorf("Expected %v, got %v", tt.wantMissing, missing)
+			}
+		})
+	}
+}
+
+func TestStrandedSingleSeats(t *testing.T) {
+	tests := []struct {
+		name         string
+		unavailable  []string
+		taken        []string
+		requested    []string
+		wantStranded []string
+	}{
+		{name: "from the row edge", requested: []string{"s1", "s2"}, wantStranded: []string{}},
+		{name: "one off the row edge", requested: []string{"s2", "s3"}, wantStranded: []string{"s1"}},
+		{name: "one off the other row edge", requested: []string{"s4", "s5"}, wantStranded: []string{"s6"}},
+		{name: "middle leaving pairs", requested: []string{"s3", "s4"}, wantStranded: []string{}},
+		{name: "single seat left of the whole gap", requested: []string{"s1", "s2", "s3", "s4", "s5"}, wantStranded: []string{}},
+		{name: "gap between two selected seats", requested: []string{"s2", "s4"}, wantStranded: []string{"s1", "s3"}},
+		{name: "next to a taken seat", taken: []string{"s5"}, requested: []string{"s2", "s3"}, wantStranded: []string{"s1", "s4"}},
+		{name: "filling up to a taken seat", taken: []string{"s4"}, requested: []string{"s1", "s2"}, wantStranded: []string{}},
+		{name: "next to an unavailable seat", unavailable: []string{"s1"}, requested: []string{"s3", "s4"}, wantStranded: []string{"s2"}},
+		{name: "nothing selected", taken: []string{"s2"}, wantStranded: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			block := seatRow("STANDARD", "STANDARD", "STANDARD", "STANDARD", "STANDARD", "STANDARD")
+			for _, seat := range block {
+				if slices.Contains(tt.unavailable, seat.SeatId) {
+					seat.Status = "MAINTENANCE"
+				}
+			}
+
+			stranded := strandedSingleSeats(block, seatSet(tt.requested...), seatSet(tt.taken...))
+			if !slices.Equal(stranded, tt.wantStranded) {
+				t.Errorf("Expected %v, got %v", tt.wantStranded, stranded)
+			}
+		})
+	}
+}
+
+func TestTakenSeats(t *testing.T) {
+	now := int64(1_000_000)
+	entries := map[string]string{
+		"held-by-other":   fmt.Sprintf("HELD|hold-2|%d", now+1000),
+		"held-by-user":    fmt.Sprintf("HELD|hold-1|%d", now+1000),
+		"booked":          fmt.Sprintf("BOOKED|booking-1|%d", now+1000),
+		"pending-booking": fmt.Sprintf("HELD|booking-2|%d", now+1000),
+		"booked-by-hold":  fmt.Sprintf("BOOKED|hold-1|%d", now+1000),
+		"expired":         fmt.Sprintf("HELD|hold-2|%d", now),
+		"malformed":       "HELD|hold-2",
+	}
+
+	taken := takenSeats(entries, seatSet("hold-1"), now)
+
+	for _, seatId := range []string{"held-by-other", "booked", "pending-booking", "booked-by-hold"} {
+		if _, ok := taken[seatId]; !ok {
+			t.Errorf("Expected %s to be taken", seatId)
+		}
+	}
+	for _, seatId := range []string{"held-by-user", "expired", "malformed"} {
+		if _, ok := taken[seatId]; ok {
+			t.Errorf("Expected %s not to be taken", seatId)
+		}
+	}
+}
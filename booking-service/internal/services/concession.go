@@ -0,0 +1,521 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"booking-service/internal/datastore"
+	"booking-service/internal/models"
+	"booking-service/internal/types"
+	"booking-service/proto/pb"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+var (
+	ErrInvalidConcession     = fmt.Errorf("invalid concession data")
+	ErrConcessionNotFound    = fmt.Errorf("concession item not found")
+	ErrConcessionUnavailable = fmt.Errorf("concession item is not available")
+	ErrConcessionOutOfStock  = fmt.Errorf("concession item is out of stock")
+)
+
+// defaultCinemaId matches movie-service's cinema for rooms created without
+// one.
+const defaultCinemaId = "MAIN"
+
+func (s *BookingService) GetConcessionItems(ctx context.Context, activeOnly bool, cinemaId string) ([]*models.ConcessionItem, error) {
+	return datastore.GetConcessionItems(ctx, s.roDb, activeOnly, cinemaId)
+}
+
+func (s *BookingService) CreateConcessionItem(ctx context.Context, item *models.ConcessionItem) (*models.ConcessionItem, error) {
+	item.Name = strings.TrimSpace(item.Name)
+	if item.Name == "" || item.Price < 0 {
+		return nil, ErrInvalidConcession
+	}
+
+	switch item.Category {
+	case models.ConcessionCategoryFood, models.ConcessionCategoryDrink:
+		if len(item.Components) > 0 {
+			return nil, ErrInvalidConcession
+		}
+	case models.ConcessionCategoryCombo:
+		// A combo's stock is that of its components.
+	default:
+		return nil, ErrInvalidConcession
+	}
+
+	item.Id = uuid.New().String()
+	item.IsActive = true
+
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if item.Category == models.ConcessionCategoryCombo {
+			if err := s.checkComboComponents(ctx, tx, item.Id, item.Components); err != nil {
+				return err
+			}
+		}
+
+		return datastore.CreateConcessionItem(ctx, tx, item)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (s *BookingService) UpdateConcessionItem(ctx context.Context, itemId string, update *types.ConcessionItemUpdate) (*models.ConcessionItem, error) {
+	item, err := datastore.GetConcessionItemById(ctx, s.db, itemId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrConcessionNotFound
+		}
+		return nil, err
+	}
+
+	if update.Name != nil {
+		item.Name = strings.TrimSpace(*update.Name)
+	}
+	if update.Description != nil {
+		item.Description = *update.Description
+	}
+	if update.Price != nil {
+		item.Price = *update.Price
+	}
+	if update.IsActive != nil {
+		item.IsActive = *update.IsActive
+	}
+
+	if item.Name == "" || item.Price < 0 {
+		return nil, ErrInvalidConcession
+	}
+	if update.Components != nil && item.Category != models.ConcessionCategoryCombo {
+		return nil, ErrInvalidConcession
+	}
+
+	err = s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		updated, err := datastore.UpdateConcessionItem(ctx, tx, item)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			return ErrConcessionNotFound
+		}
+
+		if update.Components == nil {
+			return nil
+		}
+
+		if err = s.checkComboComponents(ctx, tx, item.Id, update.Components); err != nil {
+			return err
+		}
+		item.Components = update.Components
+
+		return datastore.ReplaceConcessionComboItems(ctx, tx, item.Id, item.Components)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// AdjustConcessionStock records a delivery (positive delta) or wastage
+// (negative delta) of a stocked item at a cinema.
+func (s *BookingService) AdjustConcessionStock(ctx context.Context, itemId, cinemaId string, delta int) (*models.ConcessionItem, error) {
+	cinemaId = strings.TrimSpace(cinemaId)
+	if delta == 0 || cinemaId == "" {
+		return nil, ErrInvalidConcession
+	}
+
+	item, err := datastore.GetConcessionItemById(ctx, s.db, itemId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrConcessionNotFound
+		}
+		return nil, err
+	}
+
+	if item.Category == models.ConcessionCategoryCombo {
+		return nil, ErrInvalidConcession
+	}
+
+	if delta > 0 {
+		err = datastore.AddConcessionStock(ctx, s.db, item.Id, cinemaId, delta)
+	} else {
+		var taken bool
+		taken, err = datastore.TakeConcessionStock(ctx, s.db, item.Id, cinemaId, -delta)
+		if err == nil && !taken {
+			err = ErrConcessionOutOfStock
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return datastore.GetConcessionItemById(ctx, s.db, item.Id)
+}
+
+// checkComboComponents validates a combo's components, which must be
+// distinct stocked items, and points them at the combo.
+func (s *BookingService) checkComboComponents(ctx context.Context, db bun.IDB, comboId string, components []*models.ConcessionComboItem) error {
+	if len(components) == 0 {
+		return ErrInvalidConcession
+	}
+
+	itemIds := make([]string, 0, len(components))
+	for _, component := range components {
+		if component.Quantity <= 0 || slices.Contains(itemIds, component.ItemId) {
+			return ErrInvalidConcession
+		}
+		itemIds = append(itemIds, component.ItemId)
+		component.ComboId = comboId
+	}
+
+	items, err := datastore.GetConcessionItemsByIds(ctx, db, itemIds)
+	if err != nil {
+		return err
+	}
+	if len(items) != len(itemIds) {
+		return ErrConcessionNotFound
+	}
+
+	for _, item := range items {
+		if item.Category == models.ConcessionCategoryCombo {
+			return ErrInvalidConcession
+		}
+	}
+
+	return nil
+}
+
+// priceConcessions turns the ordered add-ons into booking concessions served
+// at cinemaId and returns their total. Every item must be on sale and in stock
+// there; the stock itself is taken when the booking is created.
+func (s *BookingService) priceConcessions(ctx context.Context, db bun.IDB, cinemaId string, orders []types.ConcessionOrder) ([]*models.BookingConcession, float64, error) {
+	if len(orders) == 0 {
+		return nil, 0, nil
+	}
+
+	quantities := make(map[string]int, len(orders))
+	itemIds := make([]string, 0, len(orders))
+	for _, order := range orders {
+		if order.ItemId == "" || order.Quantity <= 0 {
+			return nil, 0, ErrInvalidConcession
+		}
+		if _, ok := quantities[order.ItemId]; !ok {
+			itemIds = append(itemIds, order.ItemId)
+		}
+		quantities[order.ItemId] += order.Quantity
+	}
+
+	items, err := datastore.GetConcessionItemsByIds(ctx, db, itemIds)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	itemMap := make(map[string]*models.ConcessionItem, len(items))
+	for _, item := range items {
+		itemMap[item.Id] = item
+	}
+
+	concessions := make([]*models.BookingConcession, 0, len(itemIds))
+	var total float64
+	for _, itemId := range itemIds {
+		item, ok := itemMap[itemId]
+		if !ok {
+			return nil, 0, ErrConcessionNotFound
+		}
+		if !item.IsActive {
+			return nil, 0, fmt.Errorf("%w: %s", ErrConcessionUnavailable, item.Name)
+		}
+
+		quantity := quantities[itemId]
+		concession := &models.BookingConcession{
+			Id:         uuid.New().String(),
+			ItemId:     item.Id,
+			ItemName:   item.Name,
+			CinemaId:   cinemaId,
+			Quantity:   quantity,
+			UnitPrice:  item.Price,
+			TotalPrice: roundAmount(item.Price * float64(quantity)),
+			Status:     models.BookingConcessionStatusPending,
+		}
+		concessions = append(concessions, concession)
+		total += concession.TotalPrice
+	}
+
+	if err = s.checkConcessionStock(ctx, db, cinemaId, concessions); err != nil {
+		return nil, 0, err
+	}
+
+	return concessions, roundAmount(total), nil
+}
+
+func (s *BookingService) checkConcessionStock(ctx context.Context, db bun.IDB, cinemaId string, concessions []*models.BookingConcession) error {
+	usage, err := s.concessionStockUsage(ctx, db, concessions)
+	if err != nil {
+		return err
+	}
+
+	stockIds := make([]string, 0, len(usage))
+	for itemId := range usage {
+		stockIds = append(stockIds, itemId)
+	}
+
+	stocked, err := datastore.GetConcessionItemsByIds(ctx, db, stockIds)
+	if err != nil {
+		return err
+	}
+
+	stocks, err := datastore.GetConcessionStocks(ctx, db, cinemaId, stockIds)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range stocked {
+		if !item.IsActive {
+			return fmt.Errorf("%w: %s", ErrConcessionUnavailable, item.Name)
+		}
+		if stocks[item.Id] < usage[item.Id] {
+			return fmt.Errorf("%w: %s", ErrConcessionOutOfStock, item.Name)
+		}
+	}
+
+	return nil
+}
+
+// concessionStockUsage works out how much of each stocked item the
+// concessions use, counting a combo as its components. A concession's usage
+// is recorded on it the first time, so a booking gives back exactly the stock
+// it took even if a combo's components change later.
+func (s *BookingService) concessionStockUsage(ctx context.Context, db bun.IDB, concessions []*models.BookingConcession) (map[string]int, error) {
+	itemIds := make([]string, 0, len(concessions))
+	for _, concession := range concessions {
+		if concession.StockUsage == nil && !slices.Contains(itemIds, concession.ItemId) {
+			itemIds = append(itemIds, concession.ItemId)
+		}
+	}
+
+	if len(itemIds) > 0 {
+		items, err := datastore.GetConcessionItemsByIds(ctx, db, itemIds)
+		if err != nil {
+			return nil, err
+		}
+
+		itemMap := make(map[string]*models.ConcessionItem, len(items))
+		for _, item := range items {
+			itemMap[item.Id] = item
+		}
+
+		for _, concession := range concessions {
+			item, ok := itemMap[concession.ItemId]
+			if !ok || concession.StockUsage != nil {
+				continue
+			}
+			concession.StockUsage = itemStockUsage(item, concession.Quantity)
+		}
+	}
+
+	return sumConcessionStockUsage(concessions), nil
+}
+
+// itemStockUsage is the stock quantity of item uses, counting a combo as its
+// components.
+func itemStockUsage(item *models.ConcessionItem, quantity int) map[string]int {
+	if item.Category != models.ConcessionCategoryCombo {
+		return map[string]int{item.Id: quantity}
+	}
+
+	usage := make(map[string]int, len(item.Components))
+	for _, component := range item.Components {
+		usage[component.ItemId] += component.Quantity * quantity
+	}
+	return usage
+}
+
+func sumConcessionStockUsage(concessions []*models.BookingConcession) map[string]int {
+	usage := make(map[string]int)
+	for _, concession := range concessions {
+		for itemId, quantity := range concession.StockUsage {
+			usage[itemId] += quantity
+		}
+	}
+	return usage
+}
+
+// takeConcessionStock takes the stock a new booking's concessions use from
+// their cinema, failing if any item has run out since it was priced. Items are
+// updated in id order so concurrent bookings lock them consistently.
+func (s *BookingService) takeConcessionStock(ctx context.Context, tx bun.Tx, concessions []*models.BookingConcession) error {
+	if len(concessions) == 0 {
+		return nil
+	}
+
+	usage, itemIds, err := s.sortedConcessionStockUsage(ctx, tx, concessions)
+	if err != nil {
+		return err
+	}
+
+	cinemaId := concessions[0].CinemaId
+	for _, itemId := range itemIds {
+		taken, err := datastore.TakeConcessionStock(ctx, tx, itemId, cinemaId, usage[itemId])
+		if err != nil {
+			return err
+		}
+		if !taken {
+			item, err := datastore.GetConcessionItemById(ctx, tx, itemId)
+			if err != nil {
+				return err
+			}
+			return fmt.Errorf("%w: %s", ErrConcessionOutOfStock, item.Name)
+		}
+	}
+
+	return nil
+}
+
+// returnConcessionStock gives back to their cinema the stock the concessions
+// took. Concessions from before usage was recorded give back what their item
+// uses now.
+func (s *BookingService) returnConcessionStock(ctx context.Context, tx bun.Tx, concessions []*models.BookingConcession) error {
+	if len(concessions) == 0 {
+		return nil
+	}
+
+	usage, itemIds, err := s.sortedConcessionStockUsage(ctx, tx, concessions)
+	if err != nil {
+		return err
+	}
+
+	cinemaId := concessions[0].CinemaId
+	for _, itemId := range itemIds {
+		if err = datastore.AddConcessionStock(ctx, tx, itemId, cinemaId, usage[itemId]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *BookingService) sortedConcessionStockUsage(ctx context.Context, tx bun.Tx, concessions []*models.BookingConcession) (map[string]int, []string, error) {
+	usage, err := s.concessionStockUsage(ctx, tx, concessions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	itemIds := make([]string, 0, len(usage))
+	for itemId := range usage {
+		itemIds = append(itemIds, itemId)
+	}
+	sort.Strings(itemIds)
+
+	return usage, itemIds, nil
+}
+
+// confirmBookingConcessions marks a paid booking's concessions ready for
+// pickup. Their stock was taken when the booking was created.
+func (s *BookingService) confirmBookingConcessions(ctx context.Context, tx bun.Tx, bookingId string) error {
+	return datastore.UpdateBookingConcessionStatus(ctx, tx, bookingId, models.BookingConcessionStatusConfirmed,
+		models.BookingConcessionStatusPending)
+}
+
+// releaseBookingConcessions cancels a booking's uncollected concessions and
+// gives back their stock.
+func (s *BookingService) releaseBookingConcessions(ctx context.Context, tx bun.Tx, bookingId string) error {
+	uncollected, err := datastore.GetBookingConcessions(ctx, tx, bookingId,
+		models.BookingConcessionStatusPending, models.BookingConcessionStatusConfirmed)
+	if err != nil {
+		return err
+	}
+
+	if err = s.returnConcessionStock(ctx, tx, uncollected); err != nil {
+		return err
+	}
+
+	return datastore.UpdateBookingConcessionStatus(ctx, tx, bookingId, models.BookingConcessionStatusCancelled,
+		models.BookingConcessionStatusPending, models.BookingConcessionStatusConfirmed)
+}
+
+// CollectConcessions hands a paid booking's concessions over at the counter.
+// The booking is found from a ticket code or its id; concessionIds limits the
+// pickup to some of its items.
+func (s *BookingService) CollectConcessions(ctx context.Context, token, bookingId, staffId string, concessionIds []string) (*types.ConcessionPickupResult, error) {
+	if token != "" {
+		claims, err := s.ticketSigner.Verify(token)
+		if err != nil {
+			return pickupRejected(&types.ConcessionPickupResult{}, types.ConcessionPickupInvalidToken, "Ticket code is not valid"), nil
+		}
+		bookingId = claims.BookingId
+	}
+
+	result := &types.ConcessionPickupResult{BookingId: bookingId}
+
+	booking, err := datastore.GetBookingById(ctx, s.db, bookingId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return pickupRejected(result, types.ConcessionPickupBookingNotFound, "Booking does not exist"), nil
+		}
+		return nil, err
+	}
+
+	switch booking.Status {
+	case models.BookingStatusCancelled:
+		return pickupRejected(result, types.ConcessionPickupCancelled, "Booking has been cancelled"), nil
+	case models.BookingStatusPending:
+		return pickupRejected(result, types.ConcessionPickupNotPaid, "Booking has not been paid"), nil
+	}
+
+	collected, err := datastore.CollectBookingConcessions(ctx, s.db, booking.Id, concessionIds, staffId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(collected) == 0 {
+		concessions, err := datastore.GetBookingConcessions(ctx, s.db, booking.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]*models.BookingConcession, 0, len(concessions))
+		for _, concession := range concessions {
+			if len(concessionIds) == 0 || slices.Contains(concessionIds, concession.Id) {
+				items = append(items, concession)
+			}
+		}
+		result.Items = items
+
+		if len(items) == 0 {
+			return pickupRejected(result, types.ConcessionPickupNothingOrdered, "Booking has no concessions to collect"), nil
+		}
+		return pickupRejected(result, types.ConcessionPickupAlreadyCollected, "Concessions have already been collected"), nil
+	}
+
+	result.Valid = true
+	result.Code = types.ConcessionPickupCollected
+	result.Message = "Concessions collected"
+	result.Items = collected
+
+	return result, nil
+}
+
+// showtimeCinemaId returns the cinema a showtime is in. Showtimes from before
+// rooms had a cinema are in the default one.
+func showtimeCinemaId(showtime *pb.ShowtimeData) string {
+	if showtime.CinemaId == "" {
+		return defaultCinemaId
+	}
+	return showtime.CinemaId
+}
+
+func pickupRejected(result *types.ConcessionPickupResult, code types.ConcessionPickupCode, message string) *types.ConcessionPickupResult {
+	result.Valid = false
+	result.Code = code
+	result.Message = message
+	return result
+}
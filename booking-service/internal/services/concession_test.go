@@ -0,0 +1,53 @@
+package services
+
+import (
+	"maps"
+	"testing"
+
+	"booking-service/internal/models"
+)
+
+func TestItemStockUsage(t *testing.T) {
+	popcorn := &models.ConcessionItem{Id: "popcorn", Category: models.ConcessionCategoryFood}
+	combo := &models.ConcessionItem{
+		Id:       "combo",
+		Category: models.ConcessionCategoryCombo,
+		Components: []*models.ConcessionComboItem{
+			{ComboId: "combo", ItemId: "popcorn", Quantity: 1},
+			{ComboId: "combo", ItemId: "soda", Quantity: 2},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		item     *models.ConcessionItem
+		quantity int
+		want     map[string]int
+	}{
+		{name: "stocked item", item: popcorn, quantity: 3, want: map[string]int{"popcorn": 3}},
+		{name: "combo", item: combo, quantity: 2, want: map[string]int{"popcorn": 2, "soda": 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := itemStockUsage(tt.item, tt.quantity)
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("Expected usage %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSumConcessionStockUsage(t *testing.T) {
+	// The combo's recorded usage is what it took, whatever it is made of now.
+	concessions := []*models.BookingConcession{
+		{ItemId: "popcorn", Quantity: 1, StockUsage: map[string]int{"popcorn": 1}},
+		{ItemId: "combo", Quantity: 2, StockUsage: map[string]int{"popcorn": 2, "soda": 2}},
+	}
+
+	got := sumConcessionStockUsage(concessions)
+	want := map[string]int{"popcorn": 3, "soda": 2}
+	if !maps.Equal(got, want) {
+		t.Errorf("Expected usage %v, got %v", want, got)
+	}
+}
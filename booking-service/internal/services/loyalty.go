@@ -0,0 +1,250 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+
+	"booking-service/internal/datastore"
+	"booking-service/internal/models"
+	"booking-service/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+var (
+	ErrInvalidLoyaltyTier      = fmt.Errorf("invalid loyalty tier data")
+	ErrLoyaltyTierNotFound     = fmt.Errorf("loyalty tier not found")
+	ErrInsufficientPoints      = fmt.Errorf("insufficient loyalty points")
+	ErrPointsRedemptionTooHigh = fmt.Errorf("loyalty points exceed the redeemable amount for this booking")
+)
+
+// defaultLoyaltyTier is used for new accounts when no tiers are configured.
+const defaultLoyaltyTier = "MEMBER"
+
+// loyaltyTierFor returns the highest tier the lifetime points qualify for.
+// tiers must be sorted by MinLifetimePoints ascending.
+func loyaltyTierFor(tiers []*models.LoyaltyTier, lifetimePoints int) *models.LoyaltyTier {
+	var current *models.LoyaltyTier
+	for _, tier := range tiers {
+		if lifetimePoints >= tier.MinLifetimePoints {
+			current = tier
+		}
+	}
+	return current
+}
+
+// postLoyaltyTransaction applies a signed points change to the user's account
+// and writes the ledger entry. Earn entries and their reversals also move
+// lifetime points, which decide the tier. It must run inside a transaction.
+func (s *BookingService) postLoyaltyTransaction(ctx context.Context, tx bun.Tx, userId, bookingId string, txType models.LoyaltyTransactionType, points int, description string) (*models.LoyaltyTransaction, error) {
+	tiers, err := datastore.GetLoyaltyTiers(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	tierName := defaultLoyaltyTier
+	if len(tiers) > 0 {
+		tierName = tiers[0].Name
+	}
+
+	account, err := datastore.GetOrCreateLoyaltyAccountForUpdate(ctx, tx, userId, tierName)
+	if err != nil {
+		return nil, err
+	}
+
+	if txType == models.LoyaltyTransactionRedeem && account.Balance+points < 0 {
+		return nil, ErrInsufficientPoints
+	}
+
+	account.Balance += points
+	if txType == models.LoyaltyTransactionEarn || txType == models.LoyaltyTransactionEarnReversal {
+		account.LifetimePoints = max(account.LifetimePoints+points, 0)
+		if tier := loyaltyTierFor(tiers, account.LifetimePoints); tier != nil {
+			account.Tier = tier.Name
+		}
+	}
+
+	transaction := &models.LoyaltyTransaction{
+		Id:           uuid.New().String(),
+		UserId:       userId,
+		BookingId:    bookingId,
+		Type:         txType,
+		Points:       points,
+		BalanceAfter: account.Balance,
+		Description:  description,
+	}
+
+	if err = datastore.CreateLoyaltyTransaction(ctx, tx, transaction); err != nil {
+		return nil, err
+	}
+
+	if err = datastore.UpdateLoyaltyAccount(ctx, tx, account); err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+// pointsDiscountFor prices a points redemption against the amount still due
+// and rejects it if it exceeds the redeemable share of that amount.
+func (s *BookingService) pointsDiscountFor(points int, amountDue float64) (float64, error) {
+	discount := float64(points) * s.loyaltyPointValue
+	if discount > amountDue*s.loyaltyMaxRedeemRatio {
+		return 0, ErrPointsRedemptionTooHigh
+	}
+	return roundAmount(discount), nil
+}
+
+// earnLoyaltyPoints credits points for a confirmed online booking at the
+// owner's tier rate. It is a no-op if the booking already earned points.
+func (s *BookingService) earnLoyaltyPoints(ctx context.Context, tx bun.Tx, booking *models.Booking) (int, error) {
+	if booking.BookingType != models.BookingTypeOnline {
+		return 0, nil
+	}
+
+	transactions, err := datastore.GetLoyaltyTransactionsByBookingId(ctx, tx, booking.Id)
+	if err != nil {
+		return 0, err
+	}
+	for _, transaction := range transactions {
+		if transaction.Type == models.LoyaltyTransactionEarn {
+			return 0, nil
+		}
+	}
+
+	tiers, err := datastore.GetLoyaltyTiers(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	earnRate := 0.0
+	account, err := datastore.GetLoyaltyAccount(ctx, tx, booking.UserId)
+	switch {
+	case err == nil:
+		for _, tier := range tiers {
+			if tier.Name == account.Tier {
+				earnRate = tier.EarnRate
+			}
+		}
+	case errors.Is(err, sql.ErrNoRows):
+		if tier := loyaltyTierFor(tiers, 0); tier != nil {
+			earnRate = tier.EarnRate
+		}
+	default:
+		return 0, err
+	}
+
+	points := int(math.Floor(booking.TotalAmount / 1000 * earnRate))
+	if points <= 0 {
+		return 0, nil
+	}
+
+	_, err = s.postLoyaltyTransaction(ctx, tx, booking.UserId, booking.Id, models.LoyaltyTransactionEarn, points,
+		fmt.Sprintf("Earned for booking %s", booking.Id))
+	if err != nil {
+		return 0, err
+	}
+
+	return points, nil
+}
+
+// reverseLoyaltyPoints undoes a cancelled booking's points: earned points are
+// taken back, even if that leaves the balance negative, and redeemed points
+// are returned.
+func (s *BookingService) reverseLoyaltyPoints(ctx context.Context, tx bun.Tx, booking *models.Booking) error {
+	transactions, err := datastore.GetLoyaltyTransactionsByBookingId(ctx, tx, booking.Id)
+	if err != nil {
+		return err
+	}
+
+	posted := make(map[models.LoyaltyTransactionType]*models.LoyaltyTransaction, len(transactions))
+	for _, transaction := range transactions {
+		posted[transaction.Type] = transaction
+	}
+
+	if earn, ok := posted[models.LoyaltyTransactionEarn]; ok && posted[models.LoyaltyTransactionEarnReversal] == nil {
+		_, err = s.postLoyaltyTransaction(ctx, tx, booking.UserId, booking.Id, models.LoyaltyTransactionEarnReversal, -earn.Points,
+			fmt.Sprintf("Reversed for cancelled booking %s", booking.Id))
+		if err != nil {
+			return err
+		}
+	}
+
+	if redeem, ok := posted[models.LoyaltyTransactionRedeem]; ok && posted[models.LoyaltyTransactionRedeemRefund] == nil {
+		_, err = s.postLoyaltyTransaction(ctx, tx, booking.UserId, booking.Id, models.LoyaltyTransactionRedeemRefund, -redeem.Points,
+			fmt.Sprintf("Refunded for cancelled booking %s", booking.Id))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *BookingService) GetLoyaltySummary(ctx context.Context, userId string) (*types.LoyaltySummary, error) {
+	tiers, err := datastore.GetLoyaltyTiers(ctx, s.roDb)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := datastore.GetLoyaltyAccount(ctx, s.roDb, userId)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+
+		account = &models.LoyaltyAccount{UserId: userId, Tier: defaultLoyaltyTier}
+		if len(tiers) > 0 {
+			account.Tier = tiers[0].Name
+		}
+	}
+
+	summary := &types.LoyaltySummary{
+		UserId:         account.UserId,
+		Balance:        account.Balance,
+		LifetimePoints: account.LifetimePoints,
+		Tier:           account.Tier,
+		PointValue:     s.loyaltyPointValue,
+	}
+
+	for _, tier := range tiers {
+		if tier.Name == account.Tier {
+			summary.EarnRate = tier.EarnRate
+		}
+		if summary.NextTier == "" && tier.MinLifetimePoints > account.LifetimePoints {
+			summary.NextTier = tier.Name
+			summary.PointsToNextTier = tier.MinLifetimePoints - account.LifetimePoints
+		}
+	}
+
+	return summary, nil
+}
+
+func (s *BookingService) GetLoyaltyHistory(ctx context.Context, userId string, page, size int) ([]*models.LoyaltyTransaction, int, error) {
+	_, _, limit, offset := s.normalizePagination(page, size)
+	return datastore.GetLoyaltyTransactionsByUserId(ctx, s.roDb, userId, limit, offset)
+}
+
+func (s *BookingService) GetLoyaltyTiers(ctx context.Context) ([]*models.LoyaltyTier, error) {
+	return datastore.GetLoyaltyTiers(ctx, s.roDb)
+}
+
+func (s *BookingService) UpdateLoyaltyTier(ctx context.Context, tier *models.LoyaltyTier) (*models.LoyaltyTier, error) {
+	if tier.Name == "" || tier.MinLifetimePoints < 0 || tier.EarnRate < 0 {
+		return nil, ErrInvalidLoyaltyTier
+	}
+
+	found, err := datastore.UpdateLoyaltyTier(ctx, s.db, tier)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ErrLoyaltyTierNotFound
+	}
+
+	return tier, nil
+}
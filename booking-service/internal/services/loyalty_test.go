@@ -0,0 +1,76 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"booking-service/internal/models"
+)
+
+func TestLoyaltyTierFor(t *testing.T) {
+	tiers := []*models.LoyaltyTier{
+		{Name: "MEMBER", MinLifetimePoints: 0},
+		{Name: "SILVER", MinLifetimePoints: 1000},
+		{Name: "GOLD", MinLifetimePoints: 5000},
+	}
+
+	tests := []struct {
+		name           string
+		tiers          []*models.LoyaltyTier
+		lifetimePoints int
+		wantTier       string
+	}{
+		{name: "no tiers", lifetimePoints: 10000},
+		{name: "new account", tiers: tiers, lifetimePoints: 0, wantTier: "MEMBER"},
+		{name: "just below silver", tiers: tiers, lifetimePoints: 999, wantTier: "MEMBER"},
+		{name: "at silver", tiers: tiers, lifetimePoints: 1000, wantTier: "SILVER"},
+		{name: "just below gold", tiers: tiers, lifetimePoints: 4999, wantTier: "SILVER"},
+		{name: "at gold", tiers: tiers, lifetimePoints: 5000, wantTier: "GOLD"},
+		{name: "above highest tier", tiers: tiers, lifetimePoints: 100000, wantTier: "GOLD"},
+		{name: "below lowest tier", tiers: tiers[1:], lifetimePoints: 500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tier := loyaltyTierFor(tt.tiers, tt.lifetimePoints)
+
+			var got string
+			if tier != nil {
+				got = tier.Name
+			}
+			if got != tt.wantTier {
+				t.Errorf("Expected tier %q, got %q", tt.wantTier, got)
+			}
+		})
+	}
+}
+
+func TestPointsDiscountFor(t *testing.T) {
+	s := &BookingService{loyaltyPointValue: 100, loyaltyMaxRedeemRatio: 0.5}
+
+	tests := []struct {
+		name         string
+		points       int
+		amountDue    float64
+		wantDiscount float64
+		wantErr      error
+	}{
+		{name: "no points", points: 0, amountDue: 100000, wantDiscount: 0},
+		{name: "under the cap", points: 100, amountDue: 100000, wantDiscount: 10000},
+		{name: "at the cap", points: 500, amountDue: 100000, wantDiscount: 50000},
+		{name: "one point over the cap", points: 501, amountDue: 100000, wantErr: ErrPointsRedemptionTooHigh},
+		{name: "nothing due", points: 1, amountDue: 0, wantErr: ErrPointsRedemptionTooHigh},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			discount, err := s.pointsDiscountFor(tt.points, tt.amountDue)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Expected error %v, got: %v", tt.wantErr, err)
+			}
+			if discount != tt.wantDiscount {
+				t.Errorf("Expected discount %.2f, got %.2f", tt.wantDiscount, discount)
+			}
+		})
+	}
+}
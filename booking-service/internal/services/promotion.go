@@ -0,0 +1,283 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"booking-service/internal/datastore"
+	"booking-service/internal/models"
+	"booking-service/internal/types"
+	"booking-service/proto/pb"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+var (
+	ErrInvalidPromotion           = fmt.Errorf("invalid promotion data")
+	ErrPromotionNotFound          = fmt.Errorf("promotion code not found")
+	ErrPromotionInactive          = fmt.Errorf("promotion code is not active")
+	ErrPromotionNotApplicable     = fmt.Errorf("promotion code does not apply to this booking")
+	ErrPromotionUsageLimitReached = fmt.Errorf("promotion code has reached its usage limit")
+	ErrPromotionUserLimitReached  = fmt.Errorf("promotion code has reached its usage limit for this user")
+)
+
+// promotionTarget is what a promo code is evaluated against.
+type promotionTarget struct {
+	userId     string
+	showtime   *pb.ShowtimeData
+	seatPrices []float64
+	subtotal   float64
+}
+
+func newPromotionTarget(userId string, showtime *pb.ShowtimeData, seats *pb.GetSeatsWithPriceResponse) *promotionTarget {
+	seatPrices := make([]float64, 0, len(seats.Data))
+	for _, seat := range seats.Data {
+		seatPrices = append(seatPrices, seat.Price)
+	}
+
+	return &promotionTarget{
+		userId:     userId,
+		showtime:   showtime,
+		seatPrices: seatPrices,
+		subtotal:   seats.TotalAmount,
+	}
+}
+
+func normalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func roundAmount(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+// evaluatePromotion checks the promotion's rules against the target and
+// returns the discount it grants. It does not look at usage limits.
+func evaluatePromotion(promotion *models.Promotion, target *promotionTarget, now time.Time) (float64, error) {
+	if !promotion.IsActive {
+		return 0, ErrPromotionInactive
+	}
+
+	if promotion.ValidFrom != nil && now.Before(*promotion.ValidFrom) {
+		return 0, ErrPromotionInactive
+	}
+
+	if promotion.ValidUntil != nil && now.After(*promotion.ValidUntil) {
+		return 0, ErrPromotionInactive
+	}
+
+	if len(promotion.MovieIds) > 0 && !slices.Contains(promotion.MovieIds, target.showtime.MovieId) {
+		return 0, ErrPromotionNotApplicable
+	}
+
+	if len(promotion.Formats) > 0 && !slices.Contains(promotion.Formats, target.showtime.Format) {
+		return 0, ErrPromotionNotApplicable
+	}
+
+	if len(promotion.Weekdays) > 0 {
+		start, err := parseShowtimeStart(target.showtime)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse showtime start: %w", err)
+		}
+		if !slices.Contains(promotion.Weekdays, int(start.Weekday())) {
+			return 0, ErrPromotionNotApplicable
+		}
+	}
+
+	if len(target.seatPrices) < promotion.MinTickets {
+		return 0, ErrPromotionNotApplicable
+	}
+
+	var discount float64
+	switch promotion.DiscountType {
+	case models.DiscountTypePercentage:
+		discount = target.subtotal * promotion.DiscountValue / 100
+		if promotion.MaxDiscount > 0 {
+			discount = math.Min(discount, promotion.MaxDiscount)
+		}
+	case models.DiscountTypeFixed:
+		discount = promotion.DiscountValue
+	case models.DiscountTypeBuyXGetY:
+		// Every full group of buy+get seats gets its cheapest seats free.
+		groupSize := promotion.BuyQuantity + promotion.GetQuantity
+		freeSeats := len(target.seatPrices) / groupSize * promotion.GetQuantity
+		if freeSeats == 0 {
+			return 0, ErrPromotionNotApplicable
+		}
+
+		prices := slices.Clone(target.seatPrices)
+		sort.Float64s(prices)
+		for _, price := range prices[:freeSeats] {
+			discount += price
+		}
+	default:
+		return 0, ErrInvalidPromotion
+	}
+
+	return roundAmount(math.Min(discount, target.subtotal)), nil
+}
+
+// checkPromotionUsage enforces the promotion's overall usage limit and, given
+// how many active redemptions the user already has, its per-user limit.
+func checkPromotionUsage(promotion *models.Promotion, userRedemptions int) error {
+	if promotion.UsageLimit > 0 && promotion.UsageCount >= promotion.UsageLimit {
+		return ErrPromotionUsageLimitReached
+	}
+
+	if promotion.UsageLimitPerUser > 0 && userRedemptions >= promotion.UsageLimitPerUser {
+		return ErrPromotionUserLimitReached
+	}
+
+	return nil
+}
+
+// resolvePromotion loads a promo code and prices it for the target, checking
+// its usage limits. Inside a booking transaction forUpdate locks the
+// promotion row so concurrent bookings cannot over-redeem it.
+func (s *BookingService) resolvePromotion(ctx context.Context, db bun.IDB, code string, target *promotionTarget, forUpdate bool) (*models.Promotion, float64, error) {
+	var promotion *models.Promotion
+	var err error
+	if forUpdate {
+		promotion, err = datastore.GetPromotionByCodeForUpdate(ctx, db, code)
+	} else {
+		promotion, err = datastore.GetPromotionByCode(ctx, db, code)
+	}
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, 0, ErrPromotionNotFound
+		}
+		return nil, 0, err
+	}
+
+	discount, err := evaluatePromotion(promotion, target, time.Now())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var userRedemptions int
+	if promotion.UsageLimitPerUser > 0 {
+		userRedemptions, err = datastore.CountActiveRedemptionsByUser(ctx, db, promotion.Id, target.userId)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	if err = checkPromotionUsage(promotion, userRedemptions); err != nil {
+		return nil, 0, err
+	}
+
+	if promotion.FirstBookingOnly {
+		bookings, err := datastore.CountBookingsByUserId(ctx, db, target.userId)
+		if err != nil {
+			return nil, 0, err
+		}
+		if bookings > 0 {
+			return nil, 0, ErrPromotionNotApplicable
+		}
+	}
+
+	return promotion, discount, nil
+}
+
+// QuotePromotion prices a promo code for the given seats without redeeming
+// it, so clients can show and submit the discounted total.
+func (s *BookingService) QuotePromotion(ctx context.Context, userId, code, showtimeId string, seatIds []string) (*types.PromotionQuote, error) {
+	code = normalizePromoCode(code)
+	if code == "" || showtimeId == "" || len(seatIds) == 0 {
+		return nil, ErrInvalidBookingData
+	}
+
+	seats, err := s.movieClient.GetSeatsWithPrice(ctx, showtimeId, seatIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get seat prices: %w", err)
+	}
+
+	showtime, err := s.movieClient.GetShowtime(ctx, showtimeId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get showtime: %w", err)
+	}
+
+	promotion, discount, err := s.resolvePromotion(ctx, s.roDb, code, newPromotionTarget(userId, showtime, seats), false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.PromotionQuote{
+		PromoCode:      promotion.Code,
+		Description:    promotion.Description,
+		Subtotal:       seats.TotalAmount,
+		DiscountAmount: discount,
+		TotalAmount:    roundAmount(seats.TotalAmount - discount),
+	}, nil
+}
+
+func (s *BookingService) CreatePromotion(ctx context.Context, staffId string, promotion *models.Promotion) (*models.Promotion, error) {
+	promotion.Code = normalizePromoCode(promotion.Code)
+	if promotion.Code == "" || promotion.DiscountValue < 0 || promotion.MaxDiscount < 0 ||
+		promotion.UsageLimit < 0 || promotion.UsageLimitPerUser < 0 || promotion.MinTickets < 0 {
+		return nil, ErrInvalidPromotion
+	}
+
+	switch promotion.DiscountType {
+	case models.DiscountTypePercentage:
+		if promotion.DiscountValue <= 0 || promotion.DiscountValue > 100 {
+			return nil, ErrInvalidPromotion
+		}
+	case models.DiscountTypeFixed:
+		if promotion.DiscountValue <= 0 {
+			return nil, ErrInvalidPromotion
+		}
+	case models.DiscountTypeBuyXGetY:
+		if promotion.BuyQuantity <= 0 || promotion.GetQuantity <= 0 {
+			return nil, ErrInvalidPromotion
+		}
+	default:
+		return nil, ErrInvalidPromotion
+	}
+
+	for _, weekday := range promotion.Weekdays {
+		if weekday < int(time.Sunday) || weekday > int(time.Saturday) {
+			return nil, ErrInvalidPromotion
+		}
+	}
+
+	if promotion.ValidFrom != nil && promotion.ValidUntil != nil && !promotion.ValidUntil.After(*promotion.ValidFrom) {
+		return nil, ErrInvalidPromotion
+	}
+
+	promotion.Id = uuid.New().String()
+	promotion.UsageCount = 0
+	promotion.IsActive = true
+	promotion.CreatedBy = staffId
+
+	if err := datastore.CreatePromotion(ctx, s.db, promotion); err != nil {
+		return nil, err
+	}
+
+	return promotion, nil
+}
+
+func (s *BookingService) GetPromotions(ctx context.Context, activeOnly bool, page, size int) ([]*models.Promotion, int, error) {
+	_, _, limit, offset := s.normalizePagination(page, size)
+	return datastore.GetPromotions(ctx, s.roDb, activeOnly, limit, offset)
+}
+
+func (s *BookingService) DeactivatePromotion(ctx context.Context, promotionId string) error {
+	found, err := datastore.DeactivatePromotion(ctx, s.db, promotionId)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return ErrPromotionNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,246 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"booking-service/internal/models"
+	"booking-service/proto/pb"
+)
+
+func TestEvaluatePromotion(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.Local)
+	yesterday := now.Add(-24 * time.Hour)
+	tomorrow := now.Add(24 * time.Hour)
+
+	// 2026-10-16 is a Friday.
+	showtime := &pb.ShowtimeData{
+		MovieId:      "movie-1",
+		Format:       "IMAX",
+		ShowtimeDate: "2026-10-16",
+		ShowtimeTime: "20:00:00",
+	}
+
+	target := func(prices ...float64) *promotionTarget {
+		var subtotal float64
+		for _, price := range prices {
+			subtotal += price
+		}
+		return &promotionTarget{userId: "user-1", showtime: showtime, seatPrices: prices, subtotal: subtotal}
+	}
+
+	percentage := func(value float64) *models.Promotion {
+		return &models.Promotion{IsActive: true, DiscountType: models.DiscountTypePercentage, DiscountValue: value}
+	}
+
+	tests := []struct {
+		name         string
+		promotion    *models.Promotion
+		target       *promotionTarget
+		wantDiscount float64
+		wantErr      error
+	}{
+		{
+			name:      "inactive",
+			promotion: &models.Promotion{DiscountType: models.DiscountTypeFixed, DiscountValue: 10},
+			target:    target(100),
+			wantErr:   ErrPromotionInactive,
+		},
+		{
+			name:      "not yet valid",
+			promotion: &models.Promotion{IsActive: true, DiscountType: models.DiscountTypeFixed, DiscountValue: 10, ValidFrom: &tomorrow},
+			target:    target(100),
+			wantErr:   ErrPromotionInactive,
+		},
+		{
+			name:      "expired",
+			promotion: &models.Promotion{IsActive: true, DiscountType: models.DiscountTypeFixed, DiscountValue: 10, ValidUntil: &yesterday},
+			target:    target(100),
+			wantErr:   ErrPromotionInactive,
+		},
+		{
+			name:         "valid from now",
+			promotion:    &models.Promotion{IsActive: true, DiscountType: models.DiscountTypeFixed, DiscountValue: 10, ValidFrom: &now},
+			target:       target(100),
+			wantDiscount: 10,
+		},
+		{
+			name:         "valid until now",
+			promotion:    &models.Promotion{IsActive: true, DiscountType: models.DiscountTypeFixed, DiscountValue: 10, ValidFrom: &yesterday, ValidUntil: &now},
+			target:       target(100),
+			wantDiscount: 10,
+		},
+		{
+			name:      "other movie",
+			promotion: &models.Promotion{IsActive: true, DiscountType: models.DiscountTypeFixed, DiscountValue: 10, MovieIds: []string{"movie-2"}},
+			target:    target(100),
+			wantErr:   ErrPromotionNotApplicable,
+		},
+		{
+			name:      "other format",
+			promotion: &models.Promotion{IsActive: true, DiscountType: models.DiscountTypeFixed, DiscountValue: 10, Formats: []string{"2D"}},
+			target:    target(100),
+			wantErr:   ErrPromotionNotApplicable,
+		},
+		{
+			name:      "other weekday",
+			promotion: &models.Promotion{IsActive: true, DiscountType: models.DiscountTypeFixed, DiscountValue: 10, Weekdays: []int{int(time.Tuesday)}},
+			target:    target(100),
+			wantErr:   ErrPromotionNotApplicable,
+		},
+		{
+			name: "matching movie, format and weekday",
+			promotion: &models.Promotion{IsActive: true, DiscountType: models.DiscountTypeFixed, DiscountValue: 10,
+				MovieIds: []string{"movie-1"}, Formats: []string{"IMAX"}, Weekdays: []int{int(time.Friday)}},
+			target:       target(100),
+			wantDiscount: 10,
+		},
+		{
+			name:      "below minimum tickets",
+			promotion: &models.Promotion{IsActive: true, DiscountType: models.DiscountTypeFixed, DiscountValue: 10, MinTickets: 3},
+			target:    target(50, 50),
+			wantErr:   ErrPromotionNotApplicable,
+		},
+		{
+			name:         "at minimum tickets",
+			promotion:    &models.Promotion{IsActive: true, DiscountType: models.DiscountTypeFixed, DiscountValue: 10, MinTickets: 2},
+			target:       target(50, 50),
+			wantDiscount: 10,
+		},
+		{
+			name:         "percentage",
+			promotion:    percentage(15),
+			target:       target(80, 120),
+			wantDiscount: 30,
+		},
+		{
+			name:         "percentage rounded to cents",
+			promotion:    percentage(10),
+			target:       target(33.33),
+			wantDiscount: 3.33,
+		},
+		{
+			name:         "percentage under cap",
+			promotion:    &models.Promotion{IsActive: true, DiscountType: models.DiscountTypePercentage, DiscountValue: 10, MaxDiscount: 50},
+			target:       target(200),
+			wantDiscount: 20,
+		},
+		{
+			name:         "percentage capped",
+			promotion:    &models.Promotion{IsActive: true, DiscountType: models.DiscountTypePercentage, DiscountValue: 50, MaxDiscount: 40},
+			target:       target(100, 100),
+			wantDiscount: 40,
+		},
+		{
+			name:         "fixed",
+			promotion:    &models.Promotion{IsActive: true, DiscountType: models.DiscountTypeFixed, DiscountValue: 25},
+			target:       target(100),
+			wantDiscount: 25,
+		},
+		{
+			name:         "fixed capped at subtotal",
+			promotion:    &models.Promotion{IsActive: true, DiscountType: models.DiscountTypeFixed, DiscountValue: 250},
+			target:       target(80, 120),
+			wantDiscount: 200,
+		},
+		{
+			name:      "buy two get one with too few seats",
+			promotion: &models.Promotion{IsActive: true, DiscountType: models.DiscountTypeBuyXGetY, BuyQuantity: 2, GetQuantity: 1},
+			target:    target(100, 100),
+			wantErr:   ErrPromotionNotApplicable,
+		},
+		{
+			name:         "buy two get one frees the cheapest seat",
+			promotion:    &models.Promotion{IsActive: true, DiscountType: models.DiscountTypeBuyXGetY, BuyQuantity: 2, GetQuantity: 1},
+			target:       target(120, 80, 100),
+			wantDiscount: 80,
+		},
+		{
+			name:         "buy two get one counts full groups only",
+			promotion:    &models.Promotion{IsActive: true, DiscountType: models.DiscountTypeBuyXGetY, BuyQuantity: 2, GetQuantity: 1},
+			target:       target(120, 80, 100, 90, 110),
+			wantDiscount: 80,
+		},
+		{
+			name:         "buy two get one over two groups",
+			promotion:    &models.Promotion{IsActive: true, DiscountType: models.DiscountTypeBuyXGetY, BuyQuantity: 2, GetQuantity: 1},
+			target:       target(120, 80, 100, 90, 110, 130),
+			wantDiscount: 170,
+		},
+		{
+			name:      "unknown discount type",
+			promotion: &models.Promotion{IsActive: true, DiscountType: "FREE_POPCORN"},
+			target:    target(100),
+			wantErr:   ErrInvalidPromotion,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			discount, err := evaluatePromotion(tt.promotion, tt.target, now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Expected error %v, got: %v", tt.wantErr, err)
+			}
+			if discount != tt.wantDiscount {
+				t.Errorf("Expected discount %.2f, got %.2f", tt.wantDiscount, discount)
+			}
+		})
+	}
+}
+
+func TestCheckPromotionUsage(t *testing.T) {
+	tests := []struct {
+		name            string
+		promotion       *models.Promotion
+		userRedemptions int
+		wantErr         error
+	}{
+		{name: "unlimited", promotion: &models.Promotion{UsageCount: 1000}, userRedemptions: 100},
+		{name: "under usage limit", promotion: &models.Promotion{UsageLimit: 10, UsageCount: 9}},
+		{name: "usage limit reached", promotion: &models.Promotion{UsageLimit: 10, UsageCount: 10}, wantErr: ErrPromotionUsageLimitReached},
+		{name: "usage limit exceeded", promotion: &models.Promotion{UsageLimit: 10, UsageCount: 11}, wantErr: ErrPromotionUsageLimitReached},
+		{name: "under user limit", promotion: &models.Promotion{UsageLimitPerUser: 2}, userRedemptions: 1},
+		{name: "user limit reached", promotion: &models.Promotion{UsageLimitPerUser: 2}, userRedemptions: 2, wantErr: ErrPromotionUserLimitReached},
+		{
+			name:            "usage limit checked first",
+			promotion:       &models.Promotion{UsageLimit: 1, UsageCount: 1, UsageLimitPerUser: 1},
+			userRedemptions: 1,
+			wantErr:         ErrPromotionUsageLimitReached,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkPromotionUsage(tt.promotion, tt.userRedemptions); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected error %v, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestNormalizePagination(t *testing.T) {
+	s := &BookingService{}
+
+	tests := []struct {
+		name                                      string
+		page, size                                int
+		wantPage, wantSize, wantLimit, wantOffset int
+	}{
+		{name: "defaults", wantPage: 1, wantSize: 10, wantLimit: 10, wantOffset: 0},
+		{name: "first page", page: 1, size: 20, wantPage: 1, wantSize: 20, wantLimit: 20, wantOffset: 0},
+		{name: "third page", page: 3, size: 20, wantPage: 3, wantSize: 20, wantLimit: 20, wantOffset: 40},
+		{name: "negative page", page: -2, size: 5, wantPage: 1, wantSize: 5, wantLimit: 5, wantOffset: 0},
+		{name: "size capped", page: 2, size: 500, wantPage: 2, wantSize: 100, wantLimit: 100, wantOffset: 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, size, limit, offset := s.normalizePagination(tt.page, tt.size)
+			if page != tt.wantPage || size != tt.wantSize || limit != tt.wantLimit || offset != tt.wantOffset {
+				t.Errorf("Expected (%d, %d, %d, %d), got (%d, %d, %d, %d)",
+					tt.wantPage, tt.wantSize, tt.wantLimit, tt.wantOffset, page, size, limit, offset)
+			}
+		})
+	}
+}
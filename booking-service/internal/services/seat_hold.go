@@ -0,0 +1,395 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"booking-service/internal/datastore"
+	"booking-service/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	ErrSeatHoldNotFound     = fmt.Errorf("seat hold not found")
+	ErrSeatHoldExpired      = fmt.Errorf("seat hold has expired")
+	ErrSeatHoldAccessDenied = fmt.Errorf("seat hold does not belong to user")
+	ErrSeatHoldLimitReached = fmt.Errorf("maximum number of active seat holds reached")
+)
+
+const (
+	// seatHoldQuoteGrace keeps a hold's price quote valid a little longer
+	// than the hold itself.
+	seatHoldQuoteGrace = time.Minute
+	defaultExpireHolds = 100
+)
+
+// Regular holds are indexed by expiry (ms) so the sweeper can hand the seats
+// of holds that ran out to the waitlist. The hold record is gone by then, so
+// each indexed hold's "showtimeId|seatId,seatId" is kept alongside.
+const (
+	keySeatHoldExpiry      = "seat_hold:expiring"
+	keySeatHoldExpirySeats = "seat_hold:expiring:seats"
+)
+
+func keySeatHold(holdId string) string {
+	return fmt.Sprintf("seat_hold:%s", holdId)
+}
+
+func keyUserSeatHolds(userId string) string {
+	return fmt.Sprintf("seat_hold:user:%s", userId)
+}
+
+func (s *BookingService) CreateSeatHold(ctx context.Context, userId, showtimeId string, seatIds []string) (*models.SeatHold, error) {
+	if userId == "" || showtimeId == "" || len(seatIds) == 0 {
+		return nil, ErrInvalidBookingData
+	}
+
+	if err := s.checkSeatCount(seatIds); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkSeatAdjacency(ctx, userId, showtimeId, seatIds); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	hold := &models.SeatHold{
+		Id:         uuid.New().String(),
+		UserId:     userId,
+		ShowtimeId: showtimeId,
+		SeatIds:    seatIds,
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(s.holdTTL),
+	}
+
+	if err := s.reserveSeatHold(ctx, hold); err != nil {
+		return nil, err
+	}
+
+	err := s.acquireDistributedSeatLocks(ctx, showtimeId, seatIds, hold.Id, s.holdTTL)
+	if err != nil {
+		s.unreserveSeatHold(ctx, hold)
+		return nil, err
+	}
+
+	if err = s.checkBookedSeats(ctx, s.roDb, showtimeId, seatIds); err != nil {
+		s.abandonSeatHold(ctx, hold)
+		return nil, err
+	}
+
+	if err = s.quoteSeatHold(ctx, hold, s.holdTTL); err != nil {
+		s.abandonSeatHold(ctx, hold)
+		return nil, err
+	}
+
+	if err = s.saveSeatHold(ctx, hold); err != nil {
+		s.abandonSeatHold(ctx, hold)
+		return nil, err
+	}
+
+	return hold, nil
+}
+
+func (s *BookingService) ExtendSeatHold(ctx context.Context, userId, holdId string) (*models.SeatHold, error) {
+	hold, err := s.getOwnedSeatHold(ctx, userId, holdId)
+	if err != nil {
+		return nil, err
+	}
+
+	if hold.WaitlistEntryId != "" {
+		return nil, ErrSeatHoldNotExtendable
+	}
+
+	if err = s.extendSeatHoldLocks(ctx, hold, s.holdTTL); err != nil {
+		return nil, err
+	}
+
+	if err = s.quoteSeatHold(ctx, hold, s.holdTTL); err != nil {
+		return nil, err
+	}
+
+	hold.ExpiresAt = time.Now().Add(s.holdTTL)
+	if err = s.saveSeatHold(ctx, hold); err != nil {
+		return nil, err
+	}
+
+	return hold, nil
+}
+
+func (s *BookingService) ReleaseSeatHold(ctx context.Context, userId, holdId string) error {
+	hold, err := s.getOwnedSeatHold(ctx, userId, holdId)
+	if err != nil {
+		return err
+	}
+
+	// Releasing a waitlist offer declines it so the seats go to the next in line.
+	if hold.WaitlistEntryId != "" {
+		return s.LeaveWaitlist(ctx, userId, hold.WaitlistEntryId)
+	}
+
+	s.releaseDistributedSeatLocks(ctx, hold.ShowtimeId, hold.Id, hold.SeatIds)
+
+	if err = s.deleteSeatHold(ctx, hold); err != nil {
+		return err
+	}
+
+	eventData := &models.SeatReleasedEventData{
+		ShowtimeId: hold.ShowtimeId,
+		SeatIds:    hold.SeatIds,
+		Reason:     models.SeatReleaseReasonSeatHoldReleased,
+	}
+
+	return datastore.CreateOutboxEvent(ctx, s.db, models.EventTypeSeatReleased, eventData)
+}
+
+// ExpireSeatHolds hands the seats of holds that ran out to the waitlist
+// through SEAT_RELEASED events. Waitlist offers expire through
+// ExpireWaitlistOffers instead.
+func (s *BookingService) ExpireSeatHolds(ctx context.Context, limit int) (int, error) {
+	if limit <= 0 {
+		limit = defaultExpireHolds
+	}
+
+	holdIds, err := s.redisClient.ZRangeByScore(ctx, keySeatHoldExpiry, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(time.Now().UnixMilli(), 10),
+		Count: int64(limit),
+	}).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get expired seat holds: %w", err)
+	}
+
+	expired := 0
+	for _, holdId := range holdIds {
+		// Only the sweep that removes the entry releases the hold, so
+		// concurrent sweeps do not offer its seats twice.
+		removed, err := s.redisClient.ZRem(ctx, keySeatHoldExpiry, holdId).Result()
+		if err != nil {
+			return expired, fmt.Errorf("failed to unindex seat hold: %w", err)
+		}
+		if removed == 0 {
+			continue
+		}
+
+		seats, err := s.redisClient.HGet(ctx, keySeatHoldExpirySeats, holdId).Result()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return expired, fmt.Errorf("failed to get seat hold seats: %w", err)
+		}
+
+		if showtimeId, seatIds, ok := parseSeatHoldExpirySeats(seats); ok {
+			eventData := &models.SeatReleasedEventData{
+				ShowtimeId: showtimeId,
+				SeatIds:    seatIds,
+				Reason:     models.SeatReleaseReasonSeatHoldExpired,
+			}
+			if err = datastore.CreateOutboxEvent(ctx, s.db, models.EventTypeSeatReleased, eventData); err != nil {
+				// Put the hold back so the next sweep retries it.
+				s.redisClient.ZAdd(ctx, keySeatHoldExpiry, redis.Z{Score: float64(time.Now().UnixMilli()), Member: holdId})
+				return expired, err
+			}
+			expired++
+		}
+
+		if err = s.redisClient.HDel(ctx, keySeatHoldExpirySeats, holdId).Err(); err != nil {
+			logrus.WithError(err).WithField("hold_id", holdId).Error("Failed to delete expired seat hold seats")
+		}
+	}
+
+	return expired, nil
+}
+
+func formatSeatHoldExpirySeats(hold *models.SeatHold) string {
+	return hold.ShowtimeId + "|" + strings.Join(hold.SeatIds, ",")
+}
+
+func parseSeatHoldExpirySeats(value string) (string, []string, bool) {
+	showtimeId, seats, ok := strings.Cut(value, "|")
+	if !ok || showtimeId == "" || seats == "" {
+		return "", nil, false
+	}
+	return showtimeId, strings.Split(seats, ","), true
+}
+
+// claimSeatHold turns a hold into the booking's seat locks: the locks are
+// re-armed for the booking window and the hold record is dropped, so the
+// caller can skip the availability check.
+func (s *BookingService) claimSeatHold(ctx context.Context, userId, holdId string, lockDuration time.Duration) (*models.SeatHold, error) {
+	hold, err := s.getOwnedSeatHold(ctx, userId, holdId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.extendSeatHoldLocks(ctx, hold, lockDuration); err != nil {
+		return nil, err
+	}
+
+	return hold, nil
+}
+
+// quoteSeatHold fixes the prices of the hold's seats until the hold expires,
+// re-arming its quote if it has one. The quote outlives the hold by a grace
+// period so a booking made at the last moment still gets the quoted prices.
+func (s *BookingService) quoteSeatHold(ctx context.Context, hold *models.SeatHold, ttl time.Duration) error {
+	quote, err := s.movieClient.QuoteSeatPrices(ctx, hold.ShowtimeId, hold.SeatIds, nil, hold.QuoteId, ttl+seatHoldQuoteGrace)
+	if err != nil {
+		return fmt.Errorf("failed to quote seat prices: %w", err)
+	}
+
+	hold.QuoteId = quote.QuoteId
+	hold.TotalAmount = quote.TotalAmount
+
+	return nil
+}
+
+func (s *BookingService) getOwnedSeatHold(ctx context.Context, userId, holdId string) (*models.SeatHold, error) {
+	if holdId == "" {
+		return nil, ErrSeatHoldNotFound
+	}
+
+	data, err := s.redisClient.Get(ctx, keySeatHold(holdId)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrSeatHoldNotFound
+		}
+		return nil, fmt.Errorf("failed to get seat hold: %w", err)
+	}
+
+	hold := new(models.SeatHold)
+	if err = json.Unmarshal(data, hold); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal seat hold: %w", err)
+	}
+
+	if hold.UserId != userId {
+		return nil, ErrSeatHoldAccessDenied
+	}
+
+	return hold, nil
+}
+
+func (s *BookingService) extendSeatHoldLocks(ctx context.Context, hold *models.SeatHold, ttl time.Duration) error {
+	lost, err := s.extendOwnedSeatStates(ctx, hold.ShowtimeId, hold.Id, hold.SeatIds, ttl)
+	if err != nil {
+		return fmt.Errorf("failed to extend seat hold locks: %w", err)
+	}
+
+	if len(lost) > 0 {
+		_ = s.deleteSeatHold(ctx, hold)
+		return &SeatConflictError{Err: ErrSeatHoldExpired, SeatIds: lost}
+	}
+
+	expiresAt := time.Now().Add(ttl)
+	s.publishSeatStates(ctx, hold.ShowtimeId, hold.SeatIds, models.SeatStateHeld, &expiresAt)
+
+	return nil
+}
+
+func (s *BookingService) saveSeatHold(ctx context.Context, hold *models.SeatHold) error {
+	data, err := json.Marshal(hold)
+	if err != nil {
+		return fmt.Errorf("failed to marshal seat hold: %w", err)
+	}
+
+	ttl := time.Until(hold.ExpiresAt)
+	if err = s.redisClient.Set(ctx, keySeatHold(hold.Id), data, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to save seat hold: %w", err)
+	}
+
+	// The index must outlive its longest hold, so its TTL is only ever raised.
+	userKey := keyUserSeatHolds(hold.UserId)
+	pipe := s.redisClient.Pipeline()
+	pipe.ZAdd(ctx, userKey, redis.Z{Score: float64(hold.ExpiresAt.UnixMilli()), Member: hold.Id})
+	pipe.ExpireNX(ctx, userKey, ttl)
+	pipe.ExpireGT(ctx, userKey, ttl)
+	if hold.WaitlistEntryId == "" {
+		pipe.ZAdd(ctx, keySeatHoldExpiry, redis.Z{Score: float64(hold.ExpiresAt.UnixMilli()), Member: hold.Id})
+		pipe.HSet(ctx, keySeatHoldExpirySeats, hold.Id, formatSeatHoldExpirySeats(hold))
+	}
+	if _, err = pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to index seat hold: %w", err)
+	}
+
+	return nil
+}
+
+func (s *BookingService) deleteSeatHold(ctx context.Context, hold *models.SeatHold) error {
+	if err := s.redisClient.Del(ctx, keySeatHold(hold.Id)).Err(); err != nil {
+		return fmt.Errorf("failed to delete seat hold: %w", err)
+	}
+
+	if err := s.redisClient.ZRem(ctx, keyUserSeatHolds(hold.UserId), hold.Id).Err(); err != nil {
+		return fmt.Errorf("failed to unindex seat hold: %w", err)
+	}
+
+	return s.unindexSeatHoldExpiry(ctx, hold.Id)
+}
+
+func (s *BookingService) unindexSeatHoldExpiry(ctx context.Context, holdId string) error {
+	pipe := s.redisClient.Pipeline()
+	pipe.ZRem(ctx, keySeatHoldExpiry, holdId)
+	pipe.HDel(ctx, keySeatHoldExpirySeats, holdId)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to unindex seat hold expiry: %w", err)
+	}
+
+	return nil
+}
+
+// reserveSeatHoldScript prunes the expired holds from a user's hold index
+// (KEYS[1], scored by expiry in ms) and adds hold ARGV[1], expiring at
+// ARGV[2], unless ARGV[3] holds are still active. Checking and adding in one
+// step keeps concurrent requests from going over the limit. It returns 1 when
+// the hold was added.
+var reserveSeatHoldScript = redis.NewScript(`
+local t = redis.call("TIME")
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+redis.call("ZREMRANGEBYSCORE", KEYS[1], "-inf", now)
+if redis.call("ZCARD", KEYS[1]) >= tonumber(ARGV[3]) then
+	return 0
+end
+redis.call("ZADD", KEYS[1], ARGV[2], ARGV[1])
+local ttl = tonumber(ARGV[2]) - now
+if redis.call("PTTL", KEYS[1]) < ttl then
+	redis.call("PEXPIRE", KEYS[1], ttl)
+end
+return 1
+`)
+
+// reserveSeatHold counts the hold against its user's limit of active holds.
+func (s *BookingService) reserveSeatHold(ctx context.Context, hold *models.SeatHold) error {
+	reserved, err := reserveSeatHoldScript.Run(ctx, s.redisClient, []string{keyUserSeatHolds(hold.UserId)},
+		hold.Id, hold.ExpiresAt.UnixMilli(), s.maxHoldsPerUser).Int()
+	if err != nil {
+		return fmt.Errorf("failed to reserve seat hold: %w", err)
+	}
+
+	if reserved == 0 {
+		return ErrSeatHoldLimitReached
+	}
+
+	return nil
+}
+
+// abandonSeatHold undoes a hold that could not be completed: its seat locks
+// are released and it no longer counts against the user's limit.
+func (s *BookingService) abandonSeatHold(ctx context.Context, hold *models.SeatHold) {
+	s.releaseDistributedSeatLocks(ctx, hold.ShowtimeId, hold.Id, hold.SeatIds)
+	s.unreserveSeatHold(ctx, hold)
+}
+
+func (s *BookingService) unreserveSeatHold(ctx context.Context, hold *models.SeatHold) {
+	if err := s.redisClient.ZRem(ctx, keyUserSeatHolds(hold.UserId), hold.Id).Err(); err != nil {
+		logrus.WithError(err).WithField("hold_id", hold.Id).Error("Failed to unreserve seat hold")
+	}
+
+	if err := s.unindexSeatHoldExpiry(ctx, hold.Id); err != nil {
+		logrus.WithError(err).WithField("hold_id", hold.Id).Error("Failed to unreserve seat hold")
+	}
+}
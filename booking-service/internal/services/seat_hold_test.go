@@ -0,0 +1,23 @@
+package services
+
+import (
+	"slices"
+	"testing"
+
+	"booking-service/internal/models"
+)
+
+func TestSeatHoldExpirySeats(t *testing.T) {
+	hold := &models.SeatHold{ShowtimeId: "st-1", SeatIds: []string{"a1", "a2"}}
+
+	showtimeId, seatIds, ok := parseSeatHoldExpirySeats(formatSeatHoldExpirySeats(hold))
+	if !ok || showtimeId != hold.ShowtimeId || !slices.Equal(seatIds, hold.SeatIds) {
+		t.Fatalf("Expected (%s, %v), got (%s, %v, %v)", hold.ShowtimeId, hold.SeatIds, showtimeId, seatIds, ok)
+	}
+
+	for _, value := range []string{"", "st-1", "st-1|", "|a1"} {
+		if _, _, ok = parseSeatHoldExpirySeats(value); ok {
+			t.Errorf("Expected %q to be rejected", value)
+		}
+	}
+}
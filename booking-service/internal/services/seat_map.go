@@ -0,0 +1,43 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"booking-service/internal/models"
+	"booking-service/internal/pkg/pubsub"
+
+	"github.com/sirupsen/logrus"
+)
+
+// seatMapTopic carries the seat state diffs of one showtime. worker-service
+// publishes bookings and releases to it as well, and notification-service
+// relays it to the browsers viewing the seat map.
+func seatMapTopic(showtimeId string) string {
+	return fmt.Sprintf("seat_map_%s", showtimeId)
+}
+
+// publishSeatStates pushes a seat state diff to the showtime's seat map
+// subscribers. Delivery is best effort: failures are only logged, since the
+// seat locks themselves stay the source of truth.
+func (s *BookingService) publishSeatStates(ctx context.Context, showtimeId string, seatIds []string, state models.SeatState, expiresAt *time.Time) {
+	if showtimeId == "" || len(seatIds) == 0 {
+		return
+	}
+
+	message := &pubsub.Message{
+		Topic: seatMapTopic(showtimeId),
+		Data: &models.SeatStateChange{
+			ShowtimeId: showtimeId,
+			SeatIds:    seatIds,
+			State:      state,
+			ExpiresAt:  expiresAt,
+			Timestamp:  time.Now().Unix(),
+		},
+	}
+
+	if err := s.pubsub.Publish(ctx, message); err != nil {
+		logrus.WithError(err).WithField("showtime_id", showtimeId).Error("Failed to publish seat map update")
+	}
+}
@@ -0,0 +1,277 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"booking-service/internal/models"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Seat state of a showtime lives in one hash, keyed by seat id, so locking,
+// releasing and listing seats never has to scan the keyspace. Each entry is
+// "STATE|owner|expiresAtMs": HELD entries are owned by the seat hold or the
+// booking that took the seats, BOOKED entries by the paid booking. Owners are
+// hold and booking ids rather than user ids, so two flows of the same user
+// never release each other's seats. Entries expire lazily: scripts treat an
+// entry past its expiry as free and delete it when they read it, and the hash
+// itself expires with its longest-lived entry.
+//
+// Only this service writes live entries: worker-service changes booking
+// entries through the ClaimBookingSeats and ReleaseBookingSeats RPCs.
+// movie-service reads the hash to build seat maps and, like the scripts
+// here, deletes the expired entries it comes across. A layout change must
+// reach both.
+func keySeatState(showtimeId string) string {
+	return fmt.Sprintf("seat_state:{%s}", showtimeId)
+}
+
+const seatStateScriptPrelude = `
+local function now_ms()
+	local t = redis.call("TIME")
+	return tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+end
+
+local function read_seat(key, seat, now)
+	local value = redis.call("HGET", key, seat)
+	if not value then
+		return nil
+	end
+	local state, owner, expires = string.match(value, "^(%u+)|([^|]*)|(%d+)$")
+	if not state or tonumber(expires) <= now then
+		redis.call("HDEL", key, seat)
+		return nil
+	end
+	return state, owner
+end
+
+local function write_seat(key, seat, state, owner, ttl, now)
+	if ttl <= 0 then
+		redis.call("HDEL", key, seat)
+		return
+	end
+	redis.call("HSET", key, seat, state .. "|" .. owner .. "|" .. (now + ttl))
+	if redis.call("PTTL", key) < ttl then
+		redis.call("PEXPIRE", key, ttl)
+	end
+end
+`
+
+// acquireSeatStatesScript holds every requested seat (ARGV[3..]) for ARGV[1]
+// in one step; nothing is written unless all seats are free or already held
+// by ARGV[1]. It returns the 1-based seat positions held by another user and
+// those that are already booked.
+var acquireSeatStatesScript = redis.NewScript(seatStateScriptPrelude + `
+local now = now_ms()
+local locked = {}
+local booked = {}
+for i = 3, #ARGV do
+	local state, owner = read_seat(KEYS[1], ARGV[i], now)
+	if state == "BOOKED" then
+		table.insert(booked, i - 2)
+	elseif state and owner ~= ARGV[1] then
+		table.insert(locked, i - 2)
+	end
+end
+if #locked > 0 or #booked > 0 then
+	return {locked, booked}
+end
+for i = 3, #ARGV do
+	write_seat(KEYS[1], ARGV[i], "HELD", ARGV[1], tonumber(ARGV[2]), now)
+end
+return {locked, booked}
+`)
+
+// releaseSeatStatesScript deletes the seats (ARGV[2..]) still owned by
+// ARGV[1], so a stale release never drops a seat taken by someone else. It
+// returns the 1-based positions of the seats that are free afterwards.
+var releaseSeatStatesScript = redis.NewScript(seatStateScriptPrelude + `
+local now = now_ms()
+local free = {}
+for i = 2, #ARGV do
+	local state, owner = read_seat(KEYS[1], ARGV[i], now)
+	if state and owner == ARGV[1] then
+		redis.call("HDEL", KEYS[1], ARGV[i])
+		state = nil
+	end
+	if not state then
+		table.insert(free, i - 1)
+	end
+end
+return free
+`)
+
+// extendSeatStatesScript re-arms the seats (ARGV[3..]) still owned by ARGV[1]
+// to expire after ARGV[2] ms and returns the 1-based positions of the seats
+// it no longer owns.
+var extendSeatStatesScript = redis.NewScript(seatStateScriptPrelude + `
+local now = now_ms()
+local lost = {}
+for i = 3, #ARGV do
+	local state, owner = read_seat(KEYS[1], ARGV[i], now)
+	if state and owner == ARGV[1] then
+		write_seat(KEYS[1], ARGV[i], state, owner, tonumber(ARGV[2]), now)
+	else
+		table.insert(lost, i - 2)
+	end
+end
+return lost
+`)
+
+// claimSeatStatesScript sets the seats (ARGV[5..]) to state ARGV[1], owned by
+// ARGV[2], for ARGV[4] ms. A seat is only taken over when it is free or
+// already owned by ARGV[2] or ARGV[3], so a late writer never clobbers
+// someone else's live entry. It returns the 1-based positions of the seats
+// it could not claim.
+var claimSeatStatesScript = redis.NewScript(seatStateScriptPrelude + `
+local now = now_ms()
+local lost = {}
+for i = 5, #ARGV do
+	local state, owner = read_seat(KEYS[1], ARGV[i], now)
+	if not state or owner == ARGV[2] or (ARGV[3] ~= "" and owner == ARGV[3]) then
+		write_seat(KEYS[1], ARGV[i], ARGV[1], ARGV[2], tonumber(ARGV[4]), now)
+	else
+		table.insert(lost, i - 4)
+	end
+end
+return lost
+`)
+
+func seatStateArgs(seatIds []string, args ...interface{}) []interface{} {
+	for _, seatId := range seatIds {
+		args = append(args, seatId)
+	}
+	return args
+}
+
+func (s *BookingService) runAcquireSeatStates(ctx context.Context, showtimeId, owner string, seatIds []string, ttl time.Duration) ([]interface{}, error) {
+	args := seatStateArgs(seatIds, owner, ttl.Milliseconds())
+	return acquireSeatStatesScript.Run(ctx, s.redisClient, []string{keySeatState(showtimeId)}, args...).Slice()
+}
+
+// releaseOwnedSeatStates drops the seats still owned by owner, the hold or
+// booking that took them, and returns the seats that are free afterwards.
+func (s *BookingService) releaseOwnedSeatStates(ctx context.Context, showtimeId, owner string, seatIds []string) ([]string, error) {
+	args := seatStateArgs(seatIds, owner)
+	free, err := releaseSeatStatesScript.Run(ctx, s.redisClient, []string{keySeatState(showtimeId)}, args...).Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.seatIdsAtPositions(free, seatIds), nil
+}
+
+func (s *BookingService) extendOwnedSeatStates(ctx context.Context, showtimeId, owner string, seatIds []string, ttl time.Duration) ([]string, error) {
+	args := seatStateArgs(seatIds, owner, ttl.Milliseconds())
+	lost, err := extendSeatStatesScript.Run(ctx, s.redisClient, []string{keySeatState(showtimeId)}, args...).Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.seatIdsAtPositions(lost, seatIds), nil
+}
+
+// claimSeatStates sets the seats to state for owner unless someone other than
+// owner or prevOwner holds them, and returns the seats it could not claim.
+func (s *BookingService) claimSeatStates(ctx context.Context, showtimeId string, state models.SeatState, owner, prevOwner string, seatIds []string, ttl time.Duration) ([]string, error) {
+	args := seatStateArgs(seatIds, string(state), owner, prevOwner, ttl.Milliseconds())
+	lost, err := claimSeatStatesScript.Run(ctx, s.redisClient, []string{keySeatState(showtimeId)}, args...).Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.seatIdsAtPositions(lost, seatIds), nil
+}
+
+// ClaimBookingSeats hands the seats of a booking over to it in state HELD,
+// while it waits for payment, or BOOKED once paid. Seats already owned by the
+// booking or by prevOwner are taken; any other live entry is left alone. It
+// returns the seats the booking owns afterwards.
+func (s *BookingService) ClaimBookingSeats(ctx context.Context, showtimeId, bookingId, prevOwner string, seatIds []string, state models.SeatState, ttl time.Duration) ([]string, error) {
+	if showtimeId == "" || bookingId == "" || ttl <= 0 {
+		return nil, ErrInvalidBookingData
+	}
+
+	if state != models.SeatStateHeld && state != models.SeatStateBooked {
+		return nil, ErrInvalidSeatState
+	}
+
+	lost, err := s.claimSeatStates(ctx, showtimeId, state, bookingId, prevOwner, seatIds, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed := make([]string, 0, len(seatIds))
+	for _, seatId := range seatIds {
+		if !slices.Contains(lost, seatId) {
+			claimed = append(claimed, seatId)
+		}
+	}
+
+	return claimed, nil
+}
+
+// ReleaseBookingSeats drops the seats still owned by the booking and returns
+// those that are free afterwards.
+func (s *BookingService) ReleaseBookingSeats(ctx context.Context, showtimeId, bookingId string, seatIds []string) ([]string, error) {
+	if showtimeId == "" || bookingId == "" {
+		return nil, ErrInvalidBookingData
+	}
+
+	return s.releaseOwnedSeatStates(ctx, showtimeId, bookingId, seatIds)
+}
+
+// takenSeatStates returns the seats of a showtime that are held or booked,
+// leaving out those of userId's active seat holds so a user is not blocked by
+// their own seats.
+func (s *BookingService) takenSeatStates(ctx context.Context, showtimeId, userId string) (map[string]struct{}, error) {
+	now := time.Now().UnixMilli()
+
+	holdIds, err := s.redisClient.ZRangeByScore(ctx, keyUserSeatHolds(userId), &redis.ZRangeBy{
+		Min: strconv.FormatInt(now, 10),
+		Max: "+inf",
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user seat holds: %w", err)
+	}
+
+	entries, err := s.redisClient.HGetAll(ctx, keySeatState(showtimeId)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get seat states: %w", err)
+	}
+
+	ownHolds := make(map[string]struct{}, len(holdIds))
+	for _, holdId := range holdIds {
+		ownHolds[holdId] = struct{}{}
+	}
+
+	return takenSeats(entries, ownHolds, now), nil
+}
+
+// takenSeats filters the raw entries of a seat state hash, skipping seats
+// held by one of ownHolds. Expired entries are skipped but left for the
+// scripts to clean up.
+func takenSeats(entries map[string]string, ownHolds map[string]struct{}, nowMs int64) map[string]struct{} {
+	taken := make(map[string]struct{}, len(entries))
+	for seatId, value := range entries {
+		parts := strings.Split(value, "|")
+		if len(parts) != 3 {
+			continue
+		}
+		expiresAt, err := strconv.ParseInt(parts[2], 10, 64)
+		if err != nil || expiresAt <= nowMs {
+			continue
+		}
+		if _, ok := ownHolds[parts[1]]; ok && models.SeatState(parts[0]) == models.SeatStateHeld {
+			continue
+		}
+		taken[seatId] = struct{}{}
+	}
+
+	return taken
+}
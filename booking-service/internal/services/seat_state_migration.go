@@ -0,0 +1,175 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"booking-service/internal/datastore"
+	"booking-service/internal/models"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// legacySeatLockPrefixes are the per-seat lock keys written before seat state
+// moved into one hash per showtime, with the seat state each one stood for.
+// Both the plain "prefix:showtime:seat" layout and the hash-tagged
+// "prefix:{showtime}:seat" one are matched.
+var legacySeatLockPrefixes = []struct {
+	prefix string
+	state  models.SeatState
+}{
+	{prefix: "seat:concurrent_lock:", state: models.SeatStateHeld},
+	{prefix: "seat_lock:", state: models.SeatStateBooked},
+}
+
+// MigrateLegacySeatLocks moves the per-seat locks left by older releases into
+// the seat state hash of their showtime, keeping their owner and remaining
+// TTL, and deletes them. Seats that already have a live entry keep it. It
+// returns the number of locks moved.
+func (s *BookingService) MigrateLegacySeatLocks(ctx context.Context) (int, error) {
+	var migrated atomic.Int64
+
+	for _, legacy := range legacySeatLockPrefixes {
+		err := scanRedisKeys(ctx, s.redisClient, legacy.prefix+"*", func(ctx context.Context, key string) error {
+			moved, err := s.migrateLegacySeatLock(ctx, key, legacy.prefix, legacy.state)
+			if err != nil {
+				return fmt.Errorf("failed to migrate seat lock %s: %w", key, err)
+			}
+			if moved {
+				migrated.Add(1)
+			}
+			return nil
+		})
+		if err != nil {
+			return int(migrated.Load()), err
+		}
+	}
+
+	return int(migrated.Load()), nil
+}
+
+func (s *BookingService) migrateLegacySeatLock(ctx context.Context, key, prefix string, state models.SeatState) (bool, error) {
+	showtimeId, seatId, ok := parseLegacySeatLockKey(key, prefix)
+	if !ok {
+		return false, nil
+	}
+
+	owner, err := s.redisClient.Get(ctx, key).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	ttl, err := s.redisClient.PTTL(ctx, key).Result()
+	if err != nil {
+		return false, err
+	}
+
+	moved := false
+	if ttl > 0 && owner != "" {
+		lost, err := s.claimSeatStates(ctx, showtimeId, state, owner, "", []string{seatId}, ttl)
+		if err != nil {
+			return false, err
+		}
+		moved = len(lost) == 0
+	}
+
+	return moved, s.redisClient.Del(ctx, key).Err()
+}
+
+// BackfillSeatStates rebuilds the seat state of upcoming showtimes from the
+// active booking seats, e.g. after Redis lost its data. Confirmed bookings
+// keep their seats BOOKED until the showtime ends and pending ones HELD until
+// their payment window closes. Live entries of other owners are left alone.
+// It returns the number of seats written.
+func (s *BookingService) BackfillSeatStates(ctx context.Context) (int, error) {
+	seats, err := datastore.GetActiveBookingSeatsOfUpcomingShowtimes(ctx, s.roDb)
+	if err != nil {
+		return 0, err
+	}
+
+	bookings := make(map[string][]*datastore.ActiveBookingSeat)
+	for _, seat := range seats {
+		bookings[seat.BookingId] = append(bookings[seat.BookingId], seat)
+	}
+
+	backfilled := 0
+	for bookingId, bookingSeats := range bookings {
+		first := bookingSeats[0]
+
+		state := models.SeatStateBooked
+		expiresAt := first.ShowtimeEndsAt
+		if first.BookingStatus == models.BookingStatusPending {
+			state = models.SeatStateHeld
+			expiresAt = first.BookingCreatedAt.Add(bookingLockDuration)
+		}
+
+		ttl := time.Until(expiresAt)
+		if ttl <= 0 {
+			continue
+		}
+
+		seatIds := make([]string, 0, len(bookingSeats))
+		for _, seat := range bookingSeats {
+			seatIds = append(seatIds, seat.SeatId)
+		}
+
+		claimed, err := s.ClaimBookingSeats(ctx, first.ShowtimeId, bookingId, "", seatIds, state, ttl)
+		if err != nil {
+			return backfilled, fmt.Errorf("failed to backfill seats of booking %s: %w", bookingId, err)
+		}
+		backfilled += len(claimed)
+	}
+
+	return backfilled, nil
+}
+
+// parseLegacySeatLockKey splits "prefix:showtime:seat" or
+// "prefix:{showtime}:seat" into its showtime and seat ids.
+func parseLegacySeatLockKey(key, prefix string) (string, string, bool) {
+	rest := strings.TrimPrefix(key, prefix)
+
+	idx := strings.LastIndex(rest, ":")
+	if idx <= 0 || idx == len(rest)-1 {
+		return "", "", false
+	}
+
+	showtimeId, seatId := rest[:idx], rest[idx+1:]
+	if strings.HasPrefix(showtimeId, "{") && strings.HasSuffix(showtimeId, "}") {
+		showtimeId = showtimeId[1 : len(showtimeId)-1]
+	}
+	if showtimeId == "" || strings.ContainsAny(showtimeId, "{}:") {
+		return "", "", false
+	}
+
+	return showtimeId, seatId, true
+}
+
+// scanRedisKeys calls fn for every key matching pattern, on every master when
+// the client is a cluster.
+func scanRedisKeys(ctx context.Context, client redis.UniversalClient, pattern string, fn func(ctx context.Context, key string) error) error {
+	if clusterClient, ok := client.(*redis.ClusterClient); ok {
+		return clusterClient.ForEachMaster(ctx, func(ctx context.Context, node *redis.Client) error {
+			return scanNodeKeys(ctx, node, pattern, fn)
+		})
+	}
+
+	return scanNodeKeys(ctx, client, pattern, fn)
+}
+
+func scanNodeKeys(ctx context.Context, client redis.Cmdable, pattern string, fn func(ctx context.Context, key string) error) error {
+	iter := client.Scan(ctx, 0, pattern, 1000).Iterator()
+	for iter.Next(ctx) {
+		if err := fn(ctx, iter.Val()); err != nil {
+			return err
+		}
+	}
+
+	return iter.Err()
+}
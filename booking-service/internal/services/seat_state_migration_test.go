@@ -0,0 +1,66 @@
+package services
+
+import "testing"
+
+func TestParseLegacySeatLockKey(t *testing.T) {
+	tests := []struct {
+		name         string
+		key          string
+		prefix       string
+		wantShowtime string
+		wantSeat     string
+		wantOk       bool
+	}{
+		{
+			name:         "plain seat lock",
+			key:          "seat_lock:st-1:seat-1",
+			prefix:       "seat_lock:",
+			wantShowtime: "st-1",
+			wantSeat:     "seat-1",
+			wantOk:       true,
+		},
+		{
+			name:         "hash tagged seat lock",
+			key:          "seat_lock:{st-1}:seat-1",
+			prefix:       "seat_lock:",
+			wantShowtime: "st-1",
+			wantSeat:     "seat-1",
+			wantOk:       true,
+		},
+		{
+			name:         "hash tagged concurrent lock",
+			key:          "seat:concurrent_lock:{st-1}:seat-1",
+			prefix:       "seat:concurrent_lock:",
+			wantShowtime: "st-1",
+			wantSeat:     "seat-1",
+			wantOk:       true,
+		},
+		{
+			name:   "missing seat",
+			key:    "seat_lock:st-1:",
+			prefix: "seat_lock:",
+		},
+		{
+			name:   "missing showtime",
+			key:    "seat_lock:seat-1",
+			prefix: "seat_lock:",
+		},
+		{
+			name:   "unbalanced hash tag",
+			key:    "seat_lock:{st-1:seat-1",
+			prefix: "seat_lock:",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			showtimeId, seatId, ok := parseLegacySeatLockKey(tt.key, tt.prefix)
+			if ok != tt.wantOk {
+				t.Fatalf("expected ok=%v, got %v", tt.wantOk, ok)
+			}
+			if showtimeId != tt.wantShowtime || seatId != tt.wantSeat {
+				t.Errorf("expected (%q, %q), got (%q, %q)", tt.wantShowtime, tt.wantSeat, showtimeId, seatId)
+			}
+		})
+	}
+}
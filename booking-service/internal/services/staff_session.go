@@ -0,0 +1,205 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"booking-service/internal/datastore"
+	"booking-service/internal/models"
+	"booking-service/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+var (
+	ErrInvalidStaffSession      = fmt.Errorf("cash amounts must not be negative")
+	ErrStaffSessionNotFound     = fmt.Errorf("staff session not found")
+	ErrStaffSessionAlreadyOpen  = fmt.Errorf("staff member already has an open session")
+	ErrStaffSessionRequired     = fmt.Errorf("an open staff session is required for box office bookings")
+	ErrStaffSessionClosed       = fmt.Errorf("staff session is already closed")
+	ErrStaffSessionAccessDenied = fmt.Errorf("staff session does not belong to user")
+)
+
+const paymentMethodCash = "CASH"
+
+func (s *BookingService) OpenStaffSession(ctx context.Context, staffId string, openingFloat float64, note string) (*models.StaffSession, error) {
+	if staffId == "" {
+		return nil, ErrInvalidBookingData
+	}
+	if openingFloat < 0 {
+		return nil, ErrInvalidStaffSession
+	}
+
+	session := &models.StaffSession{
+		Id:           uuid.New().String(),
+		StaffId:      staffId,
+		Status:       models.StaffSessionStatusOpen,
+		OpeningFloat: roundAmount(openingFloat),
+		Note:         strings.TrimSpace(note),
+		OpenedAt:     time.Now(),
+	}
+
+	if err := datastore.CreateStaffSession(ctx, s.db, session); err != nil {
+		if errors.Is(err, datastore.ErrStaffSessionAlreadyOpen) {
+			return nil, ErrStaffSessionAlreadyOpen
+		}
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (s *BookingService) GetCurrentStaffSession(ctx context.Context, staffId string) (*models.StaffSession, error) {
+	session, err := datastore.GetOpenStaffSessionByStaffId(ctx, s.db, staffId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrStaffSessionNotFound
+		}
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// requireOpenStaffSession returns the session a box-office sale by staffId is
+// recorded against.
+func (s *BookingService) requireOpenStaffSession(ctx context.Context, db bun.IDB, staffId string, forShare bool) (*models.StaffSession, error) {
+	var session *models.StaffSession
+	var err error
+	if forShare {
+		session, err = datastore.GetOpenStaffSessionByStaffIdForShare(ctx, db, staffId)
+	} else {
+		session, err = datastore.GetOpenStaffSessionByStaffId(ctx, db, staffId)
+	}
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrStaffSessionRequired
+		}
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (s *BookingService) GetStaffSessions(ctx context.Context, staffId, status string, page, size int) ([]*models.StaffSession, int, error) {
+	_, _, limit, offset := s.normalizePagination(page, size)
+	return datastore.GetStaffSessions(ctx, s.roDb, staffId, strings.ToUpper(status), limit, offset)
+}
+
+// CloseStaffSession counts out the drawer. Staff close their own session;
+// managers may close anyone's.
+func (s *BookingService) CloseStaffSession(ctx context.Context, sessionId, userId string, isManager bool, countedCash float64, note string) (*types.StaffSessionReport, error) {
+	if countedCash < 0 {
+		return nil, ErrInvalidStaffSession
+	}
+
+	var report *types.StaffSessionReport
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		session, err := datastore.GetStaffSessionByIdForUpdate(ctx, tx, sessionId)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrStaffSessionNotFound
+			}
+			return err
+		}
+
+		if !isManager && session.StaffId != userId {
+			return ErrStaffSessionAccessDenied
+		}
+
+		if session.Status != models.StaffSessionStatusOpen {
+			return ErrStaffSessionClosed
+		}
+
+		report, err = s.buildStaffSessionReport(ctx, tx, session)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		counted := roundAmount(countedCash)
+		variance := roundAmount(counted - report.ExpectedCash)
+
+		session.Status = models.StaffSessionStatusClosed
+		session.ExpectedCash = &report.ExpectedCash
+		session.CountedCash = &counted
+		session.Variance = &variance
+		session.ClosedBy = userId
+		session.ClosedAt = &now
+		if note = strings.TrimSpace(note); note != "" {
+			session.Note = note
+		}
+
+		report.CountedCash = &counted
+		report.Variance = &variance
+
+		return datastore.CloseStaffSession(ctx, tx, session)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+func (s *BookingService) GetStaffSessionReport(ctx context.Context, sessionId, userId string, isManager bool) (*types.StaffSessionReport, error) {
+	session, err := datastore.GetStaffSessionById(ctx, s.roDb, sessionId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrStaffSessionNotFound
+		}
+		return nil, err
+	}
+
+	if !isManager && session.StaffId != userId {
+		return nil, ErrStaffSessionAccessDenied
+	}
+
+	report, err := s.buildStaffSessionReport(ctx, s.roDb, session)
+	if err != nil {
+		return nil, err
+	}
+
+	// A closed session reports what was recorded at close time.
+	if session.Status == models.StaffSessionStatusClosed {
+		if session.ExpectedCash != nil {
+			report.ExpectedCash = *session.ExpectedCash
+		}
+		report.CountedCash = session.CountedCash
+		report.Variance = session.Variance
+	}
+
+	return report, nil
+}
+
+func (s *BookingService) buildStaffSessionReport(ctx context.Context, db bun.IDB, session *models.StaffSession) (*types.StaffSessionReport, error) {
+	bookings, err := datastore.GetStaffSessionBookingTotals(ctx, db, session.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	payments, err := datastore.GetStaffSessionPaymentTotals(ctx, db, session.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var cashTaken float64
+	for _, total := range payments {
+		if total.PaymentMethod == paymentMethodCash {
+			cashTaken += total.Amount
+		}
+	}
+
+	return &types.StaffSessionReport{
+		Session:        session,
+		Bookings:       bookings,
+		PaymentMethods: payments,
+		CashTaken:      roundAmount(cashTaken),
+		ExpectedCash:   roundAmount(session.OpeningFloat + cashTaken),
+	}, nil
+}
@@ -0,0 +1,255 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"booking-service/internal/datastore"
+	"booking-service/internal/models"
+	"booking-service/internal/pkg/tickettoken"
+	"booking-service/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+var ErrTicketAlreadyUsed = fmt.Errorf("ticket has already been used")
+
+const showtimeLayout = "2006-01-02 15:04:05"
+
+func (s *BookingService) signTicketTokens(tickets []*models.Ticket) error {
+	for _, ticket := range tickets {
+		token, err := s.ticketSigner.Sign(&tickettoken.Claims{
+			TicketId:   ticket.Id,
+			BookingId:  ticket.BookingId,
+			ShowtimeId: ticket.ShowtimeId,
+			SeatId:     ticket.SeatId,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to sign ticket token: %w", err)
+		}
+		ticket.Token = token
+	}
+
+	return nil
+}
+
+// ScanTicket checks a ticket in at the door and records the attempt in the
+// check-in log, whatever its outcome.
+func (s *BookingService) ScanTicket(ctx context.Context, token, roomId, staffId, deviceId string) (*types.TicketScanResult, error) {
+	result, err := s.scanTicket(ctx, token, roomId, staffId)
+	if err != nil {
+		return nil, err
+	}
+
+	s.recordCheckin(ctx, result.TicketId, result.ShowtimeId, staffId, deviceId, result.Code)
+
+	return result, nil
+}
+
+func (s *BookingService) scanTicket(ctx context.Context, token, roomId, staffId string) (*types.TicketScanResult, error) {
+	claims, err := s.ticketSigner.Verify(token)
+	if err != nil {
+		return scanRejected(types.TicketScanInvalidToken, "Ticket code is not valid"), nil
+	}
+
+	ticket, err := datastore.GetTicketById(ctx, s.db, claims.TicketId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return scanRejected(types.TicketScanTicketNotFound, "Ticket does not exist"), nil
+		}
+		return nil, err
+	}
+
+	if ticket.BookingId != claims.BookingId || ticket.ShowtimeId != claims.ShowtimeId || ticket.SeatId != claims.SeatId {
+		return scanRejected(types.TicketScanInvalidToken, "Ticket code does not match the ticket"), nil
+	}
+
+	showtime, err := s.movieClient.GetShowtime(ctx, ticket.ShowtimeId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get showtime: %w", err)
+	}
+
+	startTime, err := parseShowtimeStart(showtime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse showtime start: %w", err)
+	}
+
+	result := &types.TicketScanResult{
+		TicketId:      ticket.Id,
+		BookingId:     ticket.BookingId,
+		ShowtimeId:    ticket.ShowtimeId,
+		MovieTitle:    showtime.MovieTitle,
+		RoomNumber:    showtime.RoomNumber,
+		ShowtimeStart: &startTime,
+		UsedAt:        ticket.UsedAt,
+		UsedBy:        ticket.UsedBy,
+
+		TicketCategory:  ticket.TicketCategory,
+		RequiresIdCheck: ticket.RequiresIdCheck,
+		MinAge:          ticket.MinAge,
+		MaxAge:          ticket.MaxAge,
+	}
+	s.fillScanSeat(ctx, result, ticket.SeatId)
+
+	switch {
+	case ticket.Status == models.TicketStatusCancelled:
+		return rejectScan(result, types.TicketScanCancelled, "Ticket has been cancelled"), nil
+	case ticket.Status == models.TicketStatusUsed:
+		return rejectScan(result, types.TicketScanAlreadyUsed, "Ticket has already been used"), nil
+	case showtime.RoomId != roomId:
+		return rejectScan(result, types.TicketScanWrongRoom, fmt.Sprintf("Ticket is for room %s", showtime.RoomNumber)), nil
+	}
+
+	now := time.Now()
+	endTime := startTime.Add(time.Duration(showtime.DurationSeconds) * time.Second)
+	if code, message, ok := checkDoorWindow(now, startTime, endTime, s.doorOpenBefore); !ok {
+		return rejectScan(result, code, message), nil
+	}
+
+	marked, err := datastore.MarkTicketUsed(ctx, s.db, ticket.Id, staffId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !marked {
+		// Lost the race to another scan; report the admission that won.
+		current, err := datastore.GetTicketById(ctx, s.db, ticket.Id)
+		if err != nil {
+			return nil, err
+		}
+		result.UsedAt = current.UsedAt
+		result.UsedBy = current.UsedBy
+		if current.Status == models.TicketStatusCancelled {
+			return rejectScan(result, types.TicketScanCancelled, "Ticket has been cancelled"), nil
+		}
+		return rejectScan(result, types.TicketScanAlreadyUsed, "Ticket has already been used"), nil
+	}
+
+	result.Valid = true
+	result.Code = types.TicketScanAdmitted
+	result.Message = "Ticket admitted"
+	if ticket.RequiresIdCheck {
+		result.Message = fmt.Sprintf("Ticket admitted, check ID for %s ticket", ticket.TicketCategory)
+		if ages := ticketAgeRange(ticket.MinAge, ticket.MaxAge); ages != "" {
+			result.Message += " (" + ages + ")"
+		}
+	}
+	result.UsedAt = &now
+	result.UsedBy = staffId
+
+	return result, nil
+}
+
+func (s *BookingService) recordCheckin(ctx context.Context, ticketId, showtimeId, staffId, deviceId string, code types.TicketScanCode) {
+	checkin := &models.TicketCheckin{
+		Id:         uuid.New().String(),
+		TicketId:   ticketId,
+		ShowtimeId: showtimeId,
+		StaffId:    staffId,
+		DeviceId:   deviceId,
+		Result:     string(code),
+	}
+
+	if err := datastore.CreateTicketCheckin(ctx, s.db, checkin); err != nil {
+		logrus.WithError(err).WithField("ticket_id", ticketId).Error("Failed to record ticket checkin")
+	}
+}
+
+func (s *BookingService) GetTicketCheckins(ctx context.Context, ticketId string) ([]*models.TicketCheckin, error) {
+	return datastore.GetCheckinsByTicketId(ctx, s.roDb, ticketId)
+}
+
+func (s *BookingService) GetCheckinReport(ctx context.Context, showtimeId string) (*types.CheckinReport, error) {
+	if showtimeId == "" {
+		return nil, ErrInvalidBookingData
+	}
+
+	ticketCounts, err := datastore.GetShowtimeTicketCounts(ctx, s.roDb, showtimeId)
+	if err != nil {
+		return nil, err
+	}
+
+	resultCounts, err := datastore.CountCheckinsByResult(ctx, s.roDb, showtimeId)
+	if err != nil {
+		return nil, err
+	}
+
+	duplicates, err := datastore.GetCheckinsByShowtimeIdAndResult(ctx, s.roDb, showtimeId, string(types.TicketScanAlreadyUsed))
+	if err != nil {
+		return nil, err
+	}
+
+	attempts := make(map[string]int, len(resultCounts))
+	for _, count := range resultCounts {
+		attempts[count.Result] = count.Count
+	}
+
+	return &types.CheckinReport{
+		ShowtimeId:        showtimeId,
+		TicketsSold:       ticketCounts.Sold,
+		Admitted:          ticketCounts.Admitted,
+		NoShows:           ticketCounts.Sold - ticketCounts.Admitted,
+		DuplicateAttempts: attempts[string(types.TicketScanAlreadyUsed)],
+		AttemptsByResult:  attempts,
+		Duplicates:        duplicates,
+	}, nil
+}
+
+func (s *BookingService) fillScanSeat(ctx context.Context, result *types.TicketScanResult, seatId string) {
+	seats, err := s.movieClient.GetSeatDetails(ctx, []string{seatId})
+	if err != nil {
+		logrus.WithError(err).WithField("seat_id", seatId).Warn("Failed to get seat details for ticket scan")
+		return
+	}
+
+	for _, seat := range seats {
+		if seat.SeatId == seatId {
+			result.SeatRow = seat.SeatRow
+			result.SeatNumber = fmt.Sprintf("%d", seat.SeatNumber)
+			result.SeatType = seat.SeatType
+			return
+		}
+	}
+}
+
+// checkDoorWindow reports whether a ticket may be admitted at now: doors
+// open doorOpenBefore ahead of the showtime start and close when it ends.
+func checkDoorWindow(now, start, end time.Time, doorOpenBefore time.Duration) (types.TicketScanCode, string, bool) {
+	if now.Before(start.Add(-doorOpenBefore)) {
+		return types.TicketScanTooEarly, "Doors are not open for this showtime yet", false
+	}
+	if now.After(end) {
+		return types.TicketScanTooLate, "Showtime has already ended", false
+	}
+	return "", "", true
+}
+
+func scanRejected(code types.TicketScanCode, message string) *types.TicketScanResult {
+	return rejectScan(&types.TicketScanResult{}, code, message)
+}
+
+func rejectScan(result *types.TicketScanResult, code types.TicketScanCode, message string) *types.TicketScanResult {
+	result.Valid = false
+	result.Code = code
+	result.Message = message
+	return result
+}
+
+// ticketAgeRange describes the ages a ticket category is sold for, where 0
+// means no bound.
+func ticketAgeRange(minAge, maxAge int) string {
+	switch {
+	case minAge > 0 && maxAge > 0:
+		return fmt.Sprintf("ages %d-%d", minAge, maxAge)
+	case minAge > 0:
+		return fmt.Sprintf("ages %d and over", minAge)
+	case maxAge > 0:
+		return fmt.Sprintf("ages up to %d", maxAge)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,44 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"booking-service/internal/types"
+)
+
+func TestCheckDoorWindow(t *testing.T) {
+	start := time.Date(2026, 10, 16, 20, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Hour)
+	doorOpenBefore := 30 * time.Minute
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		wantCode types.TicketScanCode
+		wantOk   bool
+	}{
+		{name: "day before", now: start.Add(-24 * time.Hour), wantCode: types.TicketScanTooEarly},
+		{name: "just before doors open", now: start.Add(-doorOpenBefore - time.Second), wantCode: types.TicketScanTooEarly},
+		{name: "doors open", now: start.Add(-doorOpenBefore), wantOk: true},
+		{name: "showtime start", now: start, wantOk: true},
+		{name: "during showtime", now: start.Add(time.Hour), wantOk: true},
+		{name: "showtime end", now: end, wantOk: true},
+		{name: "after showtime end", now: end.Add(time.Second), wantCode: types.TicketScanTooLate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, message, ok := checkDoorWindow(tt.now, start, end, doorOpenBefore)
+			if ok != tt.wantOk {
+				t.Fatalf("Expected ok=%v, got %v (%s)", tt.wantOk, ok, message)
+			}
+			if code != tt.wantCode {
+				t.Errorf("Expected code %q, got %q", tt.wantCode, code)
+			}
+			if !ok && message == "" {
+				t.Error("Expected a message for a rejected scan")
+			}
+		})
+	}
+}
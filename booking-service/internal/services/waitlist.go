@@ -0,0 +1,377 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"booking-service/internal/datastore"
+	"booking-service/internal/models"
+	"booking-service/internal/types"
+	"booking-service/proto/pb"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/uptrace/bun"
+)
+
+var (
+	ErrWaitlistEntryNotFound  = fmt.Errorf("waitlist entry not found")
+	ErrWaitlistAccessDenied   = fmt.Errorf("waitlist entry does not belong to user")
+	ErrWaitlistClosed         = fmt.Errorf("showtime is no longer open for the waitlist")
+	ErrWaitlistAlreadyJoined  = fmt.Errorf("user is already on the waitlist for this showtime")
+	ErrSeatHoldNotExtendable  = fmt.Errorf("waitlist offer holds cannot be extended")
+	ErrInvalidWaitlistRequest = fmt.Errorf("seat count must be between 1 and %d", maxWaitlistSeats)
+)
+
+const (
+	maxWaitlistSeats     = 10
+	waitlistOfferBatch   = 50
+	defaultExpireOffers  = 100
+	defaultWaitlistCount = 1
+)
+
+func (s *BookingService) JoinWaitlist(ctx context.Context, userId, showtimeId string, seatCount int, seatType string) (*models.WaitlistEntry, error) {
+	if userId == "" || showtimeId == "" {
+		return nil, ErrInvalidBookingData
+	}
+
+	if seatCount == 0 {
+		seatCount = defaultWaitlistCount
+	}
+	if seatCount < 1 || seatCount > maxWaitlistSeats {
+		return nil, ErrInvalidWaitlistRequest
+	}
+
+	showtime, err := s.movieClient.GetShowtime(ctx, showtimeId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get showtime: %w", err)
+	}
+
+	start, err := parseShowtimeStart(showtime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse showtime start: %w", err)
+	}
+	if !time.Now().Before(start) {
+		return nil, ErrWaitlistClosed
+	}
+
+	entry := &models.WaitlistEntry{
+		Id:         uuid.New().String(),
+		UserId:     userId,
+		ShowtimeId: showtimeId,
+		SeatCount:  seatCount,
+		SeatType:   strings.TrimSpace(seatType),
+		Status:     models.WaitlistStatusWaiting,
+		CreatedAt:  time.Now(),
+	}
+
+	if err = datastore.CreateWaitlistEntry(ctx, s.db, entry); err != nil {
+		if errors.Is(err, datastore.ErrWaitlistEntryExists) {
+			return nil, ErrWaitlistAlreadyJoined
+		}
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// GetUserWaitlistEntries returns the user's active entries. Waiting entries
+// carry their 1-based position in the showtime's queue.
+func (s *BookingService) GetUserWaitlistEntries(ctx context.Context, userId string) ([]*types.WaitlistEntryWithPosition, error) {
+	entries, err := datastore.GetActiveWaitlistEntriesByUserId(ctx, s.roDb, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*types.WaitlistEntryWithPosition, 0, len(entries))
+	for _, entry := range entries {
+		item := &types.WaitlistEntryWithPosition{WaitlistEntry: entry}
+		if entry.Status == models.WaitlistStatusWaiting {
+			ahead, err := datastore.CountWaitlistEntriesAhead(ctx, s.roDb, entry)
+			if err != nil {
+				return nil, err
+			}
+			item.Position = ahead + 1
+		}
+		result = append(result, item)
+	}
+
+	return result, nil
+}
+
+// LeaveWaitlist cancels the user's entry. Declining a pending offer hands its
+// seats back to the queue through a SEAT_RELEASED event.
+func (s *BookingService) LeaveWaitlist(ctx context.Context, userId, entryId string) error {
+	if entryId == "" {
+		return ErrWaitlistEntryNotFound
+	}
+
+	var declined *models.WaitlistEntry
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		entry, err := datastore.GetWaitlistEntryByIdForUpdate(ctx, tx, entryId)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrWaitlistEntryNotFound
+			}
+			return err
+		}
+
+		if entry.UserId != userId {
+			return ErrWaitlistAccessDenied
+		}
+
+		if entry.Status != models.WaitlistStatusWaiting && entry.Status != models.WaitlistStatusOffered {
+			return ErrWaitlistEntryNotFound
+		}
+
+		if err = datastore.UpdateWaitlistEntryStatus(ctx, tx, entry.Id, models.WaitlistStatusCancelled); err != nil {
+			return err
+		}
+
+		if entry.Status != models.WaitlistStatusOffered {
+			return nil
+		}
+		declined = entry
+
+		eventData := &models.SeatReleasedEventData{
+			ShowtimeId: entry.ShowtimeId,
+			SeatIds:    entry.OfferedSeatIds,
+			Reason:     models.SeatReleaseReasonWaitlistOfferDeclined,
+		}
+
+		return datastore.CreateOutboxEvent(ctx, tx, models.EventTypeSeatReleased, eventData)
+	})
+	if err != nil {
+		return err
+	}
+
+	// The offer's seats are only let go once the entry is committed as
+	// cancelled, so a failed transaction leaves the offer intact.
+	if declined != nil {
+		s.releaseWaitlistOfferHold(ctx, declined)
+	}
+
+	return nil
+}
+
+// ProcessWaitlist offers released seats to the head of the showtime's queue.
+// Each offer is a seat hold owned by the waiting user, so the seats cannot be
+// taken by anyone else until the offer is claimed or expires.
+func (s *BookingService) ProcessWaitlist(ctx context.Context, showtimeId string, seatIds []string) ([]*models.WaitlistEntry, error) {
+	if showtimeId == "" || len(seatIds) == 0 {
+		return nil, nil
+	}
+
+	showtime, err := s.movieClient.GetShowtime(ctx, showtimeId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get showtime: %w", err)
+	}
+
+	start, err := parseShowtimeStart(showtime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse showtime start: %w", err)
+	}
+	if !time.Now().Before(start) {
+		return nil, nil
+	}
+
+	seats, err := s.movieClient.GetSeatsWithPrice(ctx, showtimeId, seatIds)
+	if err != nil {
+		return nil, err
+	}
+
+	bookedSeats, err := datastore.GetBookedSeatsForShowtime(ctx, s.roDb, showtimeId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check booked seats: %w", err)
+	}
+
+	pool := make([]*pb.SeatPriceData, 0, len(seats.Data))
+	for _, seat := range seats.Data {
+		if _, booked := bookedSeats[seat.SeatId]; !booked {
+			pool = append(pool, seat)
+		}
+	}
+	if len(pool) == 0 {
+		return nil, nil
+	}
+
+	var offers []*models.WaitlistEntry
+	err = s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		entries, err := datastore.GetWaitingEntriesForUpdate(ctx, tx, showtimeId, waitlistOfferBatch)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			if len(pool) == 0 {
+				break
+			}
+
+			hold, err := s.holdSeatsForWaitlistEntry(ctx, entry, &pool)
+			if err != nil {
+				return err
+			}
+			if hold == nil {
+				continue
+			}
+
+			expiresAt := hold.ExpiresAt
+			entry.Status = models.WaitlistStatusOffered
+			entry.HoldId = hold.Id
+			entry.OfferedSeatIds = hold.SeatIds
+			entry.OfferExpiresAt = &expiresAt
+			offers = append(offers, entry)
+
+			if err = datastore.UpdateWaitlistEntryOffer(ctx, tx, entry); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		for _, offer := range offers {
+			s.releaseWaitlistOfferHold(ctx, offer)
+		}
+		return nil, err
+	}
+
+	return offers, nil
+}
+
+// holdSeatsForWaitlistEntry picks the entry's seats from the pool and holds
+// them for the offer window. Seats that turn out to be taken are dropped from
+// the pool and the pick is retried; nil means the pool cannot serve the entry.
+func (s *BookingService) holdSeatsForWaitlistEntry(ctx context.Context, entry *models.WaitlistEntry, pool *[]*pb.SeatPriceData) (*models.SeatHold, error) {
+	for {
+		picked := pickWaitlistSeats(*pool, entry.SeatCount, entry.SeatType)
+		if picked == nil {
+			return nil, nil
+		}
+
+		holdId := uuid.New().String()
+		err := s.acquireDistributedSeatLocks(ctx, entry.ShowtimeId, picked, holdId, s.waitlistOfferTTL)
+		if err != nil {
+			var conflictErr *SeatConflictError
+			if !errors.As(err, &conflictErr) {
+				return nil, err
+			}
+			*pool = removePoolSeats(*pool, conflictErr.SeatIds)
+			continue
+		}
+
+		now := time.Now()
+		hold := &models.SeatHold{
+			Id:              holdId,
+			UserId:          entry.UserId,
+			ShowtimeId:      entry.ShowtimeId,
+			SeatIds:         picked,
+			WaitlistEntryId: entry.Id,
+			CreatedAt:       now,
+			ExpiresAt:       now.Add(s.waitlistOfferTTL),
+		}
+
+		if err = s.quoteSeatHold(ctx, hold, s.waitlistOfferTTL); err != nil {
+			s.releaseDistributedSeatLocks(ctx, entry.ShowtimeId, holdId, picked)
+			return nil, err
+		}
+
+		if err = s.saveSeatHold(ctx, hold); err != nil {
+			s.releaseDistributedSeatLocks(ctx, entry.ShowtimeId, holdId, picked)
+			return nil, err
+		}
+
+		*pool = removePoolSeats(*pool, picked)
+		return hold, nil
+	}
+}
+
+func pickWaitlistSeats(pool []*pb.SeatPriceData, count int, seatType string) []string {
+	picked := make([]string, 0, count)
+	for _, seat := range pool {
+		if seatType != "" && !strings.EqualFold(seat.SeatType, seatType) {
+			continue
+		}
+		picked = append(picked, seat.SeatId)
+		if len(picked) == count {
+			return picked
+		}
+	}
+	return nil
+}
+
+func removePoolSeats(pool []*pb.SeatPriceData, seatIds []string) []*pb.SeatPriceData {
+	remove := make(map[string]struct{}, len(seatIds))
+	for _, seatId := range seatIds {
+		remove[seatId] = struct{}{}
+	}
+
+	result := pool[:0]
+	for _, seat := range pool {
+		if _, ok := remove[seat.SeatId]; !ok {
+			result = append(result, seat)
+		}
+	}
+	return result
+}
+
+// ExpireWaitlistOffers closes offers that ran out and releases their seats,
+// which are offered to the next in line through the SEAT_RELEASED event.
+// Offers that are claimed, or locked by a booking claiming them, are skipped.
+func (s *BookingService) ExpireWaitlistOffers(ctx context.Context, limit int) (int, error) {
+	if limit <= 0 {
+		limit = defaultExpireOffers
+	}
+
+	var expired []*models.WaitlistEntry
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		entries, err := datastore.GetExpiredWaitlistOffersForUpdate(ctx, tx, time.Now(), limit)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			if err = datastore.UpdateWaitlistEntryStatus(ctx, tx, entry.Id, models.WaitlistStatusExpired); err != nil {
+				return err
+			}
+
+			eventData := &models.SeatReleasedEventData{
+				ShowtimeId: entry.ShowtimeId,
+				SeatIds:    entry.OfferedSeatIds,
+				Reason:     models.SeatReleaseReasonWaitlistOfferExpired,
+			}
+			if err = datastore.CreateOutboxEvent(ctx, tx, models.EventTypeSeatReleased, eventData); err != nil {
+				return err
+			}
+		}
+
+		expired = entries
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, entry := range expired {
+		s.releaseWaitlistOfferHold(ctx, entry)
+	}
+
+	return len(expired), nil
+}
+
+func (s *BookingService) releaseWaitlistOfferHold(ctx context.Context, entry *models.WaitlistEntry) {
+	if entry.HoldId == "" {
+		return
+	}
+
+	s.releaseDistributedSeatLocks(ctx, entry.ShowtimeId, entry.HoldId, entry.OfferedSeatIds)
+
+	hold := &models.SeatHold{Id: entry.HoldId, UserId: entry.UserId}
+	if err := s.deleteSeatHold(ctx, hold); err != nil {
+		logrus.WithError(err).WithField("hold_id", entry.HoldId).Error("Failed to delete waitlist offer hold")
+	}
+}
@@ -1,6 +1,11 @@
 package types
 
-import "time"
+import (
+	"time"
+
+	"booking-service/internal/datastore"
+	"booking-service/internal/models"
+)
 
 type BookingHistory struct {
 	Id          string     `json:"id"`
@@ -28,6 +33,11 @@ type TicketWithBookingInfo struct {
 	CreatedAt  time.Time  `json:"created_at"`
 	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
 
+	TicketCategory  string `json:"ticket_category,omitempty"`
+	RequiresIdCheck bool   `json:"requires_id_check"`
+	MinAge          int    `json:"min_age,omitempty"`
+	MaxAge          int    `json:"max_age,omitempty"`
+
 	BookingType  string  `json:"booking_type"`
 	TotalAmount  float64 `json:"total_amount"`
 	MovieTitle   string  `json:"movie_title,omitempty"`
@@ -38,3 +48,125 @@ type TicketWithBookingInfo struct {
 	SeatNumber   string  `json:"seat_number,omitempty"`
 	SeatType     string  `json:"seat_type,omitempty"`
 }
+
+type TicketScanCode string
+
+const (
+	TicketScanAdmitted       TicketScanCode = "ADMITTED"
+	TicketScanAlreadyUsed    TicketScanCode = "ALREADY_USED"
+	TicketScanInvalidToken   TicketScanCode = "INVALID_TOKEN"
+	TicketScanTicketNotFound TicketScanCode = "TICKET_NOT_FOUND"
+	TicketScanCancelled      TicketScanCode = "CANCELLED"
+	TicketScanWrongRoom      TicketScanCode = "WRONG_ROOM"
+	TicketScanTooEarly       TicketScanCode = "TOO_EARLY"
+	TicketScanTooLate        TicketScanCode = "TOO_LATE"
+)
+
+type TicketScanResult struct {
+	Valid   bool           `json:"valid"`
+	Code    TicketScanCode `json:"code"`
+	Message string         `json:"message"`
+
+	TicketId        string     `json:"ticket_id,omitempty"`
+	BookingId       string     `json:"booking_id,omitempty"`
+	ShowtimeId      string     `json:"showtime_id,omitempty"`
+	MovieTitle      string     `json:"movie_title,omitempty"`
+	RoomNumber      string     `json:"room_number,omitempty"`
+	SeatRow         string     `json:"seat_row,omitempty"`
+	SeatNumber      string     `json:"seat_number,omitempty"`
+	SeatType        string     `json:"seat_type,omitempty"`
+	TicketCategory  string     `json:"ticket_category,omitempty"`
+	RequiresIdCheck bool       `json:"requires_id_check"`
+	MinAge          int        `json:"min_age,omitempty"`
+	MaxAge          int        `json:"max_age,omitempty"`
+	ShowtimeStart   *time.Time `json:"showtime_start,omitempty"`
+	UsedAt          *time.Time `json:"used_at,omitempty"`
+	UsedBy          string     `json:"used_by,omitempty"`
+}
+
+type CheckinReport struct {
+	ShowtimeId        string                  `json:"showtime_id"`
+	TicketsSold       int                     `json:"tickets_sold"`
+	Admitted          int                     `json:"admitted"`
+	NoShows           int                     `json:"no_shows"`
+	DuplicateAttempts int                     `json:"duplicate_attempts"`
+	AttemptsByResult  map[string]int          `json:"attempts_by_result"`
+	Duplicates        []*models.TicketCheckin `json:"duplicates"`
+}
+
+type BookingExchangeResult struct {
+	ExchangeId      string          `json:"exchange_id"`
+	Booking         *models.Booking `json:"booking"`
+	OldShowtimeId   string          `json:"old_showtime_id"`
+	OldSeatIds      []string        `json:"old_seat_ids"`
+	OldAmount       float64         `json:"old_amount"`
+	NewAmount       float64         `json:"new_amount"`
+	PriceDifference float64         `json:"price_difference"`
+}
+
+type PromotionQuote struct {
+	PromoCode      string  `json:"promo_code"`
+	Description    string  `json:"description,omitempty"`
+	Subtotal       float64 `json:"subtotal"`
+	DiscountAmount float64 `json:"discount_amount"`
+	TotalAmount    float64 `json:"total_amount"`
+}
+
+type LoyaltySummary struct {
+	UserId           string  `json:"user_id"`
+	Balance          int     `json:"balance"`
+	LifetimePoints   int     `json:"lifetime_points"`
+	Tier             string  `json:"tier"`
+	EarnRate         float64 `json:"earn_rate"`
+	NextTier         string  `json:"next_tier,omitempty"`
+	PointsToNextTier int     `json:"points_to_next_tier,omitempty"`
+	PointValue       float64 `json:"point_value"`
+}
+
+type WaitlistEntryWithPosition struct {
+	*models.WaitlistEntry
+	Position int `json:"position,omitempty"`
+}
+
+// StaffSessionReport reconciles a drawer session. For an open session the
+// cash figures are running totals and there is no variance yet.
+type StaffSessionReport struct {
+	Session        *models.StaffSession                  `json:"session"`
+	Bookings       []*datastore.StaffSessionBookingTotal `json:"bookings"`
+	PaymentMethods []*datastore.StaffSessionPaymentTotal `json:"payment_methods"`
+	CashTaken      float64                               `json:"cash_taken"`
+	ExpectedCash   float64                               `json:"expected_cash"`
+	CountedCash    *float64                              `json:"counted_cash,omitempty"`
+	Variance       *float64                              `json:"variance,omitempty"`
+}
+
+type BookingSearchQuery struct {
+	Email       string
+	UserId      string
+	MovieId     string
+	ShowtimeId  string
+	Status      string
+	BookingType string
+	From        *time.Time
+	To          *time.Time
+	MinAmount   *float64
+	MaxAmount   *float64
+	SortBy      string
+	SortOrder   string
+	Cursor      string
+	Size        int
+}
+
+type BookingSearchResult struct {
+	Bookings   []*BookingHistory `json:"bookings"`
+	NextCursor string            `json:"next_cursor,omitempty"`
+}
+
+type OccupancyStat struct {
+	Key            string  `json:"key"`
+	Label          string  `json:"label"`
+	TotalShowtimes int     `json:"total_showtimes"`
+	TicketsSold    int     `json:"tickets_sold"`
+	SeatCapacity   int     `json:"seat_capacity"`
+	OccupancyRate  float64 `json:"occupancy_rate"`
+}
@@ -0,0 +1,41 @@
+package types
+
+import "booking-service/internal/models"
+
+// ConcessionOrder is a concession item and how many of it to add to a
+// booking.
+type ConcessionOrder struct {
+	ItemId   string `json:"item_id"`
+	Quantity int    `json:"quantity"`
+}
+
+// ConcessionItemUpdate holds the fields to change on a concession item. Nil
+// fields are left as they are; Components replaces a combo's components.
+type ConcessionItemUpdate struct {
+	Name        *string
+	Description *string
+	Price       *float64
+	IsActive    *bool
+	Components  []*models.ConcessionComboItem
+}
+
+type ConcessionPickupCode string
+
+const (
+	ConcessionPickupCollected        ConcessionPickupCode = "COLLECTED"
+	ConcessionPickupAlreadyCollected ConcessionPickupCode = "ALREADY_COLLECTED"
+	ConcessionPickupNothingOrdered   ConcessionPickupCode = "NOTHING_ORDERED"
+	ConcessionPickupNotPaid          ConcessionPickupCode = "NOT_PAID"
+	ConcessionPickupCancelled        ConcessionPickupCode = "CANCELLED"
+	ConcessionPickupInvalidToken     ConcessionPickupCode = "INVALID_TOKEN"
+	ConcessionPickupBookingNotFound  ConcessionPickupCode = "BOOKING_NOT_FOUND"
+)
+
+type ConcessionPickupResult struct {
+	Valid   bool                 `json:"valid"`
+	Code    ConcessionPickupCode `json:"code"`
+	Message string               `json:"message"`
+
+	BookingId string                      `json:"booking_id,omitempty"`
+	Items     []*models.BookingConcession `json:"items,omitempty"`
+}
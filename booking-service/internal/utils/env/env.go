@@ -3,6 +3,8 @@ package env
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"time"
 )
 
 func EnvsRequired(envs ...string) (map[string]string, error) {
@@ -18,3 +20,19 @@ func EnvsRequired(envs ...string) (map[string]string, error) {
 
 	return m, nil
 }
+
+func GetDuration(env string, fallback time.Duration) time.Duration {
+	v, err := time.ParseDuration(os.Getenv(env))
+	if err != nil || v <= 0 {
+		return fallback
+	}
+	return v
+}
+
+func GetInt(env string, fallback int) int {
+	v, err := strconv.Atoi(os.Getenv(env))
+	if err != nil || v <= 0 {
+		return fallback
+	}
+	return v
+}
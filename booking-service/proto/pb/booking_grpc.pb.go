@@ -21,10 +21,20 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	BookingService_UpdateBookingStatus_FullMethodName     = "/pb.BookingService/UpdateBookingStatus"
 	BookingService_CreateTickets_FullMethodName           = "/pb.BookingService/CreateTickets"
+	BookingService_CancelBooking_FullMethodName           = "/pb.BookingService/CancelBooking"
 	BookingService_GetRevenueByTime_FullMethodName        = "/pb.BookingService/GetRevenueByTime"
 	BookingService_GetRevenueByShowtime_FullMethodName    = "/pb.BookingService/GetRevenueByShowtime"
 	BookingService_GetRevenueByBookingType_FullMethodName = "/pb.BookingService/GetRevenueByBookingType"
 	BookingService_GetTotalRevenue_FullMethodName         = "/pb.BookingService/GetTotalRevenue"
+	BookingService_GetOccupancy_FullMethodName            = "/pb.BookingService/GetOccupancy"
+	BookingService_GetRevenueByMovie_FullMethodName       = "/pb.BookingService/GetRevenueByMovie"
+	BookingService_GetSalesHeatmap_FullMethodName         = "/pb.BookingService/GetSalesHeatmap"
+	BookingService_GetBookingLeadTime_FullMethodName      = "/pb.BookingService/GetBookingLeadTime"
+	BookingService_ProcessWaitlist_FullMethodName         = "/pb.BookingService/ProcessWaitlist"
+	BookingService_ExpireWaitlistOffers_FullMethodName    = "/pb.BookingService/ExpireWaitlistOffers"
+	BookingService_ExpireSeatHolds_FullMethodName         = "/pb.BookingService/ExpireSeatHolds"
+	BookingService_ClaimBookingSeats_FullMethodName       = "/pb.BookingService/ClaimBookingSeats"
+	BookingService_ReleaseBookingSeats_FullMethodName     = "/pb.BookingService/ReleaseBookingSeats"
 )
 
 // BookingServiceClient is the client API for BookingService service.
@@ -33,10 +43,20 @@ const (
 type BookingServiceClient interface {
 	UpdateBookingStatus(ctx context.Context, in *UpdateBookingStatusRequest, opts ...grpc.CallOption) (*UpdateBookingStatusResponse, error)
 	CreateTickets(ctx context.Context, in *CreateTicketsRequest, opts ...grpc.CallOption) (*CreateTicketsResponse, error)
+	CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error)
 	GetRevenueByTime(ctx context.Context, in *GetRevenueByTimeRequest, opts ...grpc.CallOption) (*GetRevenueByTimeResponse, error)
 	GetRevenueByShowtime(ctx context.Context, in *GetRevenueByShowtimeRequest, opts ...grpc.CallOption) (*GetRevenueByShowtimeResponse, error)
 	GetRevenueByBookingType(ctx context.Context, in *GetRevenueByBookingTypeRequest, opts ...grpc.CallOption) (*GetRevenueByBookingTypeResponse, error)
 	GetTotalRevenue(ctx context.Context, in *GetTotalRevenueRequest, opts ...grpc.CallOption) (*GetTotalRevenueResponse, error)
+	GetOccupancy(ctx context.Context, in *GetOccupancyRequest, opts ...grpc.CallOption) (*GetOccupancyResponse, error)
+	GetRevenueByMovie(ctx context.Context, in *GetRevenueByMovieRequest, opts ...grpc.CallOption) (*GetRevenueByMovieResponse, error)
+	GetSalesHeatmap(ctx context.Context, in *GetSalesHeatmapRequest, opts ...grpc.CallOption) (*GetSalesHeatmapResponse, error)
+	GetBookingLeadTime(ctx context.Context, in *GetBookingLeadTimeRequest, opts ...grpc.CallOption) (*GetBookingLeadTimeResponse, error)
+	ProcessWaitlist(ctx context.Context, in *ProcessWaitlistRequest, opts ...grpc.CallOption) (*ProcessWaitlistResponse, error)
+	ExpireWaitlistOffers(ctx context.Context, in *ExpireWaitlistOffersRequest, opts ...grpc.CallOption) (*ExpireWaitlistOffersResponse, error)
+	ExpireSeatHolds(ctx context.Context, in *ExpireSeatHoldsRequest, opts ...grpc.CallOption) (*ExpireSeatHoldsResponse, error)
+	ClaimBookingSeats(ctx context.Context, in *ClaimBookingSeatsRequest, opts ...grpc.CallOption) (*ClaimBookingSeatsResponse, error)
+	ReleaseBookingSeats(ctx context.Context, in *ReleaseBookingSeatsRequest, opts ...grpc.CallOption) (*ReleaseBookingSeatsResponse, error)
 }
 
 type bookingServiceClient struct {
@@ -67,6 +87,16 @@ func (c *bookingServiceClient) CreateTickets(ctx context.Context, in *CreateTick
 	return out, nil
 }
 
+func (c *bookingServiceClient) CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelBookingResponse)
+	err := c.cc.Invoke(ctx, BookingService_CancelBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bookingServiceClient) GetRevenueByTime(ctx context.Context, in *GetRevenueByTimeRequest, opts ...grpc.CallOption) (*GetRevenueByTimeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetRevenueByTimeResponse)
@@ -107,16 +137,116 @@ func (c *bookingServiceClient) GetTotalRevenue(ctx context.Context, in *GetTotal
 	return out, nil
 }
 
+func (c *bookingServiceClient) GetOccupancy(ctx context.Context, in *GetOccupancyRequest, opts ...grpc.CallOption) (*GetOccupancyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOccupancyResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetOccupancy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetRevenueByMovie(ctx context.Context, in *GetRevenueByMovieRequest, opts ...grpc.CallOption) (*GetRevenueByMovieResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRevenueByMovieResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetRevenueByMovie_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetSalesHeatmap(ctx context.Context, in *GetSalesHeatmapRequest, opts ...grpc.CallOption) (*GetSalesHeatmapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSalesHeatmapResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetSalesHeatmap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetBookingLeadTime(ctx context.Context, in *GetBookingLeadTimeRequest, opts ...grpc.CallOption) (*GetBookingLeadTimeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBookingLeadTimeResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetBookingLeadTime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ProcessWaitlist(ctx context.Context, in *ProcessWaitlistRequest, opts ...grpc.CallOption) (*ProcessWaitlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProcessWaitlistResponse)
+	err := c.cc.Invoke(ctx, BookingService_ProcessWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ExpireWaitlistOffers(ctx context.Context, in *ExpireWaitlistOffersRequest, opts ...grpc.CallOption) (*ExpireWaitlistOffersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpireWaitlistOffersResponse)
+	err := c.cc.Invoke(ctx, BookingService_ExpireWaitlistOffers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ExpireSeatHolds(ctx context.Context, in *ExpireSeatHoldsRequest, opts ...grpc.CallOption) (*ExpireSeatHoldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpireSeatHoldsResponse)
+	err := c.cc.Invoke(ctx, BookingService_ExpireSeatHolds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ClaimBookingSeats(ctx context.Context, in *ClaimBookingSeatsRequest, opts ...grpc.CallOption) (*ClaimBookingSeatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimBookingSeatsResponse)
+	err := c.cc.Invoke(ctx, BookingService_ClaimBookingSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ReleaseBookingSeats(ctx context.Context, in *ReleaseBookingSeatsRequest, opts ...grpc.CallOption) (*ReleaseBookingSeatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseBookingSeatsResponse)
+	err := c.cc.Invoke(ctx, BookingService_ReleaseBookingSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BookingServiceServer is the server API for BookingService service.
 // All implementations must embed UnimplementedBookingServiceServer
 // for forward compatibility.
 type BookingServiceServer interface {
 	UpdateBookingStatus(context.Context, *UpdateBookingStatusRequest) (*UpdateBookingStatusResponse, error)
 	CreateTickets(context.Context, *CreateTicketsRequest) (*CreateTicketsResponse, error)
+	CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error)
 	GetRevenueByTime(context.Context, *GetRevenueByTimeRequest) (*GetRevenueByTimeResponse, error)
 	GetRevenueByShowtime(context.Context, *GetRevenueByShowtimeRequest) (*GetRevenueByShowtimeResponse, error)
 	GetRevenueByBookingType(context.Context, *GetRevenueByBookingTypeRequest) (*GetRevenueByBookingTypeResponse, error)
 	GetTotalRevenue(context.Context, *GetTotalRevenueRequest) (*GetTotalRevenueResponse, error)
+	GetOccupancy(context.Context, *GetOccupancyRequest) (*GetOccupancyResponse, error)
+	GetRevenueByMovie(context.Context, *GetRevenueByMovieRequest) (*GetRevenueByMovieResponse, error)
+	GetSalesHeatmap(context.Context, *GetSalesHeatmapRequest) (*GetSalesHeatmapResponse, error)
+	GetBookingLeadTime(context.Context, *GetBookingLeadTimeRequest) (*GetBookingLeadTimeResponse, error)
+	ProcessWaitlist(context.Context, *ProcessWaitlistRequest) (*ProcessWaitlistResponse, error)
+	ExpireWaitlistOffers(context.Context, *ExpireWaitlistOffersRequest) (*ExpireWaitlistOffersResponse, error)
+	ExpireSeatHolds(context.Context, *ExpireSeatHoldsRequest) (*ExpireSeatHoldsResponse, error)
+	ClaimBookingSeats(context.Context, *ClaimBookingSeatsRequest) (*ClaimBookingSeatsResponse, error)
+	ReleaseBookingSeats(context.Context, *ReleaseBookingSeatsRequest) (*ReleaseBookingSeatsResponse, error)
 	mustEmbedUnimplementedBookingServiceServer()
 }
 
@@ -133,6 +263,9 @@ func (UnimplementedBookingServiceServer) UpdateBookingStatus(context.Context, *U
 func (UnimplementedBookingServiceServer) CreateTickets(context.Context, *CreateTicketsRequest) (*CreateTicketsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTickets not implemented")
 }
+func (UnimplementedBookingServiceServer) CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBooking not implemented")
+}
 func (UnimplementedBookingServiceServer) GetRevenueByTime(context.Context, *GetRevenueByTimeRequest) (*GetRevenueByTimeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRevenueByTime not implemented")
 }
@@ -145,6 +278,33 @@ func (UnimplementedBookingServiceServer) GetRevenueByBookingType(context.Context
 func (UnimplementedBookingServiceServer) GetTotalRevenue(context.Context, *GetTotalRevenueRequest) (*GetTotalRevenueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTotalRevenue not implemented")
 }
+func (UnimplementedBookingServiceServer) GetOccupancy(context.Context, *GetOccupancyRequest) (*GetOccupancyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOccupancy not implemented")
+}
+func (UnimplementedBookingServiceServer) GetRevenueByMovie(context.Context, *GetRevenueByMovieRequest) (*GetRevenueByMovieResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevenueByMovie not implemented")
+}
+func (UnimplementedBookingServiceServer) GetSalesHeatmap(context.Context, *GetSalesHeatmapRequest) (*GetSalesHeatmapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSalesHeatmap not implemented")
+}
+func (UnimplementedBookingServiceServer) GetBookingLeadTime(context.Context, *GetBookingLeadTimeRequest) (*GetBookingLeadTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookingLeadTime not implemented")
+}
+func (UnimplementedBookingServiceServer) ProcessWaitlist(context.Context, *ProcessWaitlistRequest) (*ProcessWaitlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessWaitlist not implemented")
+}
+func (UnimplementedBookingServiceServer) ExpireWaitlistOffers(context.Context, *ExpireWaitlistOffersRequest) (*ExpireWaitlistOffersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpireWaitlistOffers not implemented")
+}
+func (UnimplementedBookingServiceServer) ExpireSeatHolds(context.Context, *ExpireSeatHoldsRequest) (*ExpireSeatHoldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpireSeatHolds not implemented")
+}
+func (UnimplementedBookingServiceServer) ClaimBookingSeats(context.Context, *ClaimBookingSeatsRequest) (*ClaimBookingSeatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimBookingSeats not implemented")
+}
+func (UnimplementedBookingServiceServer) ReleaseBookingSeats(context.Context, *ReleaseBookingSeatsRequest) (*ReleaseBookingSeatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseBookingSeats not implemented")
+}
 func (UnimplementedBookingServiceServer) mustEmbedUnimplementedBookingServiceServer() {}
 func (UnimplementedBookingServiceServer) testEmbeddedByValue()                        {}
 
@@ -202,6 +362,24 @@ func _BookingService_CreateTickets_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookingService_CancelBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CancelBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CancelBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CancelBooking(ctx, req.(*CancelBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BookingService_GetRevenueByTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRevenueByTimeRequest)
 	if err := dec(in); err != nil {
@@ -274,6 +452,168 @@ func _BookingService_GetTotalRevenue_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookingService_GetOccupancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOccupancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetOccupancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetOccupancy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetOccupancy(ctx, req.(*GetOccupancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetRevenueByMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevenueByMovieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetRevenueByMovie(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetRevenueByMovie_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetRevenueByMovie(ctx, req.(*GetRevenueByMovieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetSalesHeatmap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSalesHeatmapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetSalesHeatmap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetSalesHeatmap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetSalesHeatmap(ctx, req.(*GetSalesHeatmapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetBookingLeadTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingLeadTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetBookingLeadTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetBookingLeadTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetBookingLeadTime(ctx, req.(*GetBookingLeadTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ProcessWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ProcessWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ProcessWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ProcessWaitlist(ctx, req.(*ProcessWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ExpireWaitlistOffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpireWaitlistOffersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ExpireWaitlistOffers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ExpireWaitlistOffers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ExpireWaitlistOffers(ctx, req.(*ExpireWaitlistOffersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ExpireSeatHolds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpireSeatHoldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ExpireSeatHolds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ExpireSeatHolds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ExpireSeatHolds(ctx, req.(*ExpireSeatHoldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ClaimBookingSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimBookingSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ClaimBookingSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ClaimBookingSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ClaimBookingSeats(ctx, req.(*ClaimBookingSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ReleaseBookingSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseBookingSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ReleaseBookingSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ReleaseBookingSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ReleaseBookingSeats(ctx, req.(*ReleaseBookingSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BookingService_ServiceDesc is the grpc.ServiceDesc for BookingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -289,6 +629,10 @@ var BookingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateTickets",
 			Handler:    _BookingService_CreateTickets_Handler,
 		},
+		{
+			MethodName: "CancelBooking",
+			Handler:    _BookingService_CancelBooking_Handler,
+		},
 		{
 			MethodName: "GetRevenueByTime",
 			Handler:    _BookingService_GetRevenueByTime_Handler,
@@ -305,6 +649,42 @@ var BookingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTotalRevenue",
 			Handler:    _BookingService_GetTotalRevenue_Handler,
 		},
+		{
+			MethodName: "GetOccupancy",
+			Handler:    _BookingService_GetOccupancy_Handler,
+		},
+		{
+			MethodName: "GetRevenueByMovie",
+			Handler:    _BookingService_GetRevenueByMovie_Handler,
+		},
+		{
+			MethodName: "GetSalesHeatmap",
+			Handler:    _BookingService_GetSalesHeatmap_Handler,
+		},
+		{
+			MethodName: "GetBookingLeadTime",
+			Handler:    _BookingService_GetBookingLeadTime_Handler,
+		},
+		{
+			MethodName: "ProcessWaitlist",
+			Handler:    _BookingService_ProcessWaitlist_Handler,
+		},
+		{
+			MethodName: "ExpireWaitlistOffers",
+			Handler:    _BookingService_ExpireWaitlistOffers_Handler,
+		},
+		{
+			MethodName: "ExpireSeatHolds",
+			Handler:    _BookingService_ExpireSeatHolds_Handler,
+		},
+		{
+			MethodName: "ClaimBookingSeats",
+			Handler:    _BookingService_ClaimBookingSeats_Handler,
+		},
+		{
+			MethodName: "ReleaseBookingSeats",
+			Handler:    _BookingService_ReleaseBookingSeats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "booking.proto",
@@ -23,6 +23,7 @@ const (
 	MovieService_GetShowtimes_FullMethodName      = "/pb.MovieService/GetShowtimes"
 	MovieService_GetSeatsWithPrice_FullMethodName = "/pb.MovieService/GetSeatsWithPrice"
 	MovieService_GetSeatDetails_FullMethodName    = "/pb.MovieService/GetSeatDetails"
+	MovieService_GetShowtimeSeats_FullMethodName  = "/pb.MovieService/GetShowtimeSeats"
 )
 
 // MovieServiceClient is the client API for MovieService service.
@@ -33,6 +34,7 @@ type MovieServiceClient interface {
 	GetShowtimes(ctx context.Context, in *GetShowtimesRequest, opts ...grpc.CallOption) (*GetShowtimesResponse, error)
 	GetSeatsWithPrice(ctx context.Context, in *GetSeatsWithPriceRequest, opts ...grpc.CallOption) (*GetSeatsWithPriceResponse, error)
 	GetSeatDetails(ctx context.Context, in *GetSeatDetailsRequest, opts ...grpc.CallOption) (*GetSeatDetailsResponse, error)
+	GetShowtimeSeats(ctx context.Context, in *GetShowtimeSeatsRequest, opts ...grpc.CallOption) (*GetSeatDetailsResponse, error)
 }
 
 type movieServiceClient struct {
@@ -83,6 +85,16 @@ func (c *movieServiceClient) GetSeatDetails(ctx context.Context, in *GetSeatDeta
 	return out, nil
 }
 
+func (c *movieServiceClient) GetShowtimeSeats(ctx context.Context, in *GetShowtimeSeatsRequest, opts ...grpc.CallOption) (*GetSeatDetailsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSeatDetailsResponse)
+	err := c.cc.Invoke(ctx, MovieService_GetShowtimeSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MovieServiceServer is the server API for MovieService service.
 // All implementations must embed UnimplementedMovieServiceServer
 // for forward compatibility.
@@ -91,6 +103,7 @@ type MovieServiceServer interface {
 	GetShowtimes(context.Context, *GetShowtimesRequest) (*GetShowtimesResponse, error)
 	GetSeatsWithPrice(context.Context, *GetSeatsWithPriceRequest) (*GetSeatsWithPriceResponse, error)
 	GetSeatDetails(context.Context, *GetSeatDetailsRequest) (*GetSeatDetailsResponse, error)
+	GetShowtimeSeats(context.Context, *GetShowtimeSeatsRequest) (*GetSeatDetailsResponse, error)
 	mustEmbedUnimplementedMovieServiceServer()
 }
 
@@ -113,6 +126,9 @@ func (UnimplementedMovieServiceServer) GetSeatsWithPrice(context.Context, *GetSe
 func (UnimplementedMovieServiceServer) GetSeatDetails(context.Context, *GetSeatDetailsRequest) (*GetSeatDetailsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSeatDetails not implemented")
 }
+func (UnimplementedMovieServiceServer) GetShowtimeSeats(context.Context, *GetShowtimeSeatsRequest) (*GetSeatDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShowtimeSeats not implemented")
+}
 func (UnimplementedMovieServiceServer) mustEmbedUnimplementedMovieServiceServer() {}
 func (UnimplementedMovieServiceServer) testEmbeddedByValue()                      {}
 
@@ -206,6 +222,24 @@ func _MovieService_GetSeatDetails_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MovieService_GetShowtimeSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShowtimeSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).GetShowtimeSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_GetShowtimeSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).GetShowtimeSeats(ctx, req.(*GetShowtimeSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MovieService_ServiceDesc is the grpc.ServiceDesc for MovieService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +263,10 @@ var MovieService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSeatDetails",
 			Handler:    _MovieService_GetSeatDetails_Handler,
 		},
+		{
+			MethodName: "GetShowtimeSeats",
+			Handler:    _MovieService_GetShowtimeSeats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "movie.proto",
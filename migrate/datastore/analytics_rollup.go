@@ -0,0 +1,78 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+
+	"migrate-cmd/models"
+
+	"github.com/uptrace/bun"
+)
+
+func CreateAnalyticsRollupTables(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewCreateTable().
+		Model((*models.AnalyticsHourlyRollup)(nil)).
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create analytics hourly rollups table: %w", err)
+	}
+
+	_, err = db.NewCreateTable().
+		Model((*models.AnalyticsDailyRollup)(nil)).
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create analytics daily rollups table: %w", err)
+	}
+
+	_, err = db.NewCreateTable().
+		Model((*models.AnalyticsRollupState)(nil)).
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create analytics rollup states table: %w", err)
+	}
+
+	// The refresh job looks up bookings and tickets changed since its last run.
+	_, err = db.ExecContext(ctx, `
+		CREATE INDEX IF NOT EXISTS idx_booking_updated_at ON bookings (updated_at)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create updated_at index bookings table: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		CREATE INDEX IF NOT EXISTS idx_ticket_updated_at ON tickets (updated_at)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create updated_at index tickets table: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		CREATE INDEX IF NOT EXISTS idx_ticket_created_at ON tickets (created_at)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create created_at index tickets table: %w", err)
+	}
+
+	return nil
+}
+
+func DropAnalyticsRollupTables(ctx context.Context, db *bun.DB) error {
+	for _, model := range []interface{}{
+		(*models.AnalyticsRollupState)(nil),
+		(*models.AnalyticsDailyRollup)(nil),
+		(*models.AnalyticsHourlyRollup)(nil),
+	} {
+		_, err := db.NewDropTable().
+			Model(model).
+			IfExists().
+			Cascade().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop analytics rollup table: %w", err)
+		}
+	}
+	return nil
+}
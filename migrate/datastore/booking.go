@@ -19,6 +19,18 @@ func CreateBookingTable(ctx context.Context, db *bun.DB) error {
 	if err != nil {
 		return fmt.Errorf("failed to create bookings table: %w", err)
 	}
+
+	_, err = db.ExecContext(ctx, `
+		ALTER TABLE bookings
+			ADD COLUMN IF NOT EXISTS promo_code VARCHAR,
+			ADD COLUMN IF NOT EXISTS discount_amount DECIMAL(10,2) NOT NULL DEFAULT 0,
+			ADD COLUMN IF NOT EXISTS points_redeemed INTEGER NOT NULL DEFAULT 0,
+			ADD COLUMN IF NOT EXISTS points_discount DECIMAL(10,2) NOT NULL DEFAULT 0,
+			ADD COLUMN IF NOT EXISTS concession_amount DECIMAL(10,2) NOT NULL DEFAULT 0
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to add discount columns bookings table: %w", err)
+	}
 	return nil
 }
 
@@ -33,17 +45,207 @@ func CreateTicketTable(ctx context.Context, db *bun.DB) error {
 		return fmt.Errorf("failed to create tickets table: %w", err)
 	}
 
+	_, err = db.NewDropIndex().
+		Index("idx_uniq_ticket_showtime_seat").
+		IfExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to drop legacy index tickets table: %w", err)
+	}
+
 	_, err = db.NewCreateIndex().
 		Model((*models.Ticket)(nil)).
 		Column("showtime_id", "seat_id").
-		Index("idx_uniq_ticket_showtime_seat").
+		Index("idx_uniq_ticket_showtime_seat_active").
 		Unique().
+		Where("status != 'CANCELLED'").
 		IfNotExists().
 		Exec(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to create index tickets table: %w", err)
 	}
 
+	_, err = db.ExecContext(ctx, `
+		ALTER TABLE tickets
+			ADD COLUMN IF NOT EXISTS used_at TIMESTAMPTZ,
+			ADD COLUMN IF NOT EXISTS used_by VARCHAR
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to add check-in columns tickets table: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		ALTER TABLE tickets
+			ADD COLUMN IF NOT EXISTS ticket_category VARCHAR,
+			ADD COLUMN IF NOT EXISTS requires_id_check BOOLEAN NOT NULL DEFAULT FALSE,
+			ADD COLUMN IF NOT EXISTS min_age INTEGER NOT NULL DEFAULT 0,
+			ADD COLUMN IF NOT EXISTS max_age INTEGER NOT NULL DEFAULT 0
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to add ticket category columns tickets table: %w", err)
+	}
+
+	return nil
+}
+
+func CreateBookingSeatTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewCreateTable().
+		Model((*models.BookingSeat)(nil)).
+		IfNotExists().
+		ForeignKey("(booking_id) REFERENCES bookings(id) ON DELETE CASCADE").
+		ForeignKey("(showtime_id) REFERENCES showtimes(id) ON DELETE CASCADE").
+		ForeignKey("(seat_id) REFERENCES seats(id) ON DELETE CASCADE").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create booking seats table: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		ALTER TABLE booking_seats
+			ADD COLUMN IF NOT EXISTS ticket_category VARCHAR,
+			ADD COLUMN IF NOT EXISTS requires_id_check BOOLEAN NOT NULL DEFAULT FALSE,
+			ADD COLUMN IF NOT EXISTS min_age INTEGER NOT NULL DEFAULT 0,
+			ADD COLUMN IF NOT EXISTS max_age INTEGER NOT NULL DEFAULT 0
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to add ticket category columns booking seats table: %w", err)
+	}
+
+	_, err = db.NewCreateIndex().
+		Model((*models.BookingSeat)(nil)).
+		Column("showtime_id", "seat_id").
+		Index("idx_uniq_booking_seat_showtime_seat_active").
+		Unique().
+		Where("status = 'ACTIVE'").
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create unique index booking seats table: %w", err)
+	}
+
+	_, err = db.NewCreateIndex().
+		Model((*models.BookingSeat)(nil)).
+		Column("booking_id").
+		Index("idx_booking_seat_booking_id").
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create index booking seats table: %w", err)
+	}
+
+	return BackfillBookingSeats(ctx, db)
+}
+
+func CreateTicketCheckinTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewCreateTable().
+		Model((*models.TicketCheckin)(nil)).
+		IfNotExists().
+		ForeignKey("(ticket_id) REFERENCES tickets(id) ON DELETE CASCADE").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create ticket checkins table: %w", err)
+	}
+
+	_, err = db.NewCreateIndex().
+		Model((*models.TicketCheckin)(nil)).
+		Column("showtime_id", "created_at").
+		Index("idx_ticket_checkin_showtime_id").
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create index ticket checkins table: %w", err)
+	}
+
+	_, err = db.NewCreateIndex().
+		Model((*models.TicketCheckin)(nil)).
+		Column("ticket_id").
+		Index("idx_ticket_checkin_ticket_id").
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create index ticket checkins table: %w", err)
+	}
+
+	return nil
+}
+
+func CreateBookingAuditLogTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewCreateTable().
+		Model((*models.BookingAuditLog)(nil)).
+		IfNotExists().
+		ForeignKey("(booking_id) REFERENCES bookings(id) ON DELETE CASCADE").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create booking audit logs table: %w", err)
+	}
+
+	_, err = db.NewCreateIndex().
+		Model((*models.BookingAuditLog)(nil)).
+		Column("booking_id", "created_at").
+		Index("idx_booking_audit_log_booking_id").
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create index booking audit logs table: %w", err)
+	}
+
+	// Indexes backing the admin booking search.
+	_, err = db.ExecContext(ctx, `
+		CREATE INDEX IF NOT EXISTS idx_booking_created_at_id ON bookings (created_at DESC, id DESC)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create created_at index bookings table: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		CREATE INDEX IF NOT EXISTS idx_booking_status_created_at ON bookings (status, created_at DESC)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create status index bookings table: %w", err)
+	}
+
+	return nil
+}
+
+// BackfillBookingSeats fills booking_seats for active bookings created before
+// the table existed, taking seats from tickets or, for unpaid bookings, from
+// the BOOKING_CREATED outbox payload.
+func BackfillBookingSeats(ctx context.Context, db *bun.DB) error {
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO booking_seats (id, booking_id, showtime_id, seat_id, status, created_at)
+		SELECT gen_random_uuid()::text, t.booking_id, b.showtime_id, t.seat_id, 'ACTIVE', b.created_at
+		FROM tickets t
+		INNER JOIN bookings b ON b.id = t.booking_id
+		WHERE b.status IN ('PENDING', 'CONFIRMED')
+		  AND t.status != 'CANCELLED'
+		  AND NOT EXISTS (SELECT 1 FROM booking_seats bs WHERE bs.booking_id = b.id)
+		ON CONFLICT DO NOTHING
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to backfill booking seats from tickets: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		INSERT INTO booking_seats (id, booking_id, showtime_id, seat_id, status, created_at)
+		SELECT gen_random_uuid()::text, b.id, b.showtime_id, seat.id, 'ACTIVE', b.created_at
+		FROM bookings b
+		INNER JOIN LATERAL (
+			SELECT oe.payload::jsonb AS payload
+			FROM outbox_events oe
+			WHERE oe.event_type = 'BOOKING_CREATED'
+			  AND oe.payload::jsonb->>'booking_id' = b.id
+			ORDER BY oe.id DESC
+			LIMIT 1
+		) e ON TRUE
+		CROSS JOIN LATERAL jsonb_array_elements_text(e.payload->'seat_ids') AS seat(id)
+		WHERE b.status IN ('PENDING', 'CONFIRMED')
+		  AND NOT EXISTS (SELECT 1 FROM booking_seats bs WHERE bs.booking_id = b.id)
+		ON CONFLICT DO NOTHING
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to backfill booking seats from outbox events: %w", err)
+	}
+
 	return nil
 }
 
@@ -56,6 +258,37 @@ func CreatePaymentTable(ctx context.Context, db *bun.DB) error {
 	if err != nil {
 		return fmt.Errorf("failed to create payments table: %w", err)
 	}
+
+	_, err = db.ExecContext(ctx, `
+		ALTER TABLE payments
+			ADD COLUMN IF NOT EXISTS gift_card_amount DECIMAL(10,2) NOT NULL DEFAULT 0
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to add gift card column payments table: %w", err)
+	}
+	return nil
+}
+
+func CreatePaymentAdjustmentTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewCreateTable().
+		Model((*models.PaymentAdjustment)(nil)).
+		IfNotExists().
+		ForeignKey("(payment_id) REFERENCES payments(id) ON DELETE CASCADE").
+		ForeignKey("(booking_id) REFERENCES bookings(id) ON DELETE CASCADE").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create payment adjustments table: %w", err)
+	}
+
+	_, err = db.NewCreateIndex().
+		Model((*models.PaymentAdjustment)(nil)).
+		Column("booking_id").
+		Index("idx_payment_adjustment_booking_id").
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create index payment adjustments table: %w", err)
+	}
 	return nil
 }
 
@@ -83,6 +316,54 @@ func DropTicketTable(ctx context.Context, db *bun.DB) error {
 	return nil
 }
 
+func DropBookingAuditLogTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewDropTable().
+		Model((*models.BookingAuditLog)(nil)).
+		IfExists().
+		Cascade().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to drop booking audit logs table: %w", err)
+	}
+	return nil
+}
+
+func DropTicketCheckinTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewDropTable().
+		Model((*models.TicketCheckin)(nil)).
+		IfExists().
+		Cascade().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to drop ticket checkins table: %w", err)
+	}
+	return nil
+}
+
+func DropBookingSeatTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewDropTable().
+		Model((*models.BookingSeat)(nil)).
+		IfExists().
+		Cascade().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to drop booking seats table: %w", err)
+	}
+	return nil
+}
+
+func DropPaymentAdjustmentTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewDropTable().
+		Model((*models.PaymentAdjustment)(nil)).
+		IfExists().
+		Cascade().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to drop payment adjustments table: %w", err)
+	}
+	return nil
+}
+
 func DropPaymentTable(ctx context.Context, db *bun.DB) error {
 	_, err := db.NewDropTable().
 		Model((*models.Payment)(nil)).
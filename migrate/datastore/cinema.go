@@ -28,6 +28,15 @@ func CreateRoomTable(ctx context.Context, db *bun.DB) error {
 	if err != nil {
 		return fmt.Errorf("failed to create rooms table: %w", err)
 	}
+
+	_, err = db.ExecContext(ctx, `
+		ALTER TABLE rooms
+			ADD COLUMN IF NOT EXISTS layout JSONB,
+			ADD COLUMN IF NOT EXISTS cinema_id VARCHAR NOT NULL DEFAULT 'MAIN'
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to add layout and cinema columns rooms table: %w", err)
+	}
 	return nil
 }
 
@@ -0,0 +1,133 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+
+	"migrate-cmd/models"
+
+	"github.com/uptrace/bun"
+)
+
+func CreateConcessionItemTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewCreateTable().
+		Model((*models.ConcessionItem)(nil)).
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create concession items table: %w", err)
+	}
+	return nil
+}
+
+func CreateConcessionStockTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewCreateTable().
+		Model((*models.ConcessionStock)(nil)).
+		IfNotExists().
+		ForeignKey("(item_id) REFERENCES concession_items(id) ON DELETE CASCADE").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create concession stocks table: %w", err)
+	}
+	return nil
+}
+
+func CreateConcessionComboItemTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewCreateTable().
+		Model((*models.ConcessionComboItem)(nil)).
+		IfNotExists().
+		ForeignKey("(combo_id) REFERENCES concession_items(id) ON DELETE CASCADE").
+		ForeignKey("(item_id) REFERENCES concession_items(id) ON DELETE CASCADE").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create concession combo items table: %w", err)
+	}
+	return nil
+}
+
+func CreateBookingConcessionTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewCreateTable().
+		Model((*models.BookingConcession)(nil)).
+		IfNotExists().
+		ForeignKey("(booking_id) REFERENCES bookings(id) ON DELETE CASCADE").
+		ForeignKey("(item_id) REFERENCES concession_items(id) ON DELETE RESTRICT").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create booking concessions table: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		ALTER TABLE booking_concessions
+			ADD COLUMN IF NOT EXISTS cinema_id VARCHAR NOT NULL DEFAULT 'MAIN'
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to add cinema column booking concessions table: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		ALTER TABLE booking_concessions
+			ADD COLUMN IF NOT EXISTS stock_usage JSONB
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to add stock usage column booking concessions table: %w", err)
+	}
+
+	_, err = db.NewCreateIndex().
+		Model((*models.BookingConcession)(nil)).
+		Column("booking_id").
+		Index("idx_booking_concession_booking").
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create index booking concessions table: %w", err)
+	}
+	return nil
+}
+
+func DropBookingConcessionTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewDropTable().
+		Model((*models.BookingConcession)(nil)).
+		IfExists().
+		Cascade().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to drop booking concessions table: %w", err)
+	}
+	return nil
+}
+
+func DropConcessionStockTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewDropTable().
+		Model((*models.ConcessionStock)(nil)).
+		IfExists().
+		Cascade().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to drop concession stocks table: %w", err)
+	}
+	return nil
+}
+
+func DropConcessionComboItemTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewDropTable().
+		Model((*models.ConcessionComboItem)(nil)).
+		IfExists().
+		Cascade().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to drop concession combo items table: %w", err)
+	}
+	return nil
+}
+
+func DropConcessionItemTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewDropTable().
+		Model((*models.ConcessionItem)(nil)).
+		IfExists().
+		Cascade().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to drop concession items table: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+
+	"migrate-cmd/models"
+
+	"github.com/uptrace/bun"
+)
+
+func CreateGiftCardTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewCreateTable().
+		Model((*models.GiftCard)(nil)).
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create gift cards table: %w", err)
+	}
+	return nil
+}
+
+func CreateGiftCardTransactionTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewCreateTable().
+		Model((*models.GiftCardTransaction)(nil)).
+		IfNotExists().
+		ForeignKey("(gift_card_id) REFERENCES gift_cards(id) ON DELETE CASCADE").
+		ForeignKey("(payment_id) REFERENCES payments(id) ON DELETE SET NULL").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create gift card transactions table: %w", err)
+	}
+
+	_, err = db.NewCreateIndex().
+		Model((*models.GiftCardTransaction)(nil)).
+		Column("gift_card_id", "created_at").
+		Index("idx_gift_card_transaction_card").
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create index gift card transactions table: %w", err)
+	}
+
+	_, err = db.NewCreateIndex().
+		Model((*models.GiftCardTransaction)(nil)).
+		Column("payment_id").
+		Index("idx_gift_card_transaction_payment").
+		Where("payment_id IS NOT NULL").
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create payment index gift card transactions table: %w", err)
+	}
+	return nil
+}
+
+func DropGiftCardTransactionTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewDropTable().
+		Model((*models.GiftCardTransaction)(nil)).
+		IfExists().
+		Cascade().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to drop gift card transactions table: %w", err)
+	}
+	return nil
+}
+
+func DropGiftCardTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewDropTable().
+		Model((*models.GiftCard)(nil)).
+		IfExists().
+		Cascade().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to drop gift cards table: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+
+	"migrate-cmd/models"
+
+	"github.com/uptrace/bun"
+)
+
+// CreateLoyaltyTierTable creates the tier table with its default tiers. Earn
+// rates are points per 1,000 spent; existing tiers are left untouched.
+func CreateLoyaltyTierTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewCreateTable().
+		Model((*models.LoyaltyTier)(nil)).
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create loyalty tiers table: %w", err)
+	}
+
+	tiers := []*models.LoyaltyTier{
+		{Name: "MEMBER", MinLifetimePoints: 0, EarnRate: 1},
+		{Name: "SILVER", MinLifetimePoints: 2000, EarnRate: 1.25},
+		{Name: "GOLD", MinLifetimePoints: 5000, EarnRate: 1.5},
+	}
+
+	_, err = db.NewInsert().
+		Model(&tiers).
+		On("CONFLICT (name) DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to insert default loyalty tiers: %w", err)
+	}
+	return nil
+}
+
+func CreateLoyaltyAccountTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewCreateTable().
+		Model((*models.LoyaltyAccount)(nil)).
+		IfNotExists().
+		ForeignKey("(user_id) REFERENCES users(id) ON DELETE CASCADE").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create loyalty accounts table: %w", err)
+	}
+	return nil
+}
+
+func CreateLoyaltyTransactionTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewCreateTable().
+		Model((*models.LoyaltyTransaction)(nil)).
+		IfNotExists().
+		ForeignKey("(user_id) REFERENCES users(id) ON DELETE CASCADE").
+		ForeignKey("(booking_id) REFERENCES bookings(id) ON DELETE CASCADE").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create loyalty transactions table: %w", err)
+	}
+
+	_, err = db.NewCreateIndex().
+		Model((*models.LoyaltyTransaction)(nil)).
+		Column("user_id", "created_at").
+		Index("idx_loyalty_transaction_user_id").
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create index loyalty transactions table: %w", err)
+	}
+
+	_, err = db.NewCreateIndex().
+		Model((*models.LoyaltyTransaction)(nil)).
+		Column("booking_id", "type").
+		Index("idx_uniq_loyalty_transaction_booking_type").
+		Unique().
+		Where("booking_id IS NOT NULL").
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create unique index loyalty transactions table: %w", err)
+	}
+	return nil
+}
+
+func DropLoyaltyTransactionTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewDropTable().
+		Model((*models.LoyaltyTransaction)(nil)).
+		IfExists().
+		Cascade().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to drop loyalty transactions table: %w", err)
+	}
+	return nil
+}
+
+func DropLoyaltyAccountTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewDropTable().
+		Model((*models.LoyaltyAccount)(nil)).
+		IfExists().
+		Cascade().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to drop loyalty accounts table: %w", err)
+	}
+	return nil
+}
+
+func DropLoyaltyTierTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewDropTable().
+		Model((*models.LoyaltyTier)(nil)).
+		IfExists().
+		Cascade().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to drop loyalty tiers table: %w", err)
+	}
+	return nil
+}
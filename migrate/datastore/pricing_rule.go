@@ -0,0 +1,86 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+
+	"migrate-cmd/models"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+func CreatePricingRuleTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewCreateTable().
+		Model((*models.PricingRule)(nil)).
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create pricing rules table: %w", err)
+	}
+
+	_, err = db.NewCreateIndex().
+		Model((*models.PricingRule)(nil)).
+		Column("rule_type", "priority").
+		Index("idx_pricing_rule_type_priority").
+		Where("is_active = TRUE").
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create index pricing rules table: %w", err)
+	}
+
+	return nil
+}
+
+// CreateTicketCategoryTable creates the concession ticket categories with the
+// default child, student and senior categories; existing ones are left
+// untouched.
+func CreateTicketCategoryTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewCreateTable().
+		Model((*models.TicketCategory)(nil)).
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create ticket categories table: %w", err)
+	}
+
+	categories := []*models.TicketCategory{
+		{Id: uuid.New().String(), Code: "CHILD", Name: "Child", Description: "Children aged 12 and under", AdjustmentType: "MULTIPLIER", AdjustmentValue: 0.7, MaxAge: 12, SeatTypes: []string{"REGULAR", "VIP"}, IsActive: true},
+		{Id: uuid.New().String(), Code: "STUDENT", Name: "Student", Description: "Students with a valid student card", AdjustmentType: "MULTIPLIER", AdjustmentValue: 0.8, RequiresIdCheck: true, IsActive: true},
+		{Id: uuid.New().String(), Code: "SENIOR", Name: "Senior", Description: "Guests aged 60 and over", AdjustmentType: "MULTIPLIER", AdjustmentValue: 0.7, MinAge: 60, RequiresIdCheck: true, IsActive: true},
+	}
+
+	_, err = db.NewInsert().
+		Model(&categories).
+		On("CONFLICT (code) DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to insert default ticket categories: %w", err)
+	}
+	return nil
+}
+
+func DropTicketCategoryTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewDropTable().
+		Model((*models.TicketCategory)(nil)).
+		IfExists().
+		Cascade().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to drop ticket categories table: %w", err)
+	}
+	return nil
+}
+
+func DropPricingRuleTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewDropTable().
+		Model((*models.PricingRule)(nil)).
+		IfExists().
+		Cascade().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to drop pricing rules table: %w", err)
+	}
+	return nil
+}
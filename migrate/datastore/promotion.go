@@ -0,0 +1,80 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+
+	"migrate-cmd/models"
+
+	"github.com/uptrace/bun"
+)
+
+func CreatePromotionTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewCreateTable().
+		Model((*models.Promotion)(nil)).
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create promotions table: %w", err)
+	}
+	return nil
+}
+
+func CreatePromotionRedemptionTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewCreateTable().
+		Model((*models.PromotionRedemption)(nil)).
+		IfNotExists().
+		ForeignKey("(promotion_id) REFERENCES promotions(id) ON DELETE CASCADE").
+		ForeignKey("(booking_id) REFERENCES bookings(id) ON DELETE CASCADE").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create promotion redemptions table: %w", err)
+	}
+
+	_, err = db.NewCreateIndex().
+		Model((*models.PromotionRedemption)(nil)).
+		Column("promotion_id", "user_id").
+		Index("idx_promotion_redemption_promotion_user").
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create index promotion redemptions table: %w", err)
+	}
+
+	_, err = db.NewCreateIndex().
+		Model((*models.PromotionRedemption)(nil)).
+		Column("booking_id").
+		Index("idx_uniq_promotion_redemption_booking_active").
+		Unique().
+		Where("status = 'ACTIVE'").
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create unique index promotion redemptions table: %w", err)
+	}
+	return nil
+}
+
+func DropPromotionRedemptionTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewDropTable().
+		Model((*models.PromotionRedemption)(nil)).
+		IfExists().
+		Cascade().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to drop promotion redemptions table: %w", err)
+	}
+	return nil
+}
+
+func DropPromotionTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewDropTable().
+		Model((*models.Promotion)(nil)).
+		IfExists().
+		Cascade().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to drop promotions table: %w", err)
+	}
+	return nil
+}
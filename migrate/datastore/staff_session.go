@@ -0,0 +1,76 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+
+	"migrate-cmd/models"
+
+	"github.com/uptrace/bun"
+)
+
+func CreateStaffSessionTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewCreateTable().
+		Model((*models.StaffSession)(nil)).
+		IfNotExists().
+		ForeignKey("(staff_id) REFERENCES users(id) ON DELETE CASCADE").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create staff sessions table: %w", err)
+	}
+
+	_, err = db.NewCreateIndex().
+		Model((*models.StaffSession)(nil)).
+		Column("staff_id").
+		Index("idx_uniq_staff_session_staff_open").
+		Unique().
+		Where("status = 'OPEN'").
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create unique index staff sessions table: %w", err)
+	}
+
+	_, err = db.NewCreateIndex().
+		Model((*models.StaffSession)(nil)).
+		Column("opened_at").
+		Index("idx_staff_session_opened_at").
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create index staff sessions table: %w", err)
+	}
+
+	// Box-office bookings and cash payments are recorded against the drawer
+	// session they were taken in.
+	for _, table := range []string{"bookings", "payments", "payment_adjustments"} {
+		_, err = db.ExecContext(ctx, fmt.Sprintf(`
+			ALTER TABLE %s
+				ADD COLUMN IF NOT EXISTS staff_session_id VARCHAR REFERENCES staff_sessions(id) ON DELETE SET NULL
+		`, table))
+		if err != nil {
+			return fmt.Errorf("failed to add staff session column %s table: %w", table, err)
+		}
+
+		_, err = db.ExecContext(ctx, fmt.Sprintf(`
+			CREATE INDEX IF NOT EXISTS idx_%s_staff_session_id ON %s (staff_session_id) WHERE staff_session_id IS NOT NULL
+		`, table, table))
+		if err != nil {
+			return fmt.Errorf("failed to create staff session index %s table: %w", table, err)
+		}
+	}
+
+	return nil
+}
+
+func DropStaffSessionTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewDropTable().
+		Model((*models.StaffSession)(nil)).
+		IfExists().
+		Cascade().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to drop staff sessions table: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+
+	"migrate-cmd/models"
+
+	"github.com/uptrace/bun"
+)
+
+func CreateWaitlistEntryTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewCreateTable().
+		Model((*models.WaitlistEntry)(nil)).
+		IfNotExists().
+		ForeignKey("(user_id) REFERENCES users(id) ON DELETE CASCADE").
+		ForeignKey("(showtime_id) REFERENCES showtimes(id) ON DELETE CASCADE").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create waitlist entries table: %w", err)
+	}
+
+	_, err = db.NewCreateIndex().
+		Model((*models.WaitlistEntry)(nil)).
+		Column("showtime_id", "status", "created_at").
+		Index("idx_waitlist_entry_showtime_status").
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create index waitlist entries table: %w", err)
+	}
+
+	_, err = db.NewCreateIndex().
+		Model((*models.WaitlistEntry)(nil)).
+		Column("user_id", "showtime_id").
+		Index("idx_uniq_waitlist_entry_user_showtime_active").
+		Unique().
+		Where("status IN ('WAITING', 'OFFERED')").
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create unique index waitlist entries table: %w", err)
+	}
+
+	_, err = db.NewCreateIndex().
+		Model((*models.WaitlistEntry)(nil)).
+		Column("hold_id").
+		Index("idx_waitlist_entry_hold_id").
+		Where("hold_id IS NOT NULL").
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create index waitlist entries table: %w", err)
+	}
+	return nil
+}
+
+func DropWaitlistEntryTable(ctx context.Context, db *bun.DB) error {
+	_, err := db.NewDropTable().
+		Model((*models.WaitlistEntry)(nil)).
+		IfExists().
+		Cascade().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to drop waitlist entries table: %w", err)
+	}
+	return nil
+}
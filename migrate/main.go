@@ -71,13 +71,33 @@ func MigrateAll(ctx context.Context, db *bun.DB) error {
 		datastore.CreateRoomTable,
 		datastore.CreateSeatTable,
 		datastore.CreateShowtimeTable,
+		datastore.CreatePricingRuleTable,
+		datastore.CreateTicketCategoryTable,
 		datastore.CreateBookingTable,
 		datastore.CreateTicketTable,
 		datastore.CreatePaymentTable,
+		datastore.CreatePaymentAdjustmentTable,
+		datastore.CreateGiftCardTable,
+		datastore.CreateGiftCardTransactionTable,
 		datastore.CreateNotificationTable,
 		datastore.CreateStaffProfileTable,
 		datastore.CreateCustomerProfileTable,
 		datastore.CreateOutboxEventTable,
+		datastore.CreateBookingSeatTable,
+		datastore.CreatePromotionTable,
+		datastore.CreatePromotionRedemptionTable,
+		datastore.CreateConcessionItemTable,
+		datastore.CreateConcessionStockTable,
+		datastore.CreateConcessionComboItemTable,
+		datastore.CreateBookingConcessionTable,
+		datastore.CreateLoyaltyTierTable,
+		datastore.CreateLoyaltyAccountTable,
+		datastore.CreateLoyaltyTransactionTable,
+		datastore.CreateTicketCheckinTable,
+		datastore.CreateWaitlistEntryTable,
+		datastore.CreateStaffSessionTable,
+		datastore.CreateBookingAuditLogTable,
+		datastore.CreateAnalyticsRollupTables,
 		//datastore.CreateNewsArticleTable,
 		//datastore.CreateNewsSummaryTable,
 		datastore.CreateDocumentTable,
@@ -105,9 +125,29 @@ func DropAllTables(ctx context.Context, db *bun.DB) error {
 		datastore.DropCustomerProfileTable,
 		datastore.DropStaffProfileTable,
 		datastore.DropNotificationTable,
+		datastore.DropAnalyticsRollupTables,
+		datastore.DropBookingAuditLogTable,
+		datastore.DropStaffSessionTable,
+		datastore.DropGiftCardTransactionTable,
+		datastore.DropGiftCardTable,
+		datastore.DropPaymentAdjustmentTable,
 		datastore.DropPaymentTable,
+		datastore.DropWaitlistEntryTable,
+		datastore.DropTicketCheckinTable,
+		datastore.DropLoyaltyTransactionTable,
+		datastore.DropLoyaltyAccountTable,
+		datastore.DropLoyaltyTierTable,
+		datastore.DropBookingConcessionTable,
+		datastore.DropConcessionComboItemTable,
+		datastore.DropConcessionStockTable,
+		datastore.DropConcessionItemTable,
+		datastore.DropPromotionRedemptionTable,
+		datastore.DropPromotionTable,
+		datastore.DropBookingSeatTable,
 		datastore.DropTicketTable,
 		datastore.DropBookingTable,
+		datastore.DropTicketCategoryTable,
+		datastore.DropPricingRuleTable,
 		datastore.DropShowtimeTable,
 		datastore.DropSeatTable,
 		datastore.DropRoomTable,
@@ -146,6 +186,7 @@ func SeedAll(ctx context.Context, db *bun.DB) error {
 		datastore.SeedNotifications,
 		datastore.SeedBookings,
 		datastore.SeedTickets,
+		datastore.BackfillBookingSeats,
 	}
 
 	for _, seedFunc := range seedFuncs {
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// AnalyticsHourlyRollup holds confirmed booking totals per UTC hour, keyed by
+// the hour the bookings were created in.
+type AnalyticsHourlyRollup struct {
+	bun.BaseModel `bun:"table:analytics_hourly_rollups,alias:ahr"`
+
+	BucketStart   time.Time `bun:"bucket_start,pk,type:timestamptz" json:"bucket_start"`
+	ShowtimeId    string    `bun:"showtime_id,pk" json:"showtime_id"`
+	BookingType   string    `bun:"booking_type,pk" json:"booking_type"`
+	TotalBookings int       `bun:"total_bookings,notnull,default:0" json:"total_bookings"`
+	TotalTickets  int       `bun:"total_tickets,notnull,default:0" json:"total_tickets"`
+	TotalRevenue  float64   `bun:"total_revenue,notnull,default:0,type:decimal(14,2)" json:"total_revenue"`
+	UpdatedAt     time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp" json:"updated_at"`
+}
+
+// AnalyticsDailyRollup holds the same totals per day in the business
+// timezone recorded on the daily rollup state.
+type AnalyticsDailyRollup struct {
+	bun.BaseModel `bun:"table:analytics_daily_rollups,alias:adr"`
+
+	BucketDate    time.Time `bun:"bucket_date,pk,type:date" json:"bucket_date"`
+	ShowtimeId    string    `bun:"showtime_id,pk" json:"showtime_id"`
+	BookingType   string    `bun:"booking_type,pk" json:"booking_type"`
+	TotalBookings int       `bun:"total_bookings,notnull,default:0" json:"total_bookings"`
+	TotalTickets  int       `bun:"total_tickets,notnull,default:0" json:"total_tickets"`
+	TotalRevenue  float64   `bun:"total_revenue,notnull,default:0,type:decimal(14,2)" json:"total_revenue"`
+	UpdatedAt     time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp" json:"updated_at"`
+}
+
+// AnalyticsRollupState tracks how far a rollup table is complete. Buckets
+// before ClosedUntil are final; bookings changed after RefreshedAt have not
+// been folded in yet.
+type AnalyticsRollupState struct {
+	bun.BaseModel `bun:"table:analytics_rollup_states,alias:ars"`
+
+	Name        string     `bun:"name,pk" json:"name"`
+	Timezone    string     `bun:"timezone,notnull,default:'UTC'" json:"timezone"`
+	ClosedUntil *time.Time `bun:"closed_until,type:timestamptz" json:"closed_until,omitempty"`
+	RefreshedAt *time.Time `bun:"refreshed_at,type:timestamptz" json:"refreshed_at,omitempty"`
+	UpdatedAt   time.Time  `bun:"updated_at,nullzero,notnull,default:current_timestamp" json:"updated_at"`
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type BookingAuditLog struct {
+	bun.BaseModel `bun:"table:booking_audit_logs,alias:bal"`
+
+	Id         string    `bun:"id,pk" json:"id"`
+	BookingId  string    `bun:"booking_id,notnull" json:"booking_id"`
+	ActorId    string    `bun:"actor_id,notnull" json:"actor_id"`
+	Action     string    `bun:"action,notnull" json:"action"`
+	FromStatus string    `bun:"from_status,notnull" json:"from_status"`
+	ToStatus   string    `bun:"to_status,notnull" json:"to_status"`
+	Reason     string    `bun:"reason,notnull" json:"reason"`
+	CreatedAt  time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
+}
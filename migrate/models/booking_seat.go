@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type BookingSeatStatus string
+
+const (
+	BookingSeatStatusActive   BookingSeatStatus = "ACTIVE"
+	BookingSeatStatusReleased BookingSeatStatus = "RELEASED"
+)
+
+type BookingSeat struct {
+	bun.BaseModel `bun:"table:booking_seats,alias:bs"`
+
+	Id              string            `bun:"id,pk" json:"id"`
+	BookingId       string            `bun:"booking_id,notnull" json:"booking_id"`
+	ShowtimeId      string            `bun:"showtime_id,notnull" json:"showtime_id"`
+	SeatId          string            `bun:"seat_id,notnull" json:"seat_id"`
+	Status          BookingSeatStatus `bun:"status,notnull,default:'ACTIVE'" json:"status"`
+	TicketCategory  string            `bun:"ticket_category,nullzero" json:"ticket_category,omitempty"`
+	RequiresIdCheck bool              `bun:"requires_id_check,notnull,default:false" json:"requires_id_check"`
+	MinAge          int               `bun:"min_age,notnull,default:0" json:"min_age,omitempty"`
+	MaxAge          int               `bun:"max_age,notnull,default:0" json:"max_age,omitempty"`
+	CreatedAt       time.Time         `bun:"created_at,nullzero,default:current_timestamp" json:"created_at"`
+	UpdatedAt       *time.Time        `bun:"updated_at" json:"updated_at,omitempty"`
+
+	Booking *Booking `bun:"rel:belongs-to,join:booking_id=id" json:"booking,omitempty"`
+	Seat    *Seat    `bun:"rel:belongs-to,join:seat_id=id" json:"seat,omitempty"`
+}
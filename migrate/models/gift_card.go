@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type GiftCard struct {
+	bun.BaseModel `bun:"table:gift_cards,alias:gc"`
+
+	Id             string     `bun:"id,pk" json:"id"`
+	Code           string     `bun:"code,notnull,unique" json:"code"`
+	InitialBalance float64    `bun:"initial_balance,notnull,type:decimal(10,2)" json:"initial_balance"`
+	Balance        float64    `bun:"balance,notnull,type:decimal(10,2)" json:"balance"`
+	Status         string     `bun:"status,notnull,default:'ACTIVE'" json:"status"`
+	ExpiresAt      time.Time  `bun:"expires_at,notnull" json:"expires_at"`
+	VoidedAt       *time.Time `bun:"voided_at" json:"voided_at,omitempty"`
+
+	CreatedAt time.Time  `bun:"created_at,nullzero,default:current_timestamp" json:"created_at"`
+	UpdatedAt *time.Time `bun:"updated_at" json:"updated_at,omitempty"`
+}
+
+type GiftCardTransaction struct {
+	bun.BaseModel `bun:"table:gift_card_transactions,alias:gct"`
+
+	Id           string  `bun:"id,pk" json:"id"`
+	GiftCardId   string  `bun:"gift_card_id,notnull" json:"gift_card_id"`
+	Type         string  `bun:"type,notnull" json:"type"`
+	Amount       float64 `bun:"amount,notnull,type:decimal(10,2)" json:"amount"`
+	BalanceAfter float64 `bun:"balance_after,notnull,type:decimal(10,2)" json:"balance_after"`
+	PaymentId    string  `bun:"payment_id,nullzero" json:"payment_id,omitempty"`
+	BookingId    string  `bun:"booking_id,nullzero" json:"booking_id,omitempty"`
+	Reason       string  `bun:"reason" json:"reason,omitempty"`
+
+	CreatedAt time.Time `bun:"created_at,nullzero,default:current_timestamp" json:"created_at"`
+
+	GiftCard *GiftCard `bun:"rel:belongs-to,join:gift_card_id=id" json:"gift_card,omitempty"`
+}
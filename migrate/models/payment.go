@@ -18,8 +18,29 @@ type Payment struct {
 	Status        string    `bun:"status,notnull,default:'PENDING'" json:"status"`
 	Payload       *string   `bun:"payload" json:"payload,omitempty"`
 
+	GiftCardAmount float64 `bun:"gift_card_amount,notnull,default:0,type:decimal(10,2)" json:"gift_card_amount"`
+
 	CreatedAt time.Time  `bun:"created_at,nullzero,default:current_timestamp" json:"created_at"`
 	UpdatedAt *time.Time `bun:"updated_at" json:"updated_at,omitempty"`
 
 	Booking *Booking `bun:"rel:belongs-to,join:booking_id=id" json:"booking,omitempty"`
 }
+
+type PaymentAdjustment struct {
+	bun.BaseModel `bun:"table:payment_adjustments,alias:pa"`
+
+	Id            string  `bun:"id,pk" json:"id"`
+	PaymentId     string  `bun:"payment_id,notnull" json:"payment_id"`
+	BookingId     string  `bun:"booking_id,notnull" json:"booking_id"`
+	ReferenceId   string  `bun:"reference_id,notnull,unique" json:"reference_id"`
+	Type          string  `bun:"type,notnull" json:"type"`
+	Amount        float64 `bun:"amount,notnull,type:decimal(10,2)" json:"amount"`
+	Reason        string  `bun:"reason" json:"reason,omitempty"`
+	Status        string  `bun:"status,notnull,default:'PENDING'" json:"status"`
+	PaymentMethod string  `bun:"payment_method,nullzero" json:"payment_method,omitempty"`
+
+	CreatedAt time.Time  `bun:"created_at,nullzero,default:current_timestamp" json:"created_at"`
+	UpdatedAt *time.Time `bun:"updated_at" json:"updated_at,omitempty"`
+
+	Payment *Payment `bun:"rel:belongs-to,join:payment_id=id" json:"payment,omitempty"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type PricingRule struct {
+	bun.BaseModel `bun:"table:pricing_rules,alias:pru"`
+
+	Id              string     `bun:"id,pk" json:"id"`
+	Name            string     `bun:"name,notnull" json:"name"`
+	RuleType        string     `bun:"rule_type,notnull" json:"rule_type"`
+	Formats         []string   `bun:"formats,array" json:"formats"`
+	RoomTypes       []string   `bun:"room_types,array" json:"room_types"`
+	Weekdays        []int      `bun:"weekdays,array" json:"weekdays"`
+	StartTime       string     `bun:"start_time" json:"start_time,omitempty"`
+	EndTime         string     `bun:"end_time" json:"end_time,omitempty"`
+	HolidayDates    []string   `bun:"holiday_dates,array" json:"holiday_dates"`
+	MinOccupancy    float64    `bun:"min_occupancy,notnull,default:0,type:decimal(5,2)" json:"min_occupancy"`
+	AdjustmentType  string     `bun:"adjustment_type,notnull" json:"adjustment_type"`
+	AdjustmentValue float64    `bun:"adjustment_value,notnull,type:decimal(12,4)" json:"adjustment_value"`
+	Priority        int        `bun:"priority,notnull,default:0" json:"priority"`
+	IsActive        bool       `bun:"is_active,notnull,default:true" json:"is_active"`
+	CreatedAt       time.Time  `bun:"created_at,nullzero,default:current_timestamp" json:"created_at"`
+	UpdatedAt       *time.Time `bun:"updated_at" json:"updated_at,omitempty"`
+}
@@ -11,12 +11,15 @@ type Room struct {
 
 	Id         string     `bun:"id,pk" json:"id"`
 	RoomNumber int        `bun:"room_number,notnull,unique" json:"room_number"`
+	CinemaId   string     `bun:"cinema_id,notnull,default:'MAIN'" json:"cinema_id"`
 	Capacity   int        `bun:"capacity,notnull" json:"capacity"`
 	RoomType   string     `bun:"room_type,notnull" json:"room_type"`
 	Status     string     `bun:"status,notnull,default:'ACTIVE'" json:"status"`
 	CreatedAt  time.Time  `bun:"created_at,nullzero,default:current_timestamp" json:"created_at"`
 	UpdatedAt  *time.Time `bun:"updated_at" json:"updated_at,omitempty"`
 
+	Layout map[string]interface{} `bun:"layout,type:jsonb" json:"layout,omitempty"`
+
 	Seats     []*Seat     `bun:"rel:has-many,join:id=room_id" json:"seats,omitempty"`
 	Showtimes []*Showtime `bun:"rel:has-many,join:id=room_id" json:"showtimes,omitempty"`
 }
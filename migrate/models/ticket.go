@@ -9,20 +9,27 @@ import (
 type TicketStatus string
 
 const (
-	TicketStatusUnused TicketStatus = "UNUSED"
-	TicketStatusUsed   TicketStatus = "USED"
+	TicketStatusUnused    TicketStatus = "UNUSED"
+	TicketStatusUsed      TicketStatus = "USED"
+	TicketStatusCancelled TicketStatus = "CANCELLED"
 )
 
 type Ticket struct {
 	bun.BaseModel `bun:"table:tickets,alias:t"`
 
-	Id         string       `bun:"id,pk" json:"id"`
-	BookingId  string       `bun:"booking_id,notnull" json:"booking_id"`
-	ShowtimeId string       `bun:"showtime_id,notnull" json:"showtime_id"`
-	SeatId     string       `bun:"seat_id,notnull" json:"seat_id"`
-	Status     TicketStatus `bun:"status,default:'UNUSED'" json:"status"`
-	CreatedAt  time.Time    `bun:"created_at,nullzero,default:current_timestamp" json:"created_at"`
-	UpdatedAt  *time.Time   `bun:"updated_at" json:"updated_at,omitempty"`
+	Id              string       `bun:"id,pk" json:"id"`
+	BookingId       string       `bun:"booking_id,notnull" json:"booking_id"`
+	ShowtimeId      string       `bun:"showtime_id,notnull" json:"showtime_id"`
+	SeatId          string       `bun:"seat_id,notnull" json:"seat_id"`
+	Status          TicketStatus `bun:"status,default:'UNUSED'" json:"status"`
+	UsedAt          *time.Time   `bun:"used_at" json:"used_at,omitempty"`
+	UsedBy          string       `bun:"used_by,nullzero" json:"used_by,omitempty"`
+	TicketCategory  string       `bun:"ticket_category,nullzero" json:"ticket_category,omitempty"`
+	RequiresIdCheck bool         `bun:"requires_id_check,notnull,default:false" json:"requires_id_check"`
+	MinAge          int          `bun:"min_age,notnull,default:0" json:"min_age,omitempty"`
+	MaxAge          int          `bun:"max_age,notnull,default:0" json:"max_age,omitempty"`
+	CreatedAt       time.Time    `bun:"created_at,nullzero,default:current_timestamp" json:"created_at"`
+	UpdatedAt       *time.Time   `bun:"updated_at" json:"updated_at,omitempty"`
 
 	Booking *Booking `bun:"rel:belongs-to,join:booking_id=id" json:"booking,omitempty"`
 	Seat    *Seat    `bun:"rel:belongs-to,join:seat_id=id" json:"seat,omitempty"`
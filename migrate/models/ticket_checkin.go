@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type TicketCheckin struct {
+	bun.BaseModel `bun:"table:ticket_checkins,alias:tc"`
+
+	Id         string    `bun:"id,pk" json:"id"`
+	TicketId   string    `bun:"ticket_id,nullzero" json:"ticket_id,omitempty"`
+	ShowtimeId string    `bun:"showtime_id,nullzero" json:"showtime_id,omitempty"`
+	StaffId    string    `bun:"staff_id,notnull" json:"staff_id"`
+	DeviceId   string    `bun:"device_id,nullzero" json:"device_id,omitempty"`
+	Result     string    `bun:"result,notnull" json:"result"`
+	CreatedAt  time.Time `bun:"created_at,nullzero,default:current_timestamp" json:"created_at"`
+
+	Ticket *Ticket `bun:"rel:belongs-to,join:ticket_id=id" json:"ticket,omitempty"`
+}
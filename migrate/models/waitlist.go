@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type WaitlistEntry struct {
+	bun.BaseModel `bun:"table:waitlist_entries,alias:wl"`
+
+	Id             string     `bun:"id,pk" json:"id"`
+	UserId         string     `bun:"user_id,notnull" json:"user_id"`
+	ShowtimeId     string     `bun:"showtime_id,notnull" json:"showtime_id"`
+	SeatCount      int        `bun:"seat_count,notnull,default:1" json:"seat_count"`
+	SeatType       string     `bun:"seat_type,nullzero" json:"seat_type,omitempty"`
+	Status         string     `bun:"status,notnull,default:'WAITING'" json:"status"`
+	HoldId         string     `bun:"hold_id,nullzero" json:"hold_id,omitempty"`
+	OfferedSeatIds []string   `bun:"offered_seat_ids,array" json:"offered_seat_ids,omitempty"`
+	OfferExpiresAt *time.Time `bun:"offer_expires_at" json:"offer_expires_at,omitempty"`
+	BookingId      string     `bun:"booking_id,nullzero" json:"booking_id,omitempty"`
+	CreatedAt      time.Time  `bun:"created_at,nullzero,notnull,default:current_timestamp" json:"created_at"`
+	UpdatedAt      *time.Time `bun:"updated_at" json:"updated_at,omitempty"`
+}
@@ -4,6 +4,7 @@ import (
 	"movie-service/internal/container"
 	"movie-service/internal/module/movie/transport/rest"
 	newsRest "movie-service/internal/module/news/transport/rest"
+	pricingRest "movie-service/internal/module/pricing/transport/rest"
 	roomRest "movie-service/internal/module/room/transport/rest"
 	seatRest "movie-service/internal/module/seat/transport/rest"
 	showtimeRest "movie-service/internal/module/showtime/transport/rest"
@@ -60,6 +61,11 @@ func startRouteV1(group *gin.RouterGroup) {
 		panic(err)
 	}
 
+	pricingApi, err := pricingRest.NewAPI(i)
+	if err != nil {
+		panic(err)
+	}
+
 	// Movie endpoints
 	movies := group.Group("/movies")
 	{
@@ -82,6 +88,7 @@ func startRouteV1(group *gin.RouterGroup) {
 		rooms.PUT("/:id", roomApi.UpdateRoom)
 		rooms.DELETE("/:id", roomApi.DeleteRoom)
 		rooms.PATCH("/:id/status", roomApi.UpdateRoomStatus)
+		rooms.PUT("/:id/layout", roomApi.GenerateRoomSeats)
 	}
 
 	// Seat endpoints
@@ -108,6 +115,26 @@ func startRouteV1(group *gin.RouterGroup) {
 		showtimes.PATCH("/:id/status", showtimeApi.UpdateShowtimeStatus)
 	}
 
+	// Pricing rule endpoints
+	pricingRules := group.Group("/pricing-rules")
+	{
+		pricingRules.GET("", pricingApi.GetPricingRules)
+		pricingRules.POST("", pricingApi.CreatePricingRule)
+		pricingRules.GET("/:id", pricingApi.GetPricingRuleById)
+		pricingRules.PUT("/:id", pricingApi.UpdatePricingRule)
+		pricingRules.DELETE("/:id", pricingApi.DeletePricingRule)
+	}
+
+	// Ticket category endpoints
+	ticketCategories := group.Group("/ticket-categories")
+	{
+		ticketCategories.GET("", pricingApi.GetTicketCategories)
+		ticketCategories.POST("", pricingApi.CreateTicketCategory)
+		ticketCategories.GET("/:id", pricingApi.GetTicketCategoryById)
+		ticketCategories.PUT("/:id", pricingApi.UpdateTicketCategory)
+		ticketCategories.DELETE("/:id", pricingApi.DeleteTicketCategory)
+	}
+
 	// News endpoints
 	news := group.Group("/news")
 	{
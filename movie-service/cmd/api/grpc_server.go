@@ -6,6 +6,7 @@ import (
 
 	"movie-service/internal/container"
 	"movie-service/internal/module/movie/transport/grpc"
+	pricingBiz "movie-service/internal/module/pricing/business"
 	seatBiz "movie-service/internal/module/seat/business"
 	showTimeBiz "movie-service/internal/module/showtime/business"
 
@@ -32,9 +33,14 @@ func ServeGRPC() *cli.Command {
 				return fmt.Errorf("failed to create seat business: %w", err)
 			}
 
+			pricingBiz, err := pricingBiz.NewBusiness(i)
+			if err != nil {
+				return fmt.Errorf("failed to create pricing business: %w", err)
+			}
+
 			s := grpc_server.NewServer()
 
-			grpcServer := grpc.NewMovieGRPCServer(showtimeBiz, seatBiz)
+			grpcServer := grpc.NewMovieGRPCServer(showtimeBiz, seatBiz, pricingBiz)
 			pb.RegisterMovieServiceServer(s, grpcServer)
 
 			lis, err := net.Listen("tcp", ":50053")
@@ -3,6 +3,9 @@ package container
 import (
 	"os"
 
+	pricingBusiness "movie-service/internal/module/pricing/business"
+	pricingPostgres "movie-service/internal/module/pricing/repository/postgres"
+
 	roomBusiness "movie-service/internal/module/room/business"
 	roomPostgres "movie-service/internal/module/room/repository/postgres"
 
@@ -75,6 +78,11 @@ func NewContainer() *do.Injector {
 	do.Provide(injector, provideShowtimeRepository)
 	do.Provide(injector, provideShowtimeBusiness)
 
+	// Pricing module
+	do.Provide(injector, providePricingRepository)
+	do.Provide(injector, provideTicketCategoryRepository)
+	do.Provide(injector, providePricingBusiness)
+
 	return injector
 }
 
@@ -256,3 +264,16 @@ func provideShowtimeRepository(i *do.Injector) (showtimeBusiness.ShowtimeReposit
 func provideShowtimeBusiness(i *do.Injector) (showtimeBusiness.ShowtimeBiz, error) {
 	return showtimeBusiness.NewBusiness(i)
 }
+
+// Pricing providers
+func providePricingRepository(i *do.Injector) (pricingBusiness.PricingRepository, error) {
+	return pricingPostgres.NewPricingRepository(i)
+}
+
+func provideTicketCategoryRepository(i *do.Injector) (pricingBusiness.TicketCategoryRepository, error) {
+	return pricingPostgres.NewTicketCategoryRepository(i)
+}
+
+func providePricingBusiness(i *do.Injector) (pricingBusiness.PricingBiz, error) {
+	return pricingBusiness.NewBusiness(i)
+}
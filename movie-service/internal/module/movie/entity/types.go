@@ -59,7 +59,7 @@ type MovieResponse struct {
 type GetMoviesResponse struct {
 	Movies []*MovieResponse `json:"movies"`
 	Meta   *MetaResponse    `json:"meta"`
-	Hello  string           `json:"hello""`
+	Hello  string           `json:"hello"`
 }
 
 type MetaResponse struct {
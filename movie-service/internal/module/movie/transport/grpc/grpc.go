@@ -2,13 +2,19 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	pricingBusiness "movie-service/internal/module/pricing/business"
+	pricingEntity "movie-service/internal/module/pricing/entity"
 	seatEntity "movie-service/internal/module/seat/entity"
 	"movie-service/internal/module/showtime/entity"
 	"movie-service/proto/pb"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type ShowtimeBusiness interface {
@@ -19,18 +25,25 @@ type ShowtimeBusiness interface {
 type SeatBusiness interface {
 	GetSeatById(ctx context.Context, id string) (*seatEntity.Seat, error)
 	GetSeatsByIds(ctx context.Context, ids []string) ([]*seatEntity.Seat, error)
+	GetSeatsByRoomAndRows(ctx context.Context, roomId string, rows []string) ([]*seatEntity.Seat, error)
+}
+
+type PricingBusiness interface {
+	QuoteSeatPrices(ctx context.Context, showtime *entity.Showtime, seats []*seatEntity.Seat, categories map[string]string, quoteId string, ttl time.Duration) (*pricingEntity.PriceQuote, error)
 }
 
 type MovieServiceServer struct {
 	pb.UnimplementedMovieServiceServer
 	showtimeBiz ShowtimeBusiness
 	seatBiz     SeatBusiness
+	pricingBiz  PricingBusiness
 }
 
-func NewMovieGRPCServer(showtimeBiz ShowtimeBusiness, seatBiz SeatBusiness) *MovieServiceServer {
+func NewMovieGRPCServer(showtimeBiz ShowtimeBusiness, seatBiz SeatBusiness, pricingBiz PricingBusiness) *MovieServiceServer {
 	return &MovieServiceServer{
 		showtimeBiz: showtimeBiz,
 		seatBiz:     seatBiz,
+		pricingBiz:  pricingBiz,
 	}
 }
 
@@ -55,6 +68,9 @@ func (s *MovieServiceServer) GetShowtime(ctx context.Context, req *pb.GetShowtim
 		RoomNumber:      fmt.Sprintf("%d", showtime.Room.RoomNumber),
 		SeatNumbers:     []string{},
 		DurationSeconds: duration,
+		Format:          string(showtime.Format),
+		SeatCapacity:    int32(showtime.Room.Capacity),
+		CinemaId:        showtime.Room.CinemaId,
 	}
 
 	return &pb.GetShowtimeResponse{
@@ -87,6 +103,9 @@ func (s *MovieServiceServer) GetShowtimes(ctx context.Context, req *pb.GetShowti
 			RoomNumber:      fmt.Sprintf("%d", showtime.Room.RoomNumber),
 			SeatNumbers:     []string{},
 			DurationSeconds: duration,
+			Format:          string(showtime.Format),
+			SeatCapacity:    int32(showtime.Room.Capacity),
+			CinemaId:        showtime.Room.CinemaId,
 		}
 		showtimeData = append(showtimeData, data)
 	}
@@ -129,28 +148,69 @@ func (s *MovieServiceServer) GetSeatsWithPrice(ctx context.Context, req *pb.GetS
 		}, nil
 	}
 
-	var seatPriceData []*pb.SeatPriceData
-	var totalAmount float64
+	ttl := time.Duration(req.QuoteTtlSeconds) * time.Second
+	quote, err := s.pricingBiz.QuoteSeatPrices(ctx, showtime, seats, req.SeatCategories, req.QuoteId, ttl)
+	if err != nil {
+		// The caller picked the categories, so tell it apart from a failure
+		// to price the seats.
+		if errors.Is(err, pricingBusiness.ErrTicketCategoryNotFound) || errors.Is(err, pricingBusiness.ErrTicketCategoryNotAllowed) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return &pb.GetSeatsWithPriceResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to price seats: %v", err),
+		}, nil
+	}
+
+	prices := make(map[string]pricingEntity.SeatPrice, len(quote.Seats))
+	for _, seatPrice := range quote.Seats {
+		prices[seatPrice.SeatId] = seatPrice
+	}
 
+	var seatPriceData []*pb.SeatPriceData
 	for _, seat := range seats {
-		price := seat.CalculatePrice(showtime.BasePrice)
-		totalAmount += price
+		seatPrice := prices[seat.Id]
+
+		adjustments := make([]*pb.PriceAdjustment, 0, len(seatPrice.Adjustments))
+		for _, adjustment := range seatPrice.Adjustments {
+			adjustments = append(adjustments, &pb.PriceAdjustment{
+				RuleId:         adjustment.RuleId,
+				RuleName:       adjustment.RuleName,
+				RuleType:       string(adjustment.RuleType),
+				AdjustmentType: string(adjustment.AdjustmentType),
+				Value:          adjustment.Value,
+				Amount:         adjustment.Amount,
+			})
+		}
 
 		seatPriceData = append(seatPriceData, &pb.SeatPriceData{
-			SeatId:     seat.Id,
-			SeatNumber: seat.SeatNumber,
-			SeatType:   string(seat.SeatType),
-			Price:      price,
-			Available:  seat.Status == seatEntity.SeatStatusAvailable,
+			SeatId:          seat.Id,
+			SeatNumber:      seat.SeatNumber,
+			SeatType:        string(seat.SeatType),
+			Price:           seatPrice.Price,
+			Available:       seat.Status == seatEntity.SeatStatusAvailable,
+			SeatRow:         seat.RowNumber,
+			BasePrice:       seatPrice.BasePrice,
+			Adjustments:     adjustments,
+			TicketCategory:  seatPrice.TicketCategory,
+			RequiresIdCheck: seatPrice.RequiresIdCheck,
+			MinAge:          int32(seatPrice.MinAge),
+			MaxAge:          int32(seatPrice.MaxAge),
 		})
 	}
 
-	return &pb.GetSeatsWithPriceResponse{
+	resp := &pb.GetSeatsWithPriceResponse{
 		Success:     true,
 		Message:     "Seats with prices retrieved successfully",
 		Data:        seatPriceData,
-		TotalAmount: totalAmount,
-	}, nil
+		TotalAmount: quote.TotalAmount,
+		QuoteId:     quote.Id,
+	}
+	if quote.Id != "" {
+		resp.QuoteExpiresAt = quote.ExpiresAt.Unix()
+	}
+
+	return resp, nil
 }
 
 func (s *MovieServiceServer) GetSeatDetails(ctx context.Context, req *pb.GetSeatDetailsRequest) (*pb.GetSeatDetailsResponse, error) {
@@ -186,6 +246,7 @@ func (s *MovieServiceServer) GetSeatDetails(ctx context.Context, req *pb.GetSeat
 			SeatRow:    seat.RowNumber,
 			SeatNumber: seatNumber,
 			SeatType:   string(seat.SeatType),
+			Status:     string(seat.Status),
 		})
 	}
 
@@ -196,6 +257,51 @@ func (s *MovieServiceServer) GetSeatDetails(ctx context.Context, req *pb.GetSeat
 	}, nil
 }
 
-func RegisterMovieServiceServer(s *grpc.Server, showtimeBiz ShowtimeBusiness, seatBiz SeatBusiness) {
-	pb.RegisterMovieServiceServer(s, NewMovieGRPCServer(showtimeBiz, seatBiz))
+func (s *MovieServiceServer) GetShowtimeSeats(ctx context.Context, req *pb.GetShowtimeSeatsRequest) (*pb.GetSeatDetailsResponse, error) {
+	if req.ShowtimeId == "" || len(req.SeatRows) == 0 {
+		return &pb.GetSeatDetailsResponse{
+			Success: false,
+			Message: "showtime_id and seat_rows are required",
+		}, nil
+	}
+
+	showtime, err := s.showtimeBiz.GetShowtimeById(ctx, req.ShowtimeId)
+	if err != nil {
+		return &pb.GetSeatDetailsResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to get showtime: %v", err),
+		}, nil
+	}
+
+	seats, err := s.seatBiz.GetSeatsByRoomAndRows(ctx, showtime.RoomId, req.SeatRows)
+	if err != nil {
+		return &pb.GetSeatDetailsResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to get seats: %v", err),
+		}, nil
+	}
+
+	seatDetails := make([]*pb.SeatDetailData, 0, len(seats))
+	for _, seat := range seats {
+		seatNumber := int32(0)
+		fmt.Sscanf(seat.SeatNumber, "%d", &seatNumber)
+
+		seatDetails = append(seatDetails, &pb.SeatDetailData{
+			SeatId:     seat.Id,
+			SeatRow:    seat.RowNumber,
+			SeatNumber: seatNumber,
+			SeatType:   string(seat.SeatType),
+			Status:     string(seat.Status),
+		})
+	}
+
+	return &pb.GetSeatDetailsResponse{
+		Success: true,
+		Message: "Showtime seats retrieved successfully",
+		Data:    seatDetails,
+	}, nil
+}
+
+func RegisterMovieServiceServer(s *grpc.Server, showtimeBiz ShowtimeBusiness, seatBiz SeatBusiness, pricingBiz PricingBusiness) {
+	pb.RegisterMovieServiceServer(s, NewMovieGRPCServer(showtimeBiz, seatBiz, pricingBiz))
 }
@@ -0,0 +1,435 @@
+package business
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"movie-service/internal/module/pricing/entity"
+	seatBusiness "movie-service/internal/module/seat/business"
+	seatEntity "movie-service/internal/module/seat/entity"
+	showtimeEntity "movie-service/internal/module/showtime/entity"
+	"movie-service/internal/pkg/caching"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/samber/do"
+)
+
+var (
+	ErrInvalidPricingRuleData = fmt.Errorf("invalid pricing rule data")
+	ErrPricingRuleNotFound    = fmt.Errorf("pricing rule not found")
+	ErrQuoteMismatch          = fmt.Errorf("price quote does not match the requested seats")
+
+	ErrInvalidTicketCategoryData = fmt.Errorf("invalid ticket category data")
+	ErrTicketCategoryNotFound    = fmt.Errorf("ticket category not found")
+	ErrTicketCategoryCodeExists  = fmt.Errorf("ticket category code already exists")
+	ErrTicketCategoryNotAllowed  = fmt.Errorf("ticket category is not available for this seat")
+)
+
+type PricingBiz interface {
+	GetPricingRuleById(ctx context.Context, id string) (*entity.PricingRule, error)
+	GetPricingRules(ctx context.Context, page, size int, ruleType entity.RuleType, isActive *bool) ([]*entity.PricingRule, int, error)
+	CreatePricingRule(ctx context.Context, rule *entity.PricingRule) error
+	UpdatePricingRule(ctx context.Context, id string, updates *entity.UpdatePricingRuleRequest) error
+	DeletePricingRule(ctx context.Context, id string) error
+	GetTicketCategoryById(ctx context.Context, id string) (*entity.TicketCategory, error)
+	GetTicketCategories(ctx context.Context, isActive *bool) ([]*entity.TicketCategory, error)
+	CreateTicketCategory(ctx context.Context, category *entity.TicketCategory) error
+	UpdateTicketCategory(ctx context.Context, id string, updates *entity.UpdateTicketCategoryRequest) error
+	DeleteTicketCategory(ctx context.Context, id string) error
+	QuoteSeatPrices(ctx context.Context, showtime *showtimeEntity.Showtime, seats []*seatEntity.Seat, categories map[string]string, quoteId string, ttl time.Duration) (*entity.PriceQuote, error)
+}
+
+type PricingRepository interface {
+	GetByID(ctx context.Context, id string) (*entity.PricingRule, error)
+	GetMany(ctx context.Context, limit, offset int, ruleType entity.RuleType, isActive *bool) ([]*entity.PricingRule, error)
+	GetTotalCount(ctx context.Context, ruleType entity.RuleType, isActive *bool) (int, error)
+	GetActive(ctx context.Context) ([]*entity.PricingRule, error)
+	Create(ctx context.Context, rule *entity.PricingRule) error
+	Update(ctx context.Context, rule *entity.PricingRule) error
+	Delete(ctx context.Context, id string) error
+}
+
+type TicketCategoryRepository interface {
+	GetByID(ctx context.Context, id string) (*entity.TicketCategory, error)
+	GetMany(ctx context.Context, isActive *bool) ([]*entity.TicketCategory, error)
+	Create(ctx context.Context, category *entity.TicketCategory) error
+	Update(ctx context.Context, category *entity.TicketCategory) error
+	Delete(ctx context.Context, id string) error
+	ExistsByCode(ctx context.Context, code string, excludeId string) (bool, error)
+}
+
+type business struct {
+	repository   PricingRepository
+	categoryRepo TicketCategoryRepository
+	seatBiz      seatBusiness.SeatBiz
+	cache        caching.Cache
+	roCache      caching.ReadOnlyCache
+	redisClient  redis.UniversalClient
+	location     *time.Location
+}
+
+func NewBusiness(i *do.Injector) (PricingBiz, error) {
+	cache, err := do.Invoke[caching.Cache](i)
+	if err != nil {
+		return nil, err
+	}
+
+	roCache, err := do.Invoke[caching.ReadOnlyCache](i)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, err := do.Invoke[PricingRepository](i)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryRepo, err := do.Invoke[TicketCategoryRepository](i)
+	if err != nil {
+		return nil, err
+	}
+
+	seatBiz, err := do.Invoke[seatBusiness.SeatBiz](i)
+	if err != nil {
+		return nil, err
+	}
+
+	redisClient, err := do.InvokeNamed[redis.UniversalClient](i, "redis-db")
+	if err != nil {
+		return nil, err
+	}
+
+	// Weekday, time-of-day and holiday rules are matched in this time zone.
+	location := time.Local
+	if tz := os.Getenv("PRICING_TIMEZONE"); tz != "" {
+		location, err = time.LoadLocation(tz)
+		if err != nil {
+			return nil, fmt.Errorf("invalid PRICING_TIMEZONE: %w", err)
+		}
+	}
+
+	return &business{
+		repository:   repository,
+		categoryRepo: categoryRepo,
+		seatBiz:      seatBiz,
+		cache:        cache,
+		roCache:      roCache,
+		redisClient:  redisClient,
+		location:     location,
+	}, nil
+}
+
+func (b *business) GetPricingRuleById(ctx context.Context, id string) (*entity.PricingRule, error) {
+	rule, err := b.repository.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPricingRuleNotFound
+		}
+		return nil, fmt.Errorf("failed to get pricing rule: %w", err)
+	}
+
+	return rule, nil
+}
+
+func (b *business) GetPricingRules(ctx context.Context, page, size int, ruleType entity.RuleType, isActive *bool) ([]*entity.PricingRule, int, error) {
+	if page < 1 || size < 1 {
+		return nil, 0, ErrInvalidPricingRuleData
+	}
+
+	offset := (page - 1) * size
+
+	rules, err := b.repository.GetMany(ctx, size, offset, ruleType, isActive)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get pricing rules: %w", err)
+	}
+
+	total, err := b.repository.GetTotalCount(ctx, ruleType, isActive)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get total count: %w", err)
+	}
+
+	return rules, total, nil
+}
+
+func (b *business) CreatePricingRule(ctx context.Context, rule *entity.PricingRule) error {
+	if rule == nil || !rule.IsValid() {
+		return ErrInvalidPricingRuleData
+	}
+
+	if err := b.repository.Create(ctx, rule); err != nil {
+		return fmt.Errorf("failed to create pricing rule: %w", err)
+	}
+
+	_ = b.cache.Delete(ctx, keyActivePricingRules)
+
+	return nil
+}
+
+func (b *business) UpdatePricingRule(ctx context.Context, id string, updates *entity.UpdatePricingRuleRequest) error {
+	if id == "" || updates == nil {
+		return ErrInvalidPricingRuleData
+	}
+
+	rule, err := b.GetPricingRuleById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if updates.Name != nil {
+		rule.Name = *updates.Name
+	}
+	if updates.Formats != nil {
+		rule.Formats = updates.Formats
+	}
+	if updates.RoomTypes != nil {
+		rule.RoomTypes = updates.RoomTypes
+	}
+	if updates.Weekdays != nil {
+		rule.Weekdays = updates.Weekdays
+	}
+	if updates.StartTime != nil {
+		rule.StartTime = *updates.StartTime
+	}
+	if updates.EndTime != nil {
+		rule.EndTime = *updates.EndTime
+	}
+	if updates.HolidayDates != nil {
+		rule.HolidayDates = updates.HolidayDates
+	}
+	if updates.MinOccupancy != nil {
+		rule.MinOccupancy = *updates.MinOccupancy
+	}
+	if updates.AdjustmentType != nil {
+		rule.AdjustmentType = *updates.AdjustmentType
+	}
+	if updates.AdjustmentValue != nil {
+		rule.AdjustmentValue = *updates.AdjustmentValue
+	}
+	if updates.Priority != nil {
+		rule.Priority = *updates.Priority
+	}
+	if updates.IsActive != nil {
+		rule.IsActive = *updates.IsActive
+	}
+
+	if !rule.IsValid() {
+		return ErrInvalidPricingRuleData
+	}
+
+	if err = b.repository.Update(ctx, rule); err != nil {
+		return fmt.Errorf("failed to update pricing rule: %w", err)
+	}
+
+	_ = b.cache.Delete(ctx, keyActivePricingRules)
+
+	return nil
+}
+
+func (b *business) DeletePricingRule(ctx context.Context, id string) error {
+	if _, err := b.GetPricingRuleById(ctx, id); err != nil {
+		return err
+	}
+
+	if err := b.repository.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete pricing rule: %w", err)
+	}
+
+	_ = b.cache.Delete(ctx, keyActivePricingRules)
+
+	return nil
+}
+
+// QuoteSeatPrices prices the seats of a showtime, each for its ticket
+// category if categories has one. With a ttl the prices are stored as a
+// quote; passing its id back returns the same prices until it expires, and a
+// ttl re-arms it. An expired or unknown quote is priced again.
+func (b *business) QuoteSeatPrices(ctx context.Context, showtime *showtimeEntity.Showtime, seats []*seatEntity.Seat, categories map[string]string, quoteId string, ttl time.Duration) (*entity.PriceQuote, error) {
+	if ttl > maxQuoteTTL {
+		ttl = maxQuoteTTL
+	}
+
+	if quoteId != "" {
+		quote, err := b.getPriceQuote(ctx, quoteId)
+		if err != nil {
+			return nil, err
+		}
+		if quote != nil {
+			if !quoteMatches(quote, showtime.Id, seats) {
+				return nil, ErrQuoteMismatch
+			}
+			if ttl > 0 {
+				if err = b.savePriceQuote(ctx, quote, ttl); err != nil {
+					return nil, err
+				}
+			}
+			return b.applyTicketCategories(ctx, quote, seats, categories)
+		}
+	}
+
+	quote, err := b.priceSeats(ctx, showtime, seats)
+	if err != nil {
+		return nil, err
+	}
+
+	if ttl > 0 {
+		quote.Id = uuid.New().String()
+		if err = b.savePriceQuote(ctx, quote, ttl); err != nil {
+			return nil, err
+		}
+	}
+
+	return b.applyTicketCategories(ctx, quote, seats, categories)
+}
+
+// applyTicketCategories returns a copy of the quote with each seat priced for
+// its ticket category. Seats without a category keep the quoted price.
+func (b *business) applyTicketCategories(ctx context.Context, quote *entity.PriceQuote, seats []*seatEntity.Seat, categories map[string]string) (*entity.PriceQuote, error) {
+	if len(categories) == 0 {
+		return quote, nil
+	}
+
+	active, err := b.getActiveTicketCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	seatTypes := make(map[string]seatEntity.SeatType, len(seats))
+	for _, seat := range seats {
+		seatTypes[seat.Id] = seat.SeatType
+	}
+
+	priced := *quote
+	priced.Seats = make([]entity.SeatPrice, 0, len(quote.Seats))
+	priced.TotalAmount = 0
+	for _, seatPrice := range quote.Seats {
+		if code := strings.ToUpper(strings.TrimSpace(categories[seatPrice.SeatId])); code != "" {
+			category, ok := active[code]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s", ErrTicketCategoryNotFound, code)
+			}
+
+			seatType := string(seatTypes[seatPrice.SeatId])
+			if len(category.SeatTypes) > 0 && !containsFold(category.SeatTypes, seatType) {
+				return nil, fmt.Errorf("%w: %s on a %s seat", ErrTicketCategoryNotAllowed, code, seatType)
+			}
+
+			seatPrice = applyTicketCategory(seatPrice, category)
+		}
+
+		priced.Seats = append(priced.Seats, seatPrice)
+		priced.TotalAmount += seatPrice.Price
+	}
+	priced.TotalAmount = roundPrice(priced.TotalAmount)
+
+	return &priced, nil
+}
+
+func (b *business) priceSeats(ctx context.Context, showtime *showtimeEntity.Showtime, seats []*seatEntity.Seat) (*entity.PriceQuote, error) {
+	rules, err := b.getActiveRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pctx := entity.PricingContext{
+		Format:    string(showtime.Format),
+		StartTime: showtime.StartTime.In(b.location),
+	}
+	if showtime.Room != nil {
+		pctx.RoomType = showtime.Room.RoomType
+	}
+
+	if hasRuleType(rules, entity.RuleTypeOccupancy) && showtime.Room != nil && showtime.Room.Capacity > 0 {
+		locked, err := b.seatBiz.GetLockedSeatsByShowtime(ctx, showtime.Id)
+		if err != nil {
+			return nil, err
+		}
+		taken := len(locked.LockedSeatIds) + len(locked.BookedSeatIds)
+		pctx.Occupancy = float64(taken) * 100 / float64(showtime.Room.Capacity)
+	}
+
+	matched := matchingRules(rules, pctx)
+
+	quote := &entity.PriceQuote{
+		ShowtimeId: showtime.Id,
+		Seats:      make([]entity.SeatPrice, 0, len(seats)),
+	}
+	for _, seat := range seats {
+		seatPrice := priceSeat(seat, showtime.BasePrice, matched)
+		quote.Seats = append(quote.Seats, seatPrice)
+		quote.TotalAmount += seatPrice.Price
+	}
+	quote.TotalAmount = roundPrice(quote.TotalAmount)
+
+	return quote, nil
+}
+
+func (b *business) getActiveRules(ctx context.Context) ([]*entity.PricingRule, error) {
+	callback := func() ([]*entity.PricingRule, error) {
+		return b.repository.GetActive(ctx)
+	}
+
+	rules, err := caching.UseCacheWithRO(ctx, b.roCache, b.cache, keyActivePricingRules, CACHE_TTL_5_MINS, callback)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pricing rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+func (b *business) getPriceQuote(ctx context.Context, id string) (*entity.PriceQuote, error) {
+	data, err := b.redisClient.Get(ctx, keyPriceQuote(id)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get price quote: %w", err)
+	}
+
+	quote := new(entity.PriceQuote)
+	if err = json.Unmarshal(data, quote); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal price quote: %w", err)
+	}
+
+	return quote, nil
+}
+
+func (b *business) savePriceQuote(ctx context.Context, quote *entity.PriceQuote, ttl time.Duration) error {
+	quote.ExpiresAt = time.Now().Add(ttl)
+
+	data, err := json.Marshal(quote)
+	if err != nil {
+		return fmt.Errorf("failed to marshal price quote: %w", err)
+	}
+
+	if err = b.redisClient.Set(ctx, keyPriceQuote(quote.Id), data, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to save price quote: %w", err)
+	}
+
+	return nil
+}
+
+func quoteMatches(quote *entity.PriceQuote, showtimeId string, seats []*seatEntity.Seat) bool {
+	if quote.ShowtimeId != showtimeId || len(quote.Seats) != len(seats) {
+		return false
+	}
+
+	quoted := make([]string, 0, len(quote.Seats))
+	for _, seat := range quote.Seats {
+		quoted = append(quoted, seat.SeatId)
+	}
+	for _, seat := range seats {
+		if !slices.Contains(quoted, seat.Id) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,20 @@
+package business
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	keyActivePricingRules     = "pricing:rules:active"
+	keyActiveTicketCategories = "pricing:ticket_categories:active"
+
+	CACHE_TTL_5_MINS = 5 * time.Minute
+
+	// maxQuoteTTL caps how long a caller can freeze prices for.
+	maxQuoteTTL = time.Hour
+)
+
+func keyPriceQuote(id string) string {
+	return fmt.Sprintf("price_quote:%s", id)
+}
@@ -0,0 +1,191 @@
+package business
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"movie-service/internal/module/pricing/entity"
+	seatEntity "movie-service/internal/module/seat/entity"
+)
+
+// matchingRules picks, per rule type, the active rule that applies to the
+// showtime: the highest priority wins, then the highest occupancy tier, then
+// the oldest rule. The result is in entity.RuleTypeOrder.
+func matchingRules(rules []*entity.PricingRule, pctx entity.PricingContext) []*entity.PricingRule {
+	candidates := make([]*entity.PricingRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.IsActive && ruleMatches(rule, pctx) {
+			candidates = append(candidates, rule)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.Priority != b.Priority {
+			return a.Priority > b.Priority
+		}
+		if a.MinOccupancy != b.MinOccupancy {
+			return a.MinOccupancy > b.MinOccupancy
+		}
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.Before(b.CreatedAt)
+		}
+		return a.Id < b.Id
+	})
+
+	matched := make([]*entity.PricingRule, 0, len(entity.RuleTypeOrder))
+	for _, ruleType := range entity.RuleTypeOrder {
+		for _, rule := range candidates {
+			if rule.RuleType == ruleType {
+				matched = append(matched, rule)
+				break
+			}
+		}
+	}
+
+	return matched
+}
+
+func ruleMatches(rule *entity.PricingRule, pctx entity.PricingContext) bool {
+	switch rule.RuleType {
+	case entity.RuleTypeFormat:
+		return containsFold(rule.Formats, pctx.Format)
+	case entity.RuleTypeRoomType:
+		return containsFold(rule.RoomTypes, pctx.RoomType)
+	case entity.RuleTypeWeekday:
+		return slices.Contains(rule.Weekdays, int(pctx.StartTime.Weekday()))
+	case entity.RuleTypeTimeOfDay:
+		start, okStart := clockMinutes(rule.StartTime)
+		end, okEnd := clockMinutes(rule.EndTime)
+		if !okStart || !okEnd {
+			return false
+		}
+		minute := pctx.StartTime.Hour()*60 + pctx.StartTime.Minute()
+		if start < end {
+			return minute >= start && minute < end
+		}
+		// The band wraps past midnight, e.g. 22:00-02:00.
+		return minute >= start || minute < end
+	case entity.RuleTypeHoliday:
+		return slices.Contains(rule.HolidayDates, pctx.StartTime.Format("2006-01-02"))
+	case entity.RuleTypeOccupancy:
+		return pctx.Occupancy >= rule.MinOccupancy
+	default:
+		return false
+	}
+}
+
+// priceSeat prices a seat from the showtime base price, its seat type and the
+// matched rules, applied in order. Each step is rounded so the breakdown adds
+// up to the final price, which never goes below zero.
+func priceSeat(seat *seatEntity.Seat, showtimePrice float64, rules []*entity.PricingRule) entity.SeatPrice {
+	basePrice := roundPrice(seat.CalculatePrice(showtimePrice))
+
+	price := basePrice
+	adjustments := make([]entity.PriceAdjustment, 0, len(rules))
+	for _, rule := range rules {
+		next := price
+		switch rule.AdjustmentType {
+		case entity.AdjustmentTypeMultiplier:
+			next = roundPrice(price * rule.AdjustmentValue)
+		case entity.AdjustmentTypeFixed:
+			next = roundPrice(price + rule.AdjustmentValue)
+		}
+		if next < 0 {
+			next = 0
+		}
+
+		adjustments = append(adjustments, entity.PriceAdjustment{
+			RuleId:         rule.Id,
+			RuleName:       rule.Name,
+			RuleType:       rule.RuleType,
+			AdjustmentType: rule.AdjustmentType,
+			Value:          rule.AdjustmentValue,
+			Amount:         roundPrice(next - price),
+		})
+		price = next
+	}
+
+	return entity.SeatPrice{
+		SeatId:      seat.Id,
+		BasePrice:   basePrice,
+		Price:       price,
+		Adjustments: adjustments,
+	}
+}
+
+// applyTicketCategory adjusts a priced seat for its ticket category, as the
+// last step of its breakdown.
+func applyTicketCategory(seatPrice entity.SeatPrice, category *entity.TicketCategory) entity.SeatPrice {
+	next := seatPrice.Price
+	switch category.AdjustmentType {
+	case entity.AdjustmentTypeMultiplier:
+		next = roundPrice(seatPrice.Price * category.AdjustmentValue)
+	case entity.AdjustmentTypeFixed:
+		next = roundPrice(seatPrice.Price + category.AdjustmentValue)
+	}
+	if next < 0 {
+		next = 0
+	}
+
+	adjustments := make([]entity.PriceAdjustment, 0, len(seatPrice.Adjustments)+1)
+	adjustments = append(adjustments, seatPrice.Adjustments...)
+	adjustments = append(adjustments, entity.PriceAdjustment{
+		RuleId:         category.Id,
+		RuleName:       category.Name,
+		RuleType:       entity.RuleTypeTicketCategory,
+		AdjustmentType: category.AdjustmentType,
+		Value:          category.AdjustmentValue,
+		Amount:         roundPrice(next - seatPrice.Price),
+	})
+
+	seatPrice.Price = next
+	seatPrice.Adjustments = adjustments
+	seatPrice.TicketCategory = category.Code
+	seatPrice.RequiresIdCheck = category.RequiresIdCheck
+	seatPrice.MinAge = category.MinAge
+	seatPrice.MaxAge = category.MaxAge
+
+	return seatPrice
+}
+
+func hasRuleType(rules []*entity.PricingRule, ruleType entity.RuleType) bool {
+	for _, rule := range rules {
+		if rule.IsActive && rule.RuleType == ruleType {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func clockMinutes(clock string) (int, bool) {
+	parts := strings.Split(clock, ":")
+	if len(parts) != 2 {
+		return 0, false
+	}
+	hour, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, false
+	}
+	minute, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, false
+	}
+	return hour*60 + minute, true
+}
+
+func roundPrice(price float64) float64 {
+	return math.Round(price*100) / 100
+}
@@ -0,0 +1,196 @@
+package business
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"movie-service/internal/module/pricing/entity"
+	seatEntity "movie-service/internal/module/seat/entity"
+)
+
+func ruleIds(rules []*entity.PricingRule) []string {
+	ids := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		ids = append(ids, rule.Id)
+	}
+	return ids
+}
+
+func TestMatchingRules(t *testing.T) {
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// 2026-10-16 is a Friday.
+	pctx := entity.PricingContext{
+		Format:    "IMAX",
+		RoomType:  "PREMIUM",
+		StartTime: time.Date(2026, 10, 16, 23, 30, 0, 0, time.UTC),
+		Occupancy: 80,
+	}
+
+	rule := func(id string, ruleType entity.RuleType, priority int) *entity.PricingRule {
+		return &entity.PricingRule{Id: id, RuleType: ruleType, Priority: priority, IsActive: true, CreatedAt: created}
+	}
+	format := func(id string, priority int, formats ...string) *entity.PricingRule {
+		r := rule(id, entity.RuleTypeFormat, priority)
+		r.Formats = formats
+		return r
+	}
+	timeOfDay := func(id, start, end string) *entity.PricingRule {
+		r := rule(id, entity.RuleTypeTimeOfDay, 0)
+		r.StartTime, r.EndTime = start, end
+		return r
+	}
+	occupancy := func(id string, minOccupancy float64) *entity.PricingRule {
+		r := rule(id, entity.RuleTypeOccupancy, 0)
+		r.MinOccupancy = minOccupancy
+		return r
+	}
+
+	inactive := format("inactive", 10, "IMAX")
+	inactive.IsActive = false
+
+	older := format("older", 0, "IMAX")
+	newer := format("newer", 0, "IMAX")
+	newer.CreatedAt = created.Add(time.Hour)
+
+	weekend := rule("weekend", entity.RuleTypeWeekday, 0)
+	weekend.Weekdays = []int{0, 6}
+	friday := rule("friday", entity.RuleTypeWeekday, 0)
+	friday.Weekdays = []int{5}
+
+	holiday := rule("holiday", entity.RuleTypeHoliday, 0)
+	holiday.HolidayDates = []string{"2026-10-16"}
+
+	premium := rule("premium", entity.RuleTypeRoomType, 0)
+	premium.RoomTypes = []string{"premium"}
+
+	tests := []struct {
+		name    string
+		rules   []*entity.PricingRule
+		pctx    entity.PricingContext
+		wantIds []string
+	}{
+		{name: "no rules", pctx: pctx, wantIds: []string{}},
+		{name: "inactive rule skipped", rules: []*entity.PricingRule{inactive, format("active", 0, "IMAX")}, pctx: pctx, wantIds: []string{"active"}},
+		{name: "format ignores case", rules: []*entity.PricingRule{format("imax", 0, "imax")}, pctx: pctx, wantIds: []string{"imax"}},
+		{name: "other format", rules: []*entity.PricingRule{format("2d", 0, "2D")}, pctx: pctx, wantIds: []string{}},
+		{name: "highest priority wins", rules: []*entity.PricingRule{format("low", 1, "IMAX"), format("high", 5, "IMAX")}, pctx: pctx, wantIds: []string{"high"}},
+		{name: "oldest wins a priority tie", rules: []*entity.PricingRule{newer, older}, pctx: pctx, wantIds: []string{"older"}},
+		{
+			name:    "highest occupancy tier reached wins",
+			rules:   []*entity.PricingRule{occupancy("half", 50), occupancy("three-quarters", 75), occupancy("ninety", 90)},
+			pctx:    pctx,
+			wantIds: []string{"three-quarters"},
+		},
+		{
+			name:    "one rule per type in application order",
+			rules:   []*entity.PricingRule{occupancy("busy", 50), holiday, friday, premium, format("imax", 0, "IMAX")},
+			pctx:    pctx,
+			wantIds: []string{"imax", "premium", "friday", "holiday", "busy"},
+		},
+		{name: "other weekday", rules: []*entity.PricingRule{weekend}, pctx: pctx, wantIds: []string{}},
+		{name: "evening window missed", rules: []*entity.PricingRule{timeOfDay("evening", "18:00", "22:00")}, pctx: pctx, wantIds: []string{}},
+		{name: "window end is exclusive", rules: []*entity.PricingRule{timeOfDay("late", "20:00", "23:30")}, pctx: pctx, wantIds: []string{}},
+		{name: "window start is inclusive", rules: []*entity.PricingRule{timeOfDay("night", "23:30", "23:59")}, pctx: pctx, wantIds: []string{"night"}},
+		{name: "window past midnight before it", rules: []*entity.PricingRule{timeOfDay("owl", "22:00", "02:00")}, pctx: pctx, wantIds: []string{"owl"}},
+		{
+			name:    "window past midnight after it",
+			rules:   []*entity.PricingRule{timeOfDay("owl", "22:00", "02:00")},
+			pctx:    entity.PricingContext{StartTime: time.Date(2026, 10, 17, 1, 0, 0, 0, time.UTC)},
+			wantIds: []string{"owl"},
+		},
+		{
+			name:    "malformed window",
+			rules:   []*entity.PricingRule{timeOfDay("broken", "22", "02:00")},
+			pctx:    pctx,
+			wantIds: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ruleIds(matchingRules(tt.rules, tt.pctx))
+			if !slices.Equal(got, tt.wantIds) {
+				t.Errorf("Expected %v, got %v", tt.wantIds, got)
+			}
+		})
+	}
+}
+
+func TestPriceSeat(t *testing.T) {
+	multiplier := func(value float64) *entity.PricingRule {
+		return &entity.PricingRule{Id: "multiplier", AdjustmentType: entity.AdjustmentTypeMultiplier, AdjustmentValue: value}
+	}
+	fixed := func(value float64) *entity.PricingRule {
+		return &entity.PricingRule{Id: "fixed", AdjustmentType: entity.AdjustmentTypeFixed, AdjustmentValue: value}
+	}
+
+	tests := []struct {
+		name            string
+		seatType        seatEntity.SeatType
+		showtimePrice   float64
+		rules           []*entity.PricingRule
+		wantBasePrice   float64
+		wantPrice       float64
+		wantAdjustments []float64
+	}{
+		{name: "no rules", seatType: seatEntity.SeatTypeRegular, showtimePrice: 100, wantBasePrice: 100, wantPrice: 100, wantAdjustments: []float64{}},
+		{name: "seat type multiplier", seatType: seatEntity.SeatTypeVIP, showtimePrice: 100, wantBasePrice: 150, wantPrice: 150, wantAdjustments: []float64{}},
+		{
+			name:            "rules applied in order",
+			seatType:        seatEntity.SeatTypeVIP,
+			showtimePrice:   100,
+			rules:           []*entity.PricingRule{multiplier(1.2), fixed(-20)},
+			wantBasePrice:   150,
+			wantPrice:       160,
+			wantAdjustments: []float64{30, -20},
+		},
+		{
+			name:            "order changes the result",
+			seatType:        seatEntity.SeatTypeVIP,
+			showtimePrice:   100,
+			rules:           []*entity.PricingRule{fixed(-20), multiplier(1.2)},
+			wantBasePrice:   150,
+			wantPrice:       156,
+			wantAdjustments: []float64{-20, 26},
+		},
+		{
+			name:            "each step rounded",
+			seatType:        seatEntity.SeatTypeRegular,
+			showtimePrice:   33.33,
+			rules:           []*entity.PricingRule{multiplier(1.1)},
+			wantBasePrice:   33.33,
+			wantPrice:       36.66,
+			wantAdjustments: []float64{3.33},
+		},
+		{
+			name:            "never below zero",
+			seatType:        seatEntity.SeatTypeRegular,
+			showtimePrice:   100,
+			rules:           []*entity.PricingRule{fixed(-150), fixed(10)},
+			wantBasePrice:   100,
+			wantPrice:       10,
+			wantAdjustments: []float64{-100, 10},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seat := &seatEntity.Seat{Id: "seat-1", SeatType: tt.seatType}
+			price := priceSeat(seat, tt.showtimePrice, tt.rules)
+
+			if price.BasePrice != tt.wantBasePrice || price.Price != tt.wantPrice {
+				t.Fatalf("Expected base %.2f and price %.2f, got %.2f and %.2f", tt.wantBasePrice, tt.wantPrice, price.BasePrice, price.Price)
+			}
+
+			amounts := make([]float64, 0, len(price.Adjustments))
+			for _, adjustment := range price.Adjustments {
+				amounts = append(amounts, adjustment.Amount)
+			}
+			if !slices.Equal(amounts, tt.wantAdjustments) {
+				t.Errorf("Expected adjustments %v, got %v", tt.wantAdjustments, amounts)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package business
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"movie-service/internal/module/pricing/entity"
+	"movie-service/internal/pkg/caching"
+)
+
+func (b *business) GetTicketCategoryById(ctx context.Context, id string) (*entity.TicketCategory, error) {
+	category, err := b.categoryRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTicketCategoryNotFound
+		}
+		return nil, fmt.Errorf("failed to get ticket category: %w", err)
+	}
+
+	return category, nil
+}
+
+func (b *business) GetTicketCategories(ctx context.Context, isActive *bool) ([]*entity.TicketCategory, error) {
+	categories, err := b.categoryRepo.GetMany(ctx, isActive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket categories: %w", err)
+	}
+
+	return categories, nil
+}
+
+func (b *business) CreateTicketCategory(ctx context.Context, category *entity.TicketCategory) error {
+	if category == nil {
+		return ErrInvalidTicketCategoryData
+	}
+
+	category.Code = strings.ToUpper(strings.TrimSpace(category.Code))
+	if !category.IsValid() {
+		return ErrInvalidTicketCategoryData
+	}
+
+	exists, err := b.categoryRepo.ExistsByCode(ctx, category.Code, "")
+	if err != nil {
+		return err
+	}
+	if exists {
+		return ErrTicketCategoryCodeExists
+	}
+
+	if err = b.categoryRepo.Create(ctx, category); err != nil {
+		return fmt.Errorf("failed to create ticket category: %w", err)
+	}
+
+	_ = b.cache.Delete(ctx, keyActiveTicketCategories)
+
+	return nil
+}
+
+func (b *business) UpdateTicketCategory(ctx context.Context, id string, updates *entity.UpdateTicketCategoryRequest) error {
+	if id == "" || updates == nil {
+		return ErrInvalidTicketCategoryData
+	}
+
+	category, err := b.GetTicketCategoryById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if updates.Name != nil {
+		category.Name = *updates.Name
+	}
+	if updates.Description != nil {
+		category.Description = *updates.Description
+	}
+	if updates.AdjustmentType != nil {
+		category.AdjustmentType = *updates.AdjustmentType
+	}
+	if updates.AdjustmentValue != nil {
+		category.AdjustmentValue = *updates.AdjustmentValue
+	}
+	if updates.MinAge != nil {
+		category.MinAge = *updates.MinAge
+	}
+	if updates.MaxAge != nil {
+		category.MaxAge = *updates.MaxAge
+	}
+	if updates.SeatTypes != nil {
+		category.SeatTypes = updates.SeatTypes
+	}
+	if updates.RequiresIdCheck != nil {
+		category.RequiresIdCheck = *updates.RequiresIdCheck
+	}
+	if updates.IsActive != nil {
+		category.IsActive = *updates.IsActive
+	}
+
+	if !category.IsValid() {
+		return ErrInvalidTicketCategoryData
+	}
+
+	if err = b.categoryRepo.Update(ctx, category); err != nil {
+		return fmt.Errorf("failed to update ticket category: %w", err)
+	}
+
+	_ = b.cache.Delete(ctx, keyActiveTicketCategories)
+
+	return nil
+}
+
+func (b *business) DeleteTicketCategory(ctx context.Context, id string) error {
+	if _, err := b.GetTicketCategoryById(ctx, id); err != nil {
+		return err
+	}
+
+	if err := b.categoryRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete ticket category: %w", err)
+	}
+
+	_ = b.cache.Delete(ctx, keyActiveTicketCategories)
+
+	return nil
+}
+
+// getActiveTicketCategories returns the active categories keyed by code.
+func (b *business) getActiveTicketCategories(ctx context.Context) (map[string]*entity.TicketCategory, error) {
+	isActive := true
+	callback := func() ([]*entity.TicketCategory, error) {
+		return b.categoryRepo.GetMany(ctx, &isActive)
+	}
+
+	categories, err := caching.UseCacheWithRO(ctx, b.roCache, b.cache, keyActiveTicketCategories, CACHE_TTL_5_MINS, callback)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket categories: %w", err)
+	}
+
+	byCode := make(map[string]*entity.TicketCategory, len(categories))
+	for _, category := range categories {
+		byCode[category.Code] = category
+	}
+
+	return byCode, nil
+}
@@ -0,0 +1,120 @@
+package entity
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type RuleType string
+
+const (
+	RuleTypeFormat    RuleType = "FORMAT"
+	RuleTypeRoomType  RuleType = "ROOM_TYPE"
+	RuleTypeWeekday   RuleType = "WEEKDAY"
+	RuleTypeTimeOfDay RuleType = "TIME_OF_DAY"
+	RuleTypeHoliday   RuleType = "HOLIDAY"
+	RuleTypeOccupancy RuleType = "OCCUPANCY"
+)
+
+// RuleTypeOrder is the order in which matched rules are applied to a price.
+var RuleTypeOrder = []RuleType{
+	RuleTypeFormat,
+	RuleTypeRoomType,
+	RuleTypeWeekday,
+	RuleTypeTimeOfDay,
+	RuleTypeHoliday,
+	RuleTypeOccupancy,
+}
+
+type AdjustmentType string
+
+const (
+	AdjustmentTypeMultiplier AdjustmentType = "MULTIPLIER"
+	AdjustmentTypeFixed      AdjustmentType = "FIXED"
+)
+
+var (
+	clockPattern = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+	datePattern  = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+)
+
+// PricingRule adjusts seat prices of the showtimes it matches. Only the
+// condition fields of its rule type are used: Formats for FORMAT, RoomTypes
+// for ROOM_TYPE, Weekdays (0 = Sunday) for WEEKDAY, StartTime and EndTime
+// ("15:04", may wrap past midnight) for TIME_OF_DAY, HolidayDates
+// ("2006-01-02") for HOLIDAY and MinOccupancy (percent of the room) for
+// OCCUPANCY.
+type PricingRule struct {
+	bun.BaseModel `bun:"table:pricing_rules,alias:pru"`
+
+	Id              string         `bun:"id,pk" json:"id"`
+	Name            string         `bun:"name,notnull" json:"name"`
+	RuleType        RuleType       `bun:"rule_type,notnull" json:"rule_type"`
+	Formats         []string       `bun:"formats,array" json:"formats"`
+	RoomTypes       []string       `bun:"room_types,array" json:"room_types"`
+	Weekdays        []int          `bun:"weekdays,array" json:"weekdays"`
+	StartTime       string         `bun:"start_time" json:"start_time,omitempty"`
+	EndTime         string         `bun:"end_time" json:"end_time,omitempty"`
+	HolidayDates    []string       `bun:"holiday_dates,array" json:"holiday_dates"`
+	MinOccupancy    float64        `bun:"min_occupancy,notnull,default:0,type:decimal(5,2)" json:"min_occupancy"`
+	AdjustmentType  AdjustmentType `bun:"adjustment_type,notnull" json:"adjustment_type"`
+	AdjustmentValue float64        `bun:"adjustment_value,notnull,type:decimal(12,4)" json:"adjustment_value"`
+	Priority        int            `bun:"priority,notnull,default:0" json:"priority"`
+	IsActive        bool           `bun:"is_active,notnull,default:true" json:"is_active"`
+	CreatedAt       time.Time      `bun:"created_at,nullzero,default:current_timestamp" json:"created_at"`
+	UpdatedAt       *time.Time     `bun:"updated_at" json:"updated_at,omitempty"`
+}
+
+func (r *PricingRule) IsValid() bool {
+	if r.Name == "" {
+		return false
+	}
+
+	switch r.AdjustmentType {
+	case AdjustmentTypeMultiplier:
+		if r.AdjustmentValue <= 0 {
+			return false
+		}
+	case AdjustmentTypeFixed:
+	default:
+		return false
+	}
+
+	switch r.RuleType {
+	case RuleTypeFormat:
+		return len(r.Formats) > 0
+	case RuleTypeRoomType:
+		return len(r.RoomTypes) > 0
+	case RuleTypeWeekday:
+		if len(r.Weekdays) == 0 {
+			return false
+		}
+		for _, weekday := range r.Weekdays {
+			if weekday < 0 || weekday > 6 {
+				return false
+			}
+		}
+		return true
+	case RuleTypeTimeOfDay:
+		return clockPattern.MatchString(r.StartTime) && clockPattern.MatchString(r.EndTime) && r.StartTime != r.EndTime
+	case RuleTypeHoliday:
+		if len(r.HolidayDates) == 0 {
+			return false
+		}
+		for _, date := range r.HolidayDates {
+			if !datePattern.MatchString(date) {
+				return false
+			}
+			if _, err := time.Parse("2006-01-02", date); err != nil {
+				return false
+			}
+		}
+		return true
+	case RuleTypeOccupancy:
+		return r.MinOccupancy > 0 && r.MinOccupancy <= 100
+	default:
+		return false
+	}
+}
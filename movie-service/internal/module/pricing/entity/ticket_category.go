@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// RuleTypeTicketCategory marks the price adjustment of a seat's ticket
+// category. It is applied after every pricing rule.
+const RuleTypeTicketCategory RuleType = "TICKET_CATEGORY"
+
+// TicketCategory is a concession ticket such as CHILD or STUDENT. SeatTypes
+// limits the seats it can be sold for (empty means all); the age range and
+// ID check are enforced at the door.
+type TicketCategory struct {
+	bun.BaseModel `bun:"table:ticket_categories,alias:tc"`
+
+	Id              string         `bun:"id,pk" json:"id"`
+	Code            string         `bun:"code,notnull,unique" json:"code"`
+	Name            string         `bun:"name,notnull" json:"name"`
+	Description     string         `bun:"description" json:"description,omitempty"`
+	AdjustmentType  AdjustmentType `bun:"adjustment_type,notnull" json:"adjustment_type"`
+	AdjustmentValue float64        `bun:"adjustment_value,notnull,type:decimal(12,4)" json:"adjustment_value"`
+	MinAge          int            `bun:"min_age,notnull,default:0" json:"min_age"`
+	MaxAge          int            `bun:"max_age,notnull,default:0" json:"max_age"`
+	SeatTypes       []string       `bun:"seat_types,array" json:"seat_types"`
+	RequiresIdCheck bool           `bun:"requires_id_check,notnull,default:false" json:"requires_id_check"`
+	IsActive        bool           `bun:"is_active,notnull,default:true" json:"is_active"`
+	CreatedAt       time.Time      `bun:"created_at,nullzero,default:current_timestamp" json:"created_at"`
+	UpdatedAt       *time.Time     `bun:"updated_at" json:"updated_at,omitempty"`
+}
+
+func (c *TicketCategory) IsValid() bool {
+	if c.Code == "" || c.Name == "" {
+		return false
+	}
+
+	if c.MinAge < 0 || c.MaxAge < 0 || (c.MaxAge > 0 && c.MaxAge < c.MinAge) {
+		return false
+	}
+
+	switch c.AdjustmentType {
+	case AdjustmentTypeMultiplier:
+		return c.AdjustmentValue > 0
+	case AdjustmentTypeFixed:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,173 @@
+package entity
+
+import (
+	"time"
+
+	"movie-service/internal/pkg/paging"
+)
+
+type CreatePricingRuleRequest struct {
+	Name            string         `json:"name" binding:"required"`
+	RuleType        RuleType       `json:"rule_type" binding:"required"`
+	Formats         []string       `json:"formats"`
+	RoomTypes       []string       `json:"room_types"`
+	Weekdays        []int          `json:"weekdays"`
+	StartTime       string         `json:"start_time"`
+	EndTime         string         `json:"end_time"`
+	HolidayDates    []string       `json:"holiday_dates"`
+	MinOccupancy    float64        `json:"min_occupancy" binding:"min=0,max=100"`
+	AdjustmentType  AdjustmentType `json:"adjustment_type" binding:"required"`
+	AdjustmentValue float64        `json:"adjustment_value"`
+	Priority        int            `json:"priority"`
+	IsActive        *bool          `json:"is_active,omitempty"`
+}
+
+type UpdatePricingRuleRequest struct {
+	Name            *string         `json:"name,omitempty"`
+	Formats         []string        `json:"formats,omitempty"`
+	RoomTypes       []string        `json:"room_types,omitempty"`
+	Weekdays        []int           `json:"weekdays,omitempty"`
+	StartTime       *string         `json:"start_time,omitempty"`
+	EndTime         *string         `json:"end_time,omitempty"`
+	HolidayDates    []string        `json:"holiday_dates,omitempty"`
+	MinOccupancy    *float64        `json:"min_occupancy,omitempty" binding:"omitempty,min=0,max=100"`
+	AdjustmentType  *AdjustmentType `json:"adjustment_type,omitempty"`
+	AdjustmentValue *float64        `json:"adjustment_value,omitempty"`
+	Priority        *int            `json:"priority,omitempty"`
+	IsActive        *bool           `json:"is_active,omitempty"`
+}
+
+type GetPricingRulesQuery struct {
+	Page     int      `form:"page,default=1" binding:"min=1"`
+	Size     int      `form:"size,default=10" binding:"min=1,max=100"`
+	RuleType RuleType `form:"rule_type"`
+	IsActive *bool    `form:"is_active"`
+}
+
+type PricingRulesResponse struct {
+	Data   []*PricingRule   `json:"data"`
+	Paging *paging.PageInfo `json:"paging"`
+}
+
+func ToPricingRulesResponse(rules []*PricingRule, page, size, total int) *PricingRulesResponse {
+	return &PricingRulesResponse{
+		Data:   rules,
+		Paging: paging.NewPageInfo(page, size, total),
+	}
+}
+
+func (req *CreatePricingRuleRequest) ToPricingRule() *PricingRule {
+	isActive := true
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+
+	return &PricingRule{
+		Name:            req.Name,
+		RuleType:        req.RuleType,
+		Formats:         req.Formats,
+		RoomTypes:       req.RoomTypes,
+		Weekdays:        req.Weekdays,
+		StartTime:       req.StartTime,
+		EndTime:         req.EndTime,
+		HolidayDates:    req.HolidayDates,
+		MinOccupancy:    req.MinOccupancy,
+		AdjustmentType:  req.AdjustmentType,
+		AdjustmentValue: req.AdjustmentValue,
+		Priority:        req.Priority,
+		IsActive:        isActive,
+	}
+}
+
+type CreateTicketCategoryRequest struct {
+	Code            string         `json:"code" binding:"required"`
+	Name            string         `json:"name" binding:"required"`
+	Description     string         `json:"description"`
+	AdjustmentType  AdjustmentType `json:"adjustment_type" binding:"required"`
+	AdjustmentValue float64        `json:"adjustment_value"`
+	MinAge          int            `json:"min_age" binding:"min=0"`
+	MaxAge          int            `json:"max_age" binding:"min=0"`
+	SeatTypes       []string       `json:"seat_types"`
+	RequiresIdCheck bool           `json:"requires_id_check"`
+	IsActive        *bool          `json:"is_active,omitempty"`
+}
+
+type UpdateTicketCategoryRequest struct {
+	Name            *string         `json:"name,omitempty"`
+	Description     *string         `json:"description,omitempty"`
+	AdjustmentType  *AdjustmentType `json:"adjustment_type,omitempty"`
+	AdjustmentValue *float64        `json:"adjustment_value,omitempty"`
+	MinAge          *int            `json:"min_age,omitempty" binding:"omitempty,min=0"`
+	MaxAge          *int            `json:"max_age,omitempty" binding:"omitempty,min=0"`
+	SeatTypes       []string        `json:"seat_types,omitempty"`
+	RequiresIdCheck *bool           `json:"requires_id_check,omitempty"`
+	IsActive        *bool           `json:"is_active,omitempty"`
+}
+
+type GetTicketCategoriesQuery struct {
+	IsActive *bool `form:"is_active"`
+}
+
+func (req *CreateTicketCategoryRequest) ToTicketCategory() *TicketCategory {
+	isActive := true
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+
+	return &TicketCategory{
+		Code:            req.Code,
+		Name:            req.Name,
+		Description:     req.Description,
+		AdjustmentType:  req.AdjustmentType,
+		AdjustmentValue: req.AdjustmentValue,
+		MinAge:          req.MinAge,
+		MaxAge:          req.MaxAge,
+		SeatTypes:       req.SeatTypes,
+		RequiresIdCheck: req.RequiresIdCheck,
+		IsActive:        isActive,
+	}
+}
+
+// PriceAdjustment is one rule's contribution to a seat price. Amount is the
+// change it made to the price.
+type PriceAdjustment struct {
+	RuleId         string         `json:"rule_id"`
+	RuleName       string         `json:"rule_name"`
+	RuleType       RuleType       `json:"rule_type"`
+	AdjustmentType AdjustmentType `json:"adjustment_type"`
+	Value          float64        `json:"value"`
+	Amount         float64        `json:"amount"`
+}
+
+// SeatPrice is the price of one seat. BasePrice is the showtime price scaled
+// by the seat type, before any pricing rule or ticket category.
+type SeatPrice struct {
+	SeatId          string            `json:"seat_id"`
+	BasePrice       float64           `json:"base_price"`
+	Price           float64           `json:"price"`
+	Adjustments     []PriceAdjustment `json:"adjustments"`
+	TicketCategory  string            `json:"ticket_category,omitempty"`
+	RequiresIdCheck bool              `json:"requires_id_check,omitempty"`
+	MinAge          int               `json:"min_age,omitempty"`
+	MaxAge          int               `json:"max_age,omitempty"`
+}
+
+// PriceQuote fixes the prices of a set of seats. A quote with an Id is stored
+// and returned unchanged until ExpiresAt; ticket categories are applied on
+// top of the stored prices each time it is read.
+type PriceQuote struct {
+	Id          string      `json:"id,omitempty"`
+	ShowtimeId  string      `json:"showtime_id"`
+	Seats       []SeatPrice `json:"seats"`
+	TotalAmount float64     `json:"total_amount"`
+	ExpiresAt   time.Time   `json:"expires_at,omitempty"`
+}
+
+// PricingContext is what the pricing rules of a showtime are matched against.
+// StartTime is in the pricing time zone; Occupancy is a percentage.
+type PricingContext struct {
+	Format    string
+	RoomType  string
+	StartTime time.Time
+	Occupancy float64
+}
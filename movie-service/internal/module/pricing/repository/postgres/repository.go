@@ -0,0 +1,168 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"movie-service/internal/module/pricing/business"
+	"movie-service/internal/module/pricing/entity"
+
+	"github.com/google/uuid"
+	"github.com/samber/do"
+	"github.com/uptrace/bun"
+)
+
+type Repository struct {
+	db   *bun.DB
+	roDb *bun.DB
+}
+
+func NewPricingRepository(i *do.Injector) (business.PricingRepository, error) {
+	db, err := do.Invoke[*bun.DB](i)
+	if err != nil {
+		return nil, err
+	}
+
+	roDb, err := do.InvokeNamed[*bun.DB](i, "readonly-db")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Repository{
+		db:   db,
+		roDb: roDb,
+	}, nil
+}
+
+func (r *Repository) Create(ctx context.Context, rule *entity.PricingRule) error {
+	if rule.Id == "" {
+		rule.Id = uuid.New().String()
+	}
+
+	now := time.Now()
+	rule.CreatedAt = now
+	rule.UpdatedAt = &now
+
+	_, err := r.db.NewInsert().Model(rule).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create pricing rule: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.NewDelete().
+		Model((*entity.PricingRule)(nil)).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete pricing rule: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("pricing rule with id %s not found", id)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetByID(ctx context.Context, id string) (*entity.PricingRule, error) {
+	var rule entity.PricingRule
+	err := r.roDb.NewSelect().
+		Model(&rule).
+		Where("id = ?", id).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+func (r *Repository) GetMany(ctx context.Context, limit, offset int, ruleType entity.RuleType, isActive *bool) ([]*entity.PricingRule, error) {
+	query := r.roDb.NewSelect().Model((*entity.PricingRule)(nil))
+
+	if ruleType != "" {
+		query = query.Where("rule_type = ?", ruleType)
+	}
+
+	if isActive != nil {
+		query = query.Where("is_active = ?", *isActive)
+	}
+
+	var rules []*entity.PricingRule
+	err := query.
+		Order("rule_type ASC", "priority DESC", "created_at ASC").
+		Limit(limit).
+		Offset(offset).
+		Scan(ctx, &rules)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pricing rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+func (r *Repository) GetTotalCount(ctx context.Context, ruleType entity.RuleType, isActive *bool) (int, error) {
+	query := r.roDb.NewSelect().Model((*entity.PricingRule)(nil))
+
+	if ruleType != "" {
+		query = query.Where("rule_type = ?", ruleType)
+	}
+
+	if isActive != nil {
+		query = query.Where("is_active = ?", *isActive)
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get total count: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *Repository) GetActive(ctx context.Context) ([]*entity.PricingRule, error) {
+	rules := make([]*entity.PricingRule, 0)
+	err := r.roDb.NewSelect().
+		Model(&rules).
+		Where("is_active = TRUE").
+		Order("created_at ASC", "id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active pricing rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+func (r *Repository) Update(ctx context.Context, rule *entity.PricingRule) error {
+	now := time.Now()
+	rule.UpdatedAt = &now
+
+	result, err := r.db.NewUpdate().
+		Model(rule).
+		Where("id = ?", rule.Id).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update pricing rule: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("pricing rule with id %s not found", rule.Id)
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"movie-service/internal/module/pricing/business"
+	"movie-service/internal/module/pricing/entity"
+
+	"github.com/google/uuid"
+	"github.com/samber/do"
+	"github.com/uptrace/bun"
+)
+
+type TicketCategoryRepository struct {
+	db   *bun.DB
+	roDb *bun.DB
+}
+
+func NewTicketCategoryRepository(i *do.Injector) (business.TicketCategoryRepository, error) {
+	db, err := do.Invoke[*bun.DB](i)
+	if err != nil {
+		return nil, err
+	}
+
+	roDb, err := do.InvokeNamed[*bun.DB](i, "readonly-db")
+	if err != nil {
+		return nil, err
+	}
+
+	return &TicketCategoryRepository{
+		db:   db,
+		roDb: roDb,
+	}, nil
+}
+
+func (r *TicketCategoryRepository) Create(ctx context.Context, category *entity.TicketCategory) error {
+	if category.Id == "" {
+		category.Id = uuid.New().String()
+	}
+
+	now := time.Now()
+	category.CreatedAt = now
+	category.UpdatedAt = &now
+
+	_, err := r.db.NewInsert().Model(category).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create ticket category: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TicketCategoryRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.NewDelete().
+		Model((*entity.TicketCategory)(nil)).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete ticket category: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("ticket category with id %s not found", id)
+	}
+
+	return nil
+}
+
+func (r *TicketCategoryRepository) GetByID(ctx context.Context, id string) (*entity.TicketCategory, error) {
+	var category entity.TicketCategory
+	err := r.roDb.NewSelect().
+		Model(&category).
+		Where("id = ?", id).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &category, nil
+}
+
+func (r *TicketCategoryRepository) GetMany(ctx context.Context, isActive *bool) ([]*entity.TicketCategory, error) {
+	query := r.roDb.NewSelect().Model((*entity.TicketCategory)(nil))
+
+	if isActive != nil {
+		query = query.Where("is_active = ?", *isActive)
+	}
+
+	categories := make([]*entity.TicketCategory, 0)
+	err := query.
+		Order("code ASC").
+		Scan(ctx, &categories)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket categories: %w", err)
+	}
+
+	return categories, nil
+}
+
+func (r *TicketCategoryRepository) Update(ctx context.Context, category *entity.TicketCategory) error {
+	now := time.Now()
+	category.UpdatedAt = &now
+
+	result, err := r.db.NewUpdate().
+		Model(category).
+		Where("id = ?", category.Id).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update ticket category: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("ticket category with id %s not found", category.Id)
+	}
+
+	return nil
+}
+
+func (r *TicketCategoryRepository) ExistsByCode(ctx context.Context, code string, excludeId string) (bool, error) {
+	query := r.roDb.NewSelect().
+		Model((*entity.TicketCategory)(nil)).
+		Where("code = ?", code)
+
+	if excludeId != "" {
+		query = query.Where("id != ?", excludeId)
+	}
+
+	exists, err := query.Exists(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check ticket category code existence: %w", err)
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,285 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"movie-service/internal/module/pricing/business"
+	"movie-service/internal/module/pricing/entity"
+	"movie-service/internal/pkg/response"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do"
+)
+
+type handler struct {
+	biz business.PricingBiz
+}
+
+func NewAPI(i *do.Injector) (*handler, error) {
+	biz, err := do.Invoke[business.PricingBiz](i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &handler{
+		biz: biz,
+	}, nil
+}
+
+func (h *handler) GetPricingRules(c *gin.Context) {
+	var query entity.GetPricingRulesQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, fmt.Sprintf("Invalid query parameters: %s", err.Error()))
+		return
+	}
+
+	if query.Page == 0 {
+		query.Page = 1
+	}
+	if query.Size == 0 {
+		query.Size = 10
+	}
+
+	query.RuleType = entity.RuleType(strings.ToUpper(string(query.RuleType)))
+
+	rules, total, err := h.biz.GetPricingRules(c.Request.Context(), query.Page, query.Size, query.RuleType, query.IsActive)
+	if err != nil {
+		response.ErrorWithMessage(c, "Failed to get pricing rules")
+		return
+	}
+
+	response.Success(c, entity.ToPricingRulesResponse(rules, query.Page, query.Size, total))
+}
+
+func (h *handler) GetPricingRuleById(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		response.BadRequest(c, "Pricing rule ID is required")
+		return
+	}
+
+	rule, err := h.biz.GetPricingRuleById(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, business.ErrPricingRuleNotFound) {
+			response.NotFound(c, fmt.Errorf("pricing rule not found"))
+			return
+		}
+
+		response.ErrorWithMessage(c, "Failed to get pricing rule")
+		return
+	}
+
+	response.Success(c, rule)
+}
+
+func (h *handler) CreatePricingRule(c *gin.Context) {
+	var req entity.CreatePricingRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, fmt.Sprintf("Invalid request body: %s", err.Error()))
+		return
+	}
+
+	req.RuleType = entity.RuleType(strings.ToUpper(string(req.RuleType)))
+	req.AdjustmentType = entity.AdjustmentType(strings.ToUpper(string(req.AdjustmentType)))
+
+	rule := req.ToPricingRule()
+	if err := h.biz.CreatePricingRule(c.Request.Context(), rule); err != nil {
+		if errors.Is(err, business.ErrInvalidPricingRuleData) {
+			response.BadRequest(c, "Invalid pricing rule data")
+			return
+		}
+
+		response.ErrorWithMessage(c, "Failed to create pricing rule")
+		return
+	}
+
+	response.Created(c, rule)
+}
+
+func (h *handler) UpdatePricingRule(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		response.BadRequest(c, "Pricing rule ID is required")
+		return
+	}
+
+	var req entity.UpdatePricingRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, fmt.Sprintf("Invalid request body: %s", err.Error()))
+		return
+	}
+
+	if req.AdjustmentType != nil {
+		adjustmentType := entity.AdjustmentType(strings.ToUpper(string(*req.AdjustmentType)))
+		req.AdjustmentType = &adjustmentType
+	}
+
+	if err := h.biz.UpdatePricingRule(c.Request.Context(), id, &req); err != nil {
+		if errors.Is(err, business.ErrPricingRuleNotFound) {
+			response.NotFound(c, fmt.Errorf("pricing rule not found"))
+			return
+		}
+		if errors.Is(err, business.ErrInvalidPricingRuleData) {
+			response.BadRequest(c, "Invalid pricing rule data")
+			return
+		}
+
+		response.ErrorWithMessage(c, "Failed to update pricing rule")
+		return
+	}
+
+	rule, err := h.biz.GetPricingRuleById(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorWithMessage(c, "Failed to get updated pricing rule")
+		return
+	}
+
+	response.Success(c, rule)
+}
+
+func (h *handler) DeletePricingRule(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		response.BadRequest(c, "Pricing rule ID is required")
+		return
+	}
+
+	if err := h.biz.DeletePricingRule(c.Request.Context(), id); err != nil {
+		if errors.Is(err, business.ErrPricingRuleNotFound) {
+			response.NotFound(c, fmt.Errorf("pricing rule not found"))
+			return
+		}
+
+		response.ErrorWithMessage(c, "Failed to delete pricing rule")
+		return
+	}
+
+	response.NoContent(c)
+}
+
+func (h *handler) GetTicketCategories(c *gin.Context) {
+	var query entity.GetTicketCategoriesQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, fmt.Sprintf("Invalid query parameters: %s", err.Error()))
+		return
+	}
+
+	categories, err := h.biz.GetTicketCategories(c.Request.Context(), query.IsActive)
+	if err != nil {
+		response.ErrorWithMessage(c, "Failed to get ticket categories")
+		return
+	}
+
+	response.Success(c, categories)
+}
+
+func (h *handler) GetTicketCategoryById(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		response.BadRequest(c, "Ticket category ID is required")
+		return
+	}
+
+	category, err := h.biz.GetTicketCategoryById(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, business.ErrTicketCategoryNotFound) {
+			response.NotFound(c, fmt.Errorf("ticket category not found"))
+			return
+		}
+
+		response.ErrorWithMessage(c, "Failed to get ticket category")
+		return
+	}
+
+	response.Success(c, category)
+}
+
+func (h *handler) CreateTicketCategory(c *gin.Context) {
+	var req entity.CreateTicketCategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, fmt.Sprintf("Invalid request body: %s", err.Error()))
+		return
+	}
+
+	req.AdjustmentType = entity.AdjustmentType(strings.ToUpper(string(req.AdjustmentType)))
+
+	category := req.ToTicketCategory()
+	if err := h.biz.CreateTicketCategory(c.Request.Context(), category); err != nil {
+		if errors.Is(err, business.ErrInvalidTicketCategoryData) {
+			response.BadRequest(c, "Invalid ticket category data")
+			return
+		}
+		if errors.Is(err, business.ErrTicketCategoryCodeExists) {
+			response.Conflict(c, "Ticket category code already exists")
+			return
+		}
+
+		response.ErrorWithMessage(c, "Failed to create ticket category")
+		return
+	}
+
+	response.Created(c, category)
+}
+
+func (h *handler) UpdateTicketCategory(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		response.BadRequest(c, "Ticket category ID is required")
+		return
+	}
+
+	var req entity.UpdateTicketCategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, fmt.Sprintf("Invalid request body: %s", err.Error()))
+		return
+	}
+
+	if req.AdjustmentType != nil {
+		adjustmentType := entity.AdjustmentType(strings.ToUpper(string(*req.AdjustmentType)))
+		req.AdjustmentType = &adjustmentType
+	}
+
+	if err := h.biz.UpdateTicketCategory(c.Request.Context(), id, &req); err != nil {
+		if errors.Is(err, business.ErrTicketCategoryNotFound) {
+			response.NotFound(c, fmt.Errorf("ticket category not found"))
+			return
+		}
+		if errors.Is(err, business.ErrInvalidTicketCategoryData) {
+			response.BadRequest(c, "Invalid ticket category data")
+			return
+		}
+
+		response.ErrorWithMessage(c, "Failed to update ticket category")
+		return
+	}
+
+	category, err := h.biz.GetTicketCategoryById(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorWithMessage(c, "Failed to get updated ticket category")
+		return
+	}
+
+	response.Success(c, category)
+}
+
+func (h *handler) DeleteTicketCategory(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		response.BadRequest(c, "Ticket category ID is required")
+		return
+	}
+
+	if err := h.biz.DeleteTicketCategory(c.Request.Context(), id); err != nil {
+		if errors.Is(err, business.ErrTicketCategoryNotFound) {
+			response.NotFound(c, fmt.Errorf("ticket category not found"))
+			return
+		}
+
+		response.ErrorWithMessage(c, "Failed to delete ticket category")
+		return
+	}
+
+	response.NoContent(c)
+}
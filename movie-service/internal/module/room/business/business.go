@@ -5,8 +5,10 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 
 	"movie-service/internal/module/room/entity"
+	seatBusiness "movie-service/internal/module/seat/business"
 	"movie-service/internal/pkg/caching"
 
 	"github.com/redis/go-redis/v9"
@@ -19,6 +21,9 @@ var (
 	ErrRoomNotFound            = fmt.Errorf("room not found")
 	ErrRoomNumberExists        = fmt.Errorf("room number already exists")
 	ErrRoomNotActive           = fmt.Errorf("room is not in ACTIVE status")
+	ErrInvalidRoomLayout       = fmt.Errorf("invalid room layout")
+	ErrRoomHasUpcomingBookings = fmt.Errorf("room has bookings for upcoming showtimes")
+	ErrCapacityFromLayout      = fmt.Errorf("room capacity follows its seat layout")
 )
 
 type RoomBiz interface {
@@ -29,6 +34,7 @@ type RoomBiz interface {
 	DeleteRoom(ctx context.Context, id string) error
 	UpdateRoomStatus(ctx context.Context, id string, status entity.RoomStatus) error
 	ValidateRoomForShowtime(ctx context.Context, roomId string) error
+	GenerateRoomSeats(ctx context.Context, id string, layout *entity.RoomLayout) (*entity.SeatLayoutResult, error)
 }
 
 type RoomRepository interface {
@@ -39,10 +45,14 @@ type RoomRepository interface {
 	Update(ctx context.Context, room *entity.Room) error
 	Delete(ctx context.Context, id string) error
 	ExistsByRoomNumber(ctx context.Context, roomNumber int, excludeId string) (bool, error)
+	CreateWithLayout(ctx context.Context, room *entity.Room, seats []*entity.LayoutSeat) (*entity.SeatLayoutResult, error)
+	ApplyLayout(ctx context.Context, id string, layout *entity.RoomLayout, seats []*entity.LayoutSeat) (*entity.SeatLayoutResult, error)
+	GetUpcomingShowtimeIds(ctx context.Context, id string) ([]string, error)
 }
 
 type business struct {
 	repository  RoomRepository
+	seatBiz     seatBusiness.SeatBiz
 	cache       caching.Cache
 	roCache     caching.ReadOnlyCache
 	redisClient redis.UniversalClient
@@ -64,6 +74,11 @@ func NewBusiness(i *do.Injector) (RoomBiz, error) {
 		return nil, err
 	}
 
+	seatBiz, err := do.Invoke[seatBusiness.SeatBiz](i)
+	if err != nil {
+		return nil, err
+	}
+
 	redisClient, err := do.InvokeNamed[redis.UniversalClient](i, "redis-cache-db")
 	if err != nil {
 		return nil, err
@@ -71,6 +86,7 @@ func NewBusiness(i *do.Injector) (RoomBiz, error) {
 
 	return &business{
 		repository:  repository,
+		seatBiz:     seatBiz,
 		cache:       cache,
 		roCache:     roCache,
 		redisClient: redisClient,
@@ -114,7 +130,21 @@ func (b *business) GetRooms(ctx context.Context, page, size int, search string,
 }
 
 func (b *business) CreateRoom(ctx context.Context, room *entity.Room) error {
-	if room == nil || !room.IsValid() {
+	if room == nil {
+		return ErrInvalidRoomData
+	}
+
+	var seats []*entity.LayoutSeat
+	if room.Layout != nil {
+		var err error
+		seats, err = room.Layout.Seats()
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidRoomLayout, err)
+		}
+		room.Capacity = len(seats)
+	}
+
+	if !room.IsValid() {
 		return ErrInvalidRoomData
 	}
 
@@ -126,11 +156,19 @@ func (b *business) CreateRoom(ctx context.Context, room *entity.Room) error {
 		return ErrRoomNumberExists
 	}
 
-	if err = b.repository.Create(ctx, room); err != nil {
+	if room.Layout == nil {
+		err = b.repository.Create(ctx, room)
+	} else {
+		_, err = b.repository.CreateWithLayout(ctx, room, seats)
+	}
+	if err != nil {
 		return fmt.Errorf("failed to create room: %w", err)
 	}
 
 	b.clearCacheForRoom(ctx, room.Id)
+	if room.Layout != nil {
+		b.seatBiz.InvalidateSeatCache(ctx)
+	}
 
 	return nil
 }
@@ -160,6 +198,9 @@ func (b *business) UpdateRoom(ctx context.Context, id string, updates *entity.Up
 	}
 
 	if updates.Capacity != nil {
+		if room.Layout != nil && *updates.Capacity != room.Capacity {
+			return ErrCapacityFromLayout
+		}
 		room.Capacity = *updates.Capacity
 	}
 
@@ -171,6 +212,10 @@ func (b *business) UpdateRoom(ctx context.Context, id string, updates *entity.Up
 		room.Status = *updates.Status
 	}
 
+	if updates.CinemaId != nil {
+		room.CinemaId = strings.TrimSpace(*updates.CinemaId)
+	}
+
 	if !room.IsValid() {
 		return ErrInvalidRoomData
 	}
@@ -239,6 +284,51 @@ func (b *business) ValidateRoomForShowtime(ctx context.Context, roomId string) e
 	return nil
 }
 
+// GenerateRoomSeats replaces a room's seats with those of a layout and sets
+// its capacity to match. Rooms with bookings or seat holds for showtimes that
+// have not ended are left alone.
+func (b *business) GenerateRoomSeats(ctx context.Context, id string, layout *entity.RoomLayout) (*entity.SeatLayoutResult, error) {
+	if id == "" || layout == nil {
+		return nil, ErrInvalidRoomData
+	}
+
+	seats, err := layout.Seats()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRoomLayout, err)
+	}
+
+	// Holds live in Redis until a booking is created, so they are checked
+	// here; ApplyLayout checks the bookings under the room lock.
+	showtimeIds, err := b.repository.GetUpcomingShowtimeIds(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate room seats: %w", err)
+	}
+
+	held, err := b.seatBiz.HasHeldSeats(ctx, showtimeIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate room seats: %w", err)
+	}
+	if held {
+		return nil, ErrRoomHasUpcomingBookings
+	}
+
+	result, err := b.repository.ApplyLayout(ctx, id, layout, seats)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRoomNotFound
+		}
+		if errors.Is(err, ErrRoomHasUpcomingBookings) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to generate room seats: %w", err)
+	}
+
+	b.clearCacheForRoom(ctx, id)
+	b.seatBiz.InvalidateSeatCache(ctx)
+
+	return result, nil
+}
+
 func (b *business) clearCacheForRoom(ctx context.Context, roomId string) {
 	_ = b.cache.Delete(ctx, redisRoomDetail(roomId))
 	_ = b.cache.Delete(ctx, redisRoomsList())
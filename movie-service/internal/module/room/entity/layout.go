@@ -0,0 +1,190 @@
+package entity
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	seatEntity "movie-service/internal/module/seat/entity"
+)
+
+const (
+	MaxLayoutRows        = 52
+	MaxLayoutSeatsPerRow = 60
+)
+
+// RoomLayout describes the seats of a room as a grid. Rows are labelled A, B,
+// ... Z, AA, AB and so on from the screen. Seat positions count seats only,
+// from 1 to SeatsPerRow, left to right.
+type RoomLayout struct {
+	Rows        int `json:"rows"`
+	SeatsPerRow int `json:"seats_per_row"`
+	// AislesAfter lists the positions an aisle follows. Seat numbers skip one
+	// at each aisle, which is how booking-service tells seats apart.
+	AislesAfter []int `json:"aisles_after,omitempty"`
+	// Zones give seats a type other than REGULAR. Later zones win.
+	Zones []SeatZone `json:"zones,omitempty"`
+	// DisabledSeats are positions left without a seat, e.g. for wheelchair
+	// spaces. Their seat numbers are not reused.
+	DisabledSeats []SeatPosition `json:"disabled_seats,omitempty"`
+}
+
+// SeatZone applies a seat type to the given rows, between FromSeat and ToSeat
+// if set and otherwise to the whole row.
+type SeatZone struct {
+	SeatType seatEntity.SeatType `json:"seat_type"`
+	Rows     []string            `json:"rows"`
+	FromSeat int                 `json:"from_seat,omitempty"`
+	ToSeat   int                 `json:"to_seat,omitempty"`
+}
+
+type SeatPosition struct {
+	Row  string `json:"row"`
+	Seat int    `json:"seat"`
+}
+
+// LayoutSeat is a seat generated from a layout.
+type LayoutSeat struct {
+	RowNumber  string
+	SeatNumber string
+	SeatType   seatEntity.SeatType
+}
+
+// SeatLayoutResult counts what applying a layout did to a room's seats.
+// Retired seats were dropped from the layout but are kept, BLOCKED, because
+// past bookings refer to them.
+type SeatLayoutResult struct {
+	Capacity int `json:"capacity"`
+	Created  int `json:"created"`
+	Updated  int `json:"updated"`
+	Removed  int `json:"removed"`
+	Retired  int `json:"retired"`
+}
+
+// Seats validates the layout and lists its seats row by row.
+func (l *RoomLayout) Seats() ([]*LayoutSeat, error) {
+	if l.Rows < 1 || l.Rows > MaxLayoutRows {
+		return nil, fmt.Errorf("rows must be between 1 and %d", MaxLayoutRows)
+	}
+	if l.SeatsPerRow < 1 || l.SeatsPerRow > MaxLayoutSeatsPerRow {
+		return nil, fmt.Errorf("seats_per_row must be between 1 and %d", MaxLayoutSeatsPerRow)
+	}
+
+	rows := make(map[string]int, l.Rows)
+	for i := 0; i < l.Rows; i++ {
+		rows[RowLabel(i)] = i
+	}
+
+	aisles := make(map[int]bool, len(l.AislesAfter))
+	for _, position := range l.AislesAfter {
+		if position < 1 || position >= l.SeatsPerRow {
+			return nil, fmt.Errorf("aisle after seat %d is outside the row", position)
+		}
+		aisles[position] = true
+	}
+
+	types := make([][]seatEntity.SeatType, l.Rows)
+	for i := range types {
+		types[i] = make([]seatEntity.SeatType, l.SeatsPerRow)
+		for j := range types[i] {
+			types[i][j] = seatEntity.SeatTypeRegular
+		}
+	}
+
+	for _, zone := range l.Zones {
+		if !slices.Contains([]seatEntity.SeatType{seatEntity.SeatTypeRegular, seatEntity.SeatTypeVIP, seatEntity.SeatTypeCouple}, zone.SeatType) {
+			return nil, fmt.Errorf("unknown seat type %q", zone.SeatType)
+		}
+		if len(zone.Rows) == 0 {
+			return nil, fmt.Errorf("%s zone has no rows", zone.SeatType)
+		}
+
+		from, to := 1, l.SeatsPerRow
+		if zone.FromSeat != 0 {
+			from = zone.FromSeat
+		}
+		if zone.ToSeat != 0 {
+			to = zone.ToSeat
+		}
+		if from < 1 || to > l.SeatsPerRow || from > to {
+			return nil, fmt.Errorf("%s zone seats %d-%d are outside the row", zone.SeatType, from, to)
+		}
+
+		for _, row := range zone.Rows {
+			i, ok := rows[row]
+			if !ok {
+				return nil, fmt.Errorf("%s zone row %s is not in the layout", zone.SeatType, row)
+			}
+			for j := from; j <= to; j++ {
+				types[i][j-1] = zone.SeatType
+			}
+		}
+	}
+
+	disabled := make(map[SeatPosition]bool, len(l.DisabledSeats))
+	for _, position := range l.DisabledSeats {
+		if _, ok := rows[position.Row]; !ok || position.Seat < 1 || position.Seat > l.SeatsPerRow {
+			return nil, fmt.Errorf("disabled seat %s%d is not in the layout", position.Row, position.Seat)
+		}
+		disabled[position] = true
+	}
+
+	seats := make([]*LayoutSeat, 0, l.Rows*l.SeatsPerRow)
+	for i := 0; i < l.Rows; i++ {
+		row := RowLabel(i)
+		number := 0
+		couples := 0
+		for j := 1; j <= l.SeatsPerRow; j++ {
+			number++
+			if disabled[SeatPosition{Row: row, Seat: j}] {
+				// A disabled seat breaks the block like an aisle does.
+				if couples%2 != 0 {
+					return nil, fmt.Errorf("couple seats in row %s must come in pairs", row)
+				}
+				couples = 0
+			} else {
+				seatType := types[i][j-1]
+				if seatType == seatEntity.SeatTypeCouple {
+					couples++
+				} else if couples%2 != 0 {
+					return nil, fmt.Errorf("couple seats in row %s must come in pairs", row)
+				} else {
+					couples = 0
+				}
+
+				seats = append(seats, &LayoutSeat{
+					RowNumber:  row,
+					SeatNumber: strconv.Itoa(number),
+					SeatType:   seatType,
+				})
+			}
+
+			if aisles[j] {
+				if couples%2 != 0 {
+					return nil, fmt.Errorf("couple seats in row %s must come in pairs", row)
+				}
+				couples = 0
+				number++
+			}
+		}
+		if couples%2 != 0 {
+			return nil, fmt.Errorf("couple seats in row %s must come in pairs", row)
+		}
+	}
+
+	if len(seats) == 0 {
+		return nil, fmt.Errorf("layout has no seats")
+	}
+
+	return seats, nil
+}
+
+// RowLabel names the row at index i: A to Z, then AA, AB and so on.
+func RowLabel(i int) string {
+	label := ""
+	for i >= 0 {
+		label = string(rune('A'+i%26)) + label
+		i = i/26 - 1
+	}
+	return label
+}
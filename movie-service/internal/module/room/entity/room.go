@@ -22,20 +22,29 @@ const (
 	RoomTypeIMAX     RoomType = "IMAX"
 )
 
+// DefaultCinemaId is the cinema of rooms created without one, which is every
+// room of a single-site install.
+const DefaultCinemaId = "MAIN"
+
 type Room struct {
 	bun.BaseModel `bun:"table:rooms,alias:r"`
 
 	Id         string     `bun:"id,pk" json:"id"`
 	RoomNumber int        `bun:"room_number,notnull,unique" json:"room_number"`
+	CinemaId   string     `bun:"cinema_id,notnull,default:'MAIN'" json:"cinema_id"`
 	Capacity   int        `bun:"capacity,notnull" json:"capacity"`
 	RoomType   RoomType   `bun:"room_type,notnull" json:"room_type"`
 	Status     RoomStatus `bun:"status,notnull,default:'ACTIVE'" json:"status"`
 	CreatedAt  time.Time  `bun:"created_at,nullzero,default:current_timestamp" json:"created_at"`
 	UpdatedAt  *time.Time `bun:"updated_at" json:"updated_at,omitempty"`
+
+	// Layout is the spec the room's seats were generated from, if any. While
+	// it is set Capacity follows it.
+	Layout *RoomLayout `bun:"layout,type:jsonb" json:"layout,omitempty"`
 }
 
 func (r *Room) IsValid() bool {
-	if r.RoomNumber <= 0 || r.Capacity <= 0 {
+	if r.RoomNumber <= 0 || r.Capacity <= 0 || r.CinemaId == "" {
 		return false
 	}
 	return true
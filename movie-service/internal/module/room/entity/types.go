@@ -1,11 +1,19 @@
 package entity
 
-import "movie-service/internal/pkg/paging"
+import (
+	"strings"
+
+	"movie-service/internal/pkg/paging"
+)
 
 type CreateRoomRequest struct {
 	RoomNumber int      `json:"room_number" binding:"required,min=1"`
-	Capacity   int      `json:"capacity" binding:"required,min=1"`
+	Capacity   int      `json:"capacity" binding:"required_without=Layout,omitempty,min=1"`
 	RoomType   RoomType `json:"room_type" binding:"required"`
+	// CinemaId defaults to DefaultCinemaId.
+	CinemaId string `json:"cinema_id"`
+	// Layout generates the room's seats along with it, and its capacity.
+	Layout *RoomLayout `json:"layout,omitempty"`
 }
 
 type UpdateRoomRequest struct {
@@ -13,6 +21,7 @@ type UpdateRoomRequest struct {
 	Capacity   *int        `json:"capacity,omitempty" binding:"omitempty,min=1"`
 	RoomType   *RoomType   `json:"room_type,omitempty"`
 	Status     *RoomStatus `json:"status,omitempty"`
+	CinemaId   *string     `json:"cinema_id,omitempty"`
 }
 
 type GetRoomsQuery struct {
@@ -24,13 +33,15 @@ type GetRoomsQuery struct {
 }
 
 type RoomResponse struct {
-	Id         string     `json:"id"`
-	RoomNumber int        `json:"room_number"`
-	Capacity   int        `json:"capacity"`
-	RoomType   RoomType   `json:"room_type"`
-	Status     RoomStatus `json:"status"`
-	CreatedAt  string     `json:"created_at"`
-	UpdatedAt  *string    `json:"updated_at,omitempty"`
+	Id         string      `json:"id"`
+	RoomNumber int         `json:"room_number"`
+	CinemaId   string      `json:"cinema_id"`
+	Capacity   int         `json:"capacity"`
+	RoomType   RoomType    `json:"room_type"`
+	Status     RoomStatus  `json:"status"`
+	Layout     *RoomLayout `json:"layout,omitempty"`
+	CreatedAt  string      `json:"created_at"`
+	UpdatedAt  *string     `json:"updated_at,omitempty"`
 }
 
 type RoomsResponse struct {
@@ -46,9 +57,11 @@ func ToRoomResponse(room *Room) *RoomResponse {
 	resp := &RoomResponse{
 		Id:         room.Id,
 		RoomNumber: room.RoomNumber,
+		CinemaId:   room.CinemaId,
 		Capacity:   room.Capacity,
 		RoomType:   room.RoomType,
 		Status:     room.Status,
+		Layout:     room.Layout,
 		CreatedAt:  room.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
 
@@ -73,10 +86,17 @@ func ToRoomsResponse(rooms []*Room, page, size, total int) *RoomsResponse {
 }
 
 func (req *CreateRoomRequest) ToRoom() *Room {
+	cinemaId := strings.TrimSpace(req.CinemaId)
+	if cinemaId == "" {
+		cinemaId = DefaultCinemaId
+	}
+
 	return &Room{
 		RoomNumber: req.RoomNumber,
+		CinemaId:   cinemaId,
 		Capacity:   req.Capacity,
 		RoomType:   req.RoomType,
 		Status:     RoomStatusActive,
+		Layout:     req.Layout,
 	}
 }
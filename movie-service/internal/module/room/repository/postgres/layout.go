@@ -0,0 +1,284 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"movie-service/internal/module/room/business"
+	"movie-service/internal/module/room/entity"
+	seatEntity "movie-service/internal/module/seat/entity"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+func (r *Repository) CreateWithLayout(ctx context.Context, room *entity.Room, seats []*entity.LayoutSeat) (*entity.SeatLayoutResult, error) {
+	if room.Id == "" {
+		room.Id = uuid.New().String()
+	}
+
+	now := time.Now()
+	room.CreatedAt = now
+	room.UpdatedAt = &now
+
+	var result *entity.SeatLayoutResult
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewInsert().Model(room).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to create room: %w", err)
+		}
+
+		var err error
+		result, err = syncRoomSeats(ctx, tx, room.Id, nil, seats)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ApplyLayout regenerates a room's seats from a layout and stores the layout
+// and the capacity it gives on the room, unless the room has bookings for a
+// showtime that has not ended.
+func (r *Repository) ApplyLayout(ctx context.Context, id string, layout *entity.RoomLayout, seats []*entity.LayoutSeat) (*entity.SeatLayoutResult, error) {
+	var result *entity.SeatLayoutResult
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		room := new(entity.Room)
+		err := tx.NewSelect().
+			Model(room).
+			Where("id = ?", id).
+			For("UPDATE").
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+
+		// Bookings belong to booking-service, which shares the database.
+		booked, err := tx.NewSelect().
+			TableExpr("bookings AS b").
+			Join("INNER JOIN showtimes AS st ON st.id = b.showtime_id").
+			Where("st.room_id = ?", id).
+			Where("st.end_time > ?", time.Now()).
+			Where("b.status IN (?)", bun.In([]string{"PENDING", "CONFIRMED"})).
+			Exists(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check room bookings: %w", err)
+		}
+		if booked {
+			return business.ErrRoomHasUpcomingBookings
+		}
+
+		previous, err := layoutPositions(room.Layout)
+		if err != nil {
+			return fmt.Errorf("failed to read room layout: %w", err)
+		}
+
+		result, err = syncRoomSeats(ctx, tx, id, previous, seats)
+		if err != nil {
+			return err
+		}
+
+		room.Capacity = result.Capacity
+		room.Layout = layout
+		now := time.Now()
+		room.UpdatedAt = &now
+
+		_, err = tx.NewUpdate().
+			Model(room).
+			Column("capacity", "layout", "updated_at").
+			WherePK().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update room: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetUpcomingShowtimeIds returns the showtimes in a room that have not ended.
+func (r *Repository) GetUpcomingShowtimeIds(ctx context.Context, id string) ([]string, error) {
+	showtimeIds := make([]string, 0)
+	err := r.db.NewSelect().
+		TableExpr("showtimes AS st").
+		Column("st.id").
+		Where("st.room_id = ?", id).
+		Where("st.end_time > ?", time.Now()).
+		Scan(ctx, &showtimeIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upcoming showtimes: %w", err)
+	}
+
+	return showtimeIds, nil
+}
+
+// syncRoomSeats makes a room's seats match a layout, matching seats by row
+// and seat number so their ids, and the tickets that point at them, survive.
+// Matched seats take the layout's seat type and keep their status, so seats
+// blocked by staff stay blocked, except that seats retired by the previous
+// layout, whose positions are given, go back on sale. Seats the layout drops
+// are deleted, or BLOCKED if bookings refer to them.
+func syncRoomSeats(ctx context.Context, tx bun.Tx, roomId string, previous map[string]struct{}, seats []*entity.LayoutSeat) (*entity.SeatLayoutResult, error) {
+	existing := make([]*seatEntity.Seat, 0)
+	err := tx.NewSelect().
+		Model(&existing).
+		Where("room_id = ?", roomId).
+		For("UPDATE").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get room seats: %w", err)
+	}
+
+	byPosition := make(map[string]*seatEntity.Seat, len(existing))
+	for _, seat := range existing {
+		byPosition[seatPosition(seat.RowNumber, seat.SeatNumber)] = seat
+	}
+
+	result := &entity.SeatLayoutResult{Capacity: len(seats)}
+	now := time.Now()
+	created := make([]*seatEntity.Seat, 0)
+	for _, layoutSeat := range seats {
+		position := seatPosition(layoutSeat.RowNumber, layoutSeat.SeatNumber)
+		seat, ok := byPosition[position]
+		if !ok {
+			created = append(created, &seatEntity.Seat{
+				Id:         uuid.New().String(),
+				RoomId:     roomId,
+				SeatNumber: layoutSeat.SeatNumber,
+				RowNumber:  layoutSeat.RowNumber,
+				SeatType:   layoutSeat.SeatType,
+				Status:     seatEntity.SeatStatusAvailable,
+				CreatedAt:  now,
+				UpdatedAt:  &now,
+			})
+			continue
+		}
+		delete(byPosition, position)
+
+		revived := isRetiredSeat(seat, previous)
+		if seat.SeatType == layoutSeat.SeatType && !revived {
+			continue
+		}
+
+		seat.SeatType = layoutSeat.SeatType
+		if revived {
+			seat.Status = seatEntity.SeatStatusAvailable
+		}
+		seat.UpdatedAt = &now
+
+		_, err = tx.NewUpdate().
+			Model(seat).
+			Column("seat_type", "status", "updated_at").
+			WherePK().
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update seat: %w", err)
+		}
+		result.Updated++
+	}
+
+	if len(created) > 0 {
+		if _, err = tx.NewInsert().Model(&created).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to create seats: %w", err)
+		}
+		result.Created = len(created)
+	}
+
+	if len(byPosition) == 0 {
+		return result, nil
+	}
+
+	dropped := make([]string, 0, len(byPosition))
+	for _, seat := range byPosition {
+		dropped = append(dropped, seat.Id)
+	}
+
+	// Tickets and booking seats cascade on seat deletion, so seats with
+	// history are kept out of sale instead.
+	referenced := make([]string, 0)
+	err = tx.NewRaw(`
+		SELECT seat_id FROM booking_seats WHERE seat_id IN (?)
+		UNION
+		SELECT seat_id FROM tickets WHERE seat_id IN (?)
+	`, bun.In(dropped), bun.In(dropped)).Scan(ctx, &referenced)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check seat bookings: %w", err)
+	}
+
+	if len(referenced) > 0 {
+		_, err = tx.NewUpdate().
+			Model((*seatEntity.Seat)(nil)).
+			Set("status = ?", seatEntity.SeatStatusBlocked).
+			Set("updated_at = ?", now).
+			Where("id IN (?)", bun.In(referenced)).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to block seats: %w", err)
+		}
+		result.Retired = len(referenced)
+	}
+
+	if len(referenced) < len(dropped) {
+		query := tx.NewDelete().
+			Model((*seatEntity.Seat)(nil)).
+			Where("id IN (?)", bun.In(dropped))
+		if len(referenced) > 0 {
+			query = query.Where("id NOT IN (?)", bun.In(referenced))
+		}
+
+		deleted, err := query.Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete seats: %w", err)
+		}
+
+		rowsAffected, err := deleted.RowsAffected()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		result.Removed = int(rowsAffected)
+	}
+
+	return result, nil
+}
+
+func seatPosition(rowNumber, seatNumber string) string {
+	return rowNumber + "-" + seatNumber
+}
+
+// layoutPositions returns the positions of a layout's seats, or nil if the
+// room has no layout.
+func layoutPositions(layout *entity.RoomLayout) (map[string]struct{}, error) {
+	if layout == nil {
+		return nil, nil
+	}
+
+	seats, err := layout.Seats()
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make(map[string]struct{}, len(seats))
+	for _, seat := range seats {
+		positions[seatPosition(seat.RowNumber, seat.SeatNumber)] = struct{}{}
+	}
+	return positions, nil
+}
+
+// isRetiredSeat reports whether a seat was retired by the previous layout: it
+// is BLOCKED and that layout had no seat at its position. Seats blocked by
+// staff are in the layout.
+func isRetiredSeat(seat *seatEntity.Seat, previous map[string]struct{}) bool {
+	if previous == nil || seat.Status != seatEntity.SeatStatusBlocked {
+		return false
+	}
+
+	_, ok := previous[seatPosition(seat.RowNumber, seat.SeatNumber)]
+	return !ok
+}
@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"testing"
+
+	"movie-service/internal/module/room/entity"
+	seatEntity "movie-service/internal/module/seat/entity"
+)
+
+func TestIsRetiredSeat(t *testing.T) {
+	// The previous layout had one row of two seats; a third seat was dropped
+	// by a layout before it and kept because bookings refer to it.
+	previous, err := layoutPositions(&entity.RoomLayout{Rows: 1, SeatsPerRow: 2})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	seat := func(seatNumber string, status seatEntity.SeatStatus) *seatEntity.Seat {
+		return &seatEntity.Seat{RowNumber: "A", SeatNumber: seatNumber, Status: status}
+	}
+
+	tests := []struct {
+		name     string
+		seat     *seatEntity.Seat
+		previous map[string]struct{}
+		want     bool
+	}{
+		{name: "retired seat", seat: seat("3", seatEntity.SeatStatusBlocked), previous: previous, want: true},
+		{name: "blocked by staff", seat: seat("1", seatEntity.SeatStatusBlocked), previous: previous},
+		{name: "available seat", seat: seat("2", seatEntity.SeatStatusAvailable), previous: previous},
+		{name: "no previous layout", seat: seat("3", seatEntity.SeatStatusBlocked)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetiredSeat(tt.seat, tt.previous); got != tt.want {
+				t.Errorf("Expected retired %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
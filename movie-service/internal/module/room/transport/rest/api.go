@@ -90,6 +90,10 @@ func (h *handler) CreateRoom(c *gin.Context) {
 			response.BadRequest(c, "Room number already exists")
 			return
 		}
+		if errors.Is(err, business.ErrInvalidRoomLayout) || errors.Is(err, business.ErrInvalidRoomData) {
+			response.BadRequest(c, err.Error())
+			return
+		}
 
 		response.ErrorWithMessage(c, "Failed to create room")
 		return
@@ -125,6 +129,10 @@ func (h *handler) UpdateRoom(c *gin.Context) {
 			response.BadRequest(c, "Invalid status transition")
 			return
 		}
+		if errors.Is(err, business.ErrCapacityFromLayout) {
+			response.BadRequest(c, "Room capacity follows its seat layout")
+			return
+		}
 
 		response.ErrorWithMessage(c, "Failed to update room")
 		return
@@ -193,3 +201,47 @@ func (h *handler) UpdateRoomStatus(c *gin.Context) {
 	resp := entity.ToRoomResponse(room)
 	response.Success(c, resp)
 }
+
+func (h *handler) GenerateRoomSeats(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		response.BadRequest(c, "Room ID is required")
+		return
+	}
+
+	var req entity.RoomLayout
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, fmt.Sprintf("Invalid request body: %s", err.Error()))
+		return
+	}
+
+	result, err := h.biz.GenerateRoomSeats(c.Request.Context(), id, &req)
+	if err != nil {
+		if errors.Is(err, business.ErrRoomNotFound) {
+			response.NotFound(c, fmt.Errorf("room not found"))
+			return
+		}
+		if errors.Is(err, business.ErrInvalidRoomLayout) {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		if errors.Is(err, business.ErrRoomHasUpcomingBookings) {
+			response.Conflict(c, "Room has bookings for upcoming showtimes")
+			return
+		}
+
+		response.ErrorWithMessage(c, "Failed to generate room seats")
+		return
+	}
+
+	room, err := h.biz.GetRoomById(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorWithMessage(c, "Failed to get updated room")
+		return
+	}
+
+	response.Success(c, gin.H{
+		"room":  entity.ToRoomResponse(room),
+		"seats": result,
+	})
+}
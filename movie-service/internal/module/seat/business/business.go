@@ -5,7 +5,6 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"strings"
 
 	"movie-service/internal/pkg/paging"
 
@@ -26,17 +25,21 @@ var (
 type SeatBiz interface {
 	GetSeatById(ctx context.Context, id string) (*entity.Seat, error)
 	GetSeatsByIds(ctx context.Context, ids []string) ([]*entity.Seat, error)
+	GetSeatsByRoomAndRows(ctx context.Context, roomId string, rows []string) ([]*entity.Seat, error)
 	GetSeats(ctx context.Context, page, size int, search, roomId, rowNumber string, seatType entity.SeatType, status entity.SeatStatus) ([]*entity.Seat, int, error)
 	GetLockedSeatsByShowtime(ctx context.Context, showtimeId string) (*entity.LockedSeatsResponse, error)
 	CreateSeat(ctx context.Context, seat *entity.Seat) error
 	UpdateSeat(ctx context.Context, id string, updates *entity.UpdateSeatRequest) error
 	DeleteSeat(ctx context.Context, id string) error
 	UpdateSeatStatus(ctx context.Context, id string, status entity.SeatStatus) error
+	InvalidateSeatCache(ctx context.Context)
+	HasHeldSeats(ctx context.Context, showtimeIds []string) (bool, error)
 }
 
 type SeatRepository interface {
 	GetByID(ctx context.Context, id string) (*entity.Seat, error)
 	GetByIDs(ctx context.Context, ids []string) ([]*entity.Seat, error)
+	GetByRoomAndRows(ctx context.Context, roomId string, rows []string) ([]*entity.Seat, error)
 	GetMany(ctx context.Context, limit, offset int, search, roomId, rowNumber string, seatType entity.SeatType, status entity.SeatStatus) ([]*entity.Seat, error)
 	GetTotalCount(ctx context.Context, search, roomId, rowNumber string, seatType entity.SeatType, status entity.SeatStatus) (int, error)
 	Create(ctx context.Context, seat *entity.Seat) error
@@ -106,6 +109,15 @@ func (b *business) GetSeatsByIds(ctx context.Context, ids []string) ([]*entity.S
 	return seats, nil
 }
 
+func (b *business) GetSeatsByRoomAndRows(ctx context.Context, roomId string, rows []string) ([]*entity.Seat, error) {
+	seats, err := b.repository.GetByRoomAndRows(ctx, roomId, rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get seats by rows: %w", err)
+	}
+
+	return seats, nil
+}
+
 func (b *business) GetSeats(ctx context.Context, page, size int, search, roomId, rowNumber string, seatType entity.SeatType, status entity.SeatStatus) ([]*entity.Seat, int, error) {
 	if page < 1 || size < 1 {
 		return nil, 0, ErrInvalidSeatData
@@ -135,71 +147,32 @@ func (b *business) GetSeats(ctx context.Context, page, size int, search, roomId,
 	return seats, total, nil
 }
 
-func (b *business) getConcurrentLockedSeatsByShowtime(ctx context.Context, showtimeId string) (map[string]bool, error) {
-	pattern := fmt.Sprintf("seat:concurrent_lock:%s:*", showtimeId)
-	keys, err := b.redisClient.Keys(ctx, pattern).Result()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get concurrent lock keys: %w", err)
-	}
-
-	lockedSeats := make(map[string]bool)
-	for _, key := range keys {
-		parts := strings.Split(key, ":")
-		if len(parts) == 4 {
-			seatId := parts[3]
-			lockedSeats[seatId] = true
-		}
-	}
-
-	return lockedSeats, nil
-}
-
-func (b *business) getBookedSeatsByShowtime(ctx context.Context, showtimeId string) (map[string]bool, error) {
-	pattern := fmt.Sprintf("seat_lock:%s:*", showtimeId)
-	keys, err := b.redisClient.Keys(ctx, pattern).Result()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get booked seat keys: %w", err)
-	}
-
-	bookedSeats := make(map[string]bool)
-	for _, key := range keys {
-		parts := strings.Split(key, ":")
-		if len(parts) == 3 {
-			seatId := parts[2]
-			bookedSeats[seatId] = true
-		}
-	}
-
-	return bookedSeats, nil
-}
-
 func (b *business) GetLockedSeatsByShowtime(ctx context.Context, showtimeId string) (*entity.LockedSeatsResponse, error) {
-	concurrentLockedSeatsMap, err := b.getConcurrentLockedSeatsByShowtime(ctx, showtimeId)
+	states, err := b.getSeatStatesByShowtime(ctx, showtimeId)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get concurrent locked seats: %w", err)
-	}
-
-	lockedSeatIds := make([]string, 0, len(concurrentLockedSeatsMap))
-	for seatId := range concurrentLockedSeatsMap {
-		lockedSeatIds = append(lockedSeatIds, seatId)
-	}
-
-	bookedSeatsMap, err := b.getBookedSeatsByShowtime(ctx, showtimeId)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get booked seats: %w", err)
+		return nil, err
 	}
 
-	bookedSeatIds := make([]string, 0, len(bookedSeatsMap))
-	for seatId := range bookedSeatsMap {
-		if concurrentLockedSeatsMap[seatId] {
-			continue
+	lockedSeatIds := make([]string, 0, len(states))
+	bookedSeatIds := make([]string, 0, len(states))
+	heldSeats := make([]entity.HeldSeat, 0, len(states))
+	for seatId, entry := range states {
+		switch entry.State {
+		case seatStateHeld:
+			lockedSeatIds = append(lockedSeatIds, seatId)
+			heldSeats = append(heldSeats, entity.HeldSeat{
+				SeatId:    seatId,
+				ExpiresAt: entry.ExpiresAt,
+			})
+		case seatStateBooked:
+			bookedSeatIds = append(bookedSeatIds, seatId)
 		}
-		bookedSeatIds = append(bookedSeatIds, seatId)
 	}
 
 	return &entity.LockedSeatsResponse{
 		LockedSeatIds: lockedSeatIds,
 		BookedSeatIds: bookedSeatIds,
+		HeldSeats:     heldSeats,
 	}, nil
 }
 
@@ -221,6 +194,7 @@ func (b *business) CreateSeat(ctx context.Context, seat *entity.Seat) error {
 	}
 
 	b.invalidateSeatsListCache(ctx)
+	b.invalidateRoomCache(ctx, seat.RoomId)
 
 	return nil
 }
@@ -279,7 +253,7 @@ func (b *business) UpdateSeat(ctx context.Context, id string, updates *entity.Up
 }
 
 func (b *business) DeleteSeat(ctx context.Context, id string) error {
-	_, err := b.repository.GetByID(ctx, id)
+	seat, err := b.repository.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return ErrSeatNotFound
@@ -292,6 +266,7 @@ func (b *business) DeleteSeat(ctx context.Context, id string) error {
 	}
 
 	b.invalidateSeatsListCache(ctx)
+	b.invalidateRoomCache(ctx, seat.RoomId)
 	_ = b.cache.Delete(ctx, keySeatDetail(id))
 
 	return nil
@@ -318,7 +293,18 @@ func (b *business) UpdateSeatStatus(ctx context.Context, id string, status entit
 	return nil
 }
 
+// InvalidateSeatCache drops every cached seat, for when seats change outside
+// this module.
+func (b *business) InvalidateSeatCache(ctx context.Context) {
+	b.invalidateSeatsListCache(ctx)
+}
+
 func (b *business) invalidateSeatsListCache(ctx context.Context) {
 	_ = caching.DeleteKeys(ctx, b.redisClient, keySeatsListPattern)
 	_ = caching.DeleteKeys(ctx, b.redisClient, keySeatDetailPattern)
 }
+
+func (b *business) invalidateRoomCache(ctx context.Context, roomId string) {
+	_ = b.cache.Delete(ctx, keyRoomDetail(roomId))
+	_ = b.cache.Delete(ctx, keyRoomsList)
+}
@@ -21,6 +21,14 @@ func keySeatDetail(id string) string {
 	return fmt.Sprintf("seat:detail:%s", id)
 }
 
+// keyRoomDetail and keyRoomsList are the room module's cache keys, dropped
+// when a seat change moves a room's capacity.
+func keyRoomDetail(roomId string) string {
+	return fmt.Sprintf("room:detail:%s", roomId)
+}
+
+const keyRoomsList = "rooms:list"
+
 func keySeatsListWithFilters(paging *paging.Paging, searchQuery, roomId, rowNumber string, seatType entity.SeatType, status entity.SeatStatus) string {
 	return fmt.Sprintf("seats:list:paging:page:%d:size:%d:search:%s:room:%s:row:%s:type:%s:status:%s",
 		paging.Limit, paging.Offset, searchQuery, roomId, rowNumber, seatType, status)
@@ -0,0 +1,86 @@
+package business
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// keySeatState is the hash booking-service keeps the seat holds and bookings
+// of a showtime in: seatId -> "STATE|owner|expiresAtMs". movie-service only
+// reads it, sweeping expired entries on the way.
+func keySeatState(showtimeId string) string {
+	return fmt.Sprintf("seat_state:{%s}", showtimeId)
+}
+
+const (
+	seatStateHeld   = "HELD"
+	seatStateBooked = "BOOKED"
+)
+
+// listSeatStatesScript returns the live entries of a showtime as flat
+// (seatId, state, expiresAtMs) triples and sweeps the expired ones.
+var listSeatStatesScript = redis.NewScript(`
+local t = redis.call("TIME")
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+local entries = redis.call("HGETALL", KEYS[1])
+local result = {}
+for i = 1, #entries, 2 do
+	local state, expires = string.match(entries[i + 1], "^(%u+)|[^|]*|(%d+)$")
+	if state and tonumber(expires) > now then
+		table.insert(result, entries[i])
+		table.insert(result, state)
+		table.insert(result, expires)
+	else
+		redis.call("HDEL", KEYS[1], entries[i])
+	end
+end
+return result
+`)
+
+type seatStateEntry struct {
+	State     string
+	ExpiresAt time.Time
+}
+
+func (b *business) getSeatStatesByShowtime(ctx context.Context, showtimeId string) (map[string]seatStateEntry, error) {
+	values, err := listSeatStatesScript.Run(ctx, b.redisClient, []string{keySeatState(showtimeId)}).StringSlice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get seat states: %w", err)
+	}
+
+	states := make(map[string]seatStateEntry, len(values)/3)
+	for i := 0; i+2 < len(values); i += 3 {
+		expiresAt, err := strconv.ParseInt(values[i+2], 10, 64)
+		if err != nil {
+			continue
+		}
+		states[values[i]] = seatStateEntry{
+			State:     values[i+1],
+			ExpiresAt: time.UnixMilli(expiresAt),
+		}
+	}
+
+	return states, nil
+}
+
+// HasHeldSeats reports whether any of the showtimes has a live seat hold.
+func (b *business) HasHeldSeats(ctx context.Context, showtimeIds []string) (bool, error) {
+	for _, showtimeId := range showtimeIds {
+		states, err := b.getSeatStatesByShowtime(ctx, showtimeId)
+		if err != nil {
+			return false, err
+		}
+
+		for _, entry := range states {
+			if entry.State == seatStateHeld {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
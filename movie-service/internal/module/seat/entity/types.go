@@ -1,6 +1,10 @@
 package entity
 
-import "movie-service/internal/pkg/paging"
+import (
+	"time"
+
+	"movie-service/internal/pkg/paging"
+)
 
 type CreateSeatRequest struct {
 	RoomId     string   `json:"room_id" binding:"required"`
@@ -77,9 +81,15 @@ func ToSeatsResponse(seats []*Seat, page, size, total int) *SeatsResponse {
 	}
 }
 
+type HeldSeat struct {
+	SeatId    string    `json:"seat_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
 type LockedSeatsResponse struct {
-	LockedSeatIds []string `json:"locked_seat_ids"`
-	BookedSeatIds []string `json:"booked_seat_ids"`
+	LockedSeatIds []string   `json:"locked_seat_ids"`
+	BookedSeatIds []string   `json:"booked_seat_ids"`
+	HeldSeats     []HeldSeat `json:"held_seats"`
 }
 
 func (req *CreateSeatRequest) ToSeat() *Seat {
@@ -2,6 +2,8 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -45,30 +47,55 @@ func (r *Repository) Create(ctx context.Context, seat *entity.Seat) error {
 	seat.CreatedAt = now
 	seat.UpdatedAt = &now
 
-	_, err := r.db.NewInsert().Model(seat).Exec(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to create seat: %w", err)
-	}
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewInsert().Model(seat).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to create seat: %w", err)
+		}
 
-	return nil
+		return adjustLayoutRoomCapacity(ctx, tx, seat.RoomId, 1)
+	})
 }
 
 func (r *Repository) Delete(ctx context.Context, id string) error {
-	result, err := r.db.NewDelete().
-		Model((*entity.Seat)(nil)).
-		Where("id = ?", id).
-		Exec(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to delete seat: %w", err)
-	}
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var roomId string
+		err := tx.NewSelect().
+			Model((*entity.Seat)(nil)).
+			Column("room_id").
+			Where("id = ?", id).
+			For("UPDATE").
+			Scan(ctx, &roomId)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("seat with id %s not found", id)
+			}
+			return fmt.Errorf("failed to get seat: %w", err)
+		}
+
+		_, err = tx.NewDelete().
+			Model((*entity.Seat)(nil)).
+			Where("id = ?", id).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete seat: %w", err)
+		}
+
+		return adjustLayoutRoomCapacity(ctx, tx, roomId, -1)
+	})
+}
 
-	rowsAffected, err := result.RowsAffected()
+// adjustLayoutRoomCapacity keeps the capacity of a room whose seats come from
+// a layout equal to its seat count. Other rooms keep the capacity staff set.
+func adjustLayoutRoomCapacity(ctx context.Context, tx bun.Tx, roomId string, delta int) error {
+	_, err := tx.NewUpdate().
+		Table("rooms").
+		Set("capacity = capacity + ?", delta).
+		Set("updated_at = ?", time.Now()).
+		Where("id = ?", roomId).
+		Where("layout IS NOT NULL").
+		Exec(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
-	}
-
-	if rowsAffected == 0 {
-		return fmt.Errorf("seat with id %s not found", id)
+		return fmt.Errorf("failed to update room capacity: %w", err)
 	}
 
 	return nil
@@ -104,6 +131,25 @@ func (r *Repository) GetByIDs(ctx context.Context, ids []string) ([]*entity.Seat
 	return seats, nil
 }
 
+func (r *Repository) GetByRoomAndRows(ctx context.Context, roomId string, rows []string) ([]*entity.Seat, error) {
+	if roomId == "" || len(rows) == 0 {
+		return []*entity.Seat{}, nil
+	}
+
+	var seats []*entity.Seat
+	err := r.roDb.NewSelect().
+		Model(&seats).
+		Where("room_id = ?", roomId).
+		Where("row_number IN (?)", bun.In(rows)).
+		OrderExpr("row_number ASC, seat_number ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get seats by rows: %w", err)
+	}
+
+	return seats, nil
+}
+
 func (r *Repository) GetMany(ctx context.Context, limit, offset int, search, roomId, rowNumber string, seatType entity.SeatType, status entity.SeatStatus) ([]*entity.Seat, error) {
 	query := r.roDb.NewSelect().Model((*entity.Seat)(nil))
 
@@ -26,6 +26,7 @@ type Room struct {
 
 	Id         string `bun:"id,pk" json:"id"`
 	RoomNumber int    `bun:"room_number" json:"room_number"`
+	CinemaId   string `bun:"cinema_id" json:"cinema_id"`
 	Capacity   int    `bun:"capacity" json:"capacity"`
 	RoomType   string `bun:"room_type" json:"room_type"`
 	Status     string `bun:"status" json:"status"`
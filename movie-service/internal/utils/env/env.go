@@ -26,7 +26,7 @@ func LoadOnce(inits ...func()) {
 func EnvsRequired(envs ...string) error {
 	for _, env := range envs {
 		if value := strings.TrimSpace(os.Getenv(env)); value == "" {
-			return fmt.Errorf("Required environment variable not set: " + env)
+			return fmt.Errorf("Required environment variable not set: %s", env)
 		}
 	}
 	return nil
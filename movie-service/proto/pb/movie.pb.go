@@ -240,6 +240,9 @@ type ShowtimeData struct {
 	RoomNumber      string                 `protobuf:"bytes,7,opt,name=room_number,json=roomNumber,proto3" json:"room_number,omitempty"`
 	SeatNumbers     []string               `protobuf:"bytes,8,rep,name=seat_numbers,json=seatNumbers,proto3" json:"seat_numbers,omitempty"`
 	DurationSeconds int64                  `protobuf:"varint,9,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Format          string                 `protobuf:"bytes,10,opt,name=format,proto3" json:"format,omitempty"`
+	SeatCapacity    int32                  `protobuf:"varint,11,opt,name=seat_capacity,json=seatCapacity,proto3" json:"seat_capacity,omitempty"`
+	CinemaId        string                 `protobuf:"bytes,12,opt,name=cinema_id,json=cinemaId,proto3" json:"cinema_id,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -337,12 +340,39 @@ func (x *ShowtimeData) GetDurationSeconds() int64 {
 	return 0
 }
 
+func (x *ShowtimeData) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ShowtimeData) GetSeatCapacity() int32 {
+	if x != nil {
+		return x.SeatCapacity
+	}
+	return 0
+}
+
+func (x *ShowtimeData) GetCinemaId() string {
+	if x != nil {
+		return x.CinemaId
+	}
+	return ""
+}
+
 type GetSeatsWithPriceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShowtimeId    string                 `protobuf:"bytes,1,opt,name=showtime_id,json=showtimeId,proto3" json:"showtime_id,omitempty"`
-	SeatIds       []string               `protobuf:"bytes,2,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ShowtimeId string                 `protobuf:"bytes,1,opt,name=showtime_id,json=showtimeId,proto3" json:"showtime_id,omitempty"`
+	SeatIds    []string               `protobuf:"bytes,2,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	// Returns the prices of this quote while it is valid.
+	QuoteId string `protobuf:"bytes,3,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	// Stores the prices as a quote valid this long, or re-arms quote_id.
+	QuoteTtlSeconds int64 `protobuf:"varint,4,opt,name=quote_ttl_seconds,json=quoteTtlSeconds,proto3" json:"quote_ttl_seconds,omitempty"`
+	// Ticket category code per seat id, e.g. CHILD. Seats left out are adult.
+	SeatCategories map[string]string `protobuf:"bytes,5,rep,name=seat_categories,json=seatCategories,proto3" json:"seat_categories,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetSeatsWithPriceRequest) Reset() {
@@ -389,14 +419,37 @@ func (x *GetSeatsWithPriceRequest) GetSeatIds() []string {
 	return nil
 }
 
+func (x *GetSeatsWithPriceRequest) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *GetSeatsWithPriceRequest) GetQuoteTtlSeconds() int64 {
+	if x != nil {
+		return x.QuoteTtlSeconds
+	}
+	return 0
+}
+
+func (x *GetSeatsWithPriceRequest) GetSeatCategories() map[string]string {
+	if x != nil {
+		return x.SeatCategories
+	}
+	return nil
+}
+
 type GetSeatsWithPriceResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data          []*SeatPriceData       `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
-	TotalAmount   float64                `protobuf:"fixed64,4,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data           []*SeatPriceData       `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	TotalAmount    float64                `protobuf:"fixed64,4,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	QuoteId        string                 `protobuf:"bytes,5,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	QuoteExpiresAt int64                  `protobuf:"varint,6,opt,name=quote_expires_at,json=quoteExpiresAt,proto3" json:"quote_expires_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetSeatsWithPriceResponse) Reset() {
@@ -457,16 +510,36 @@ func (x *GetSeatsWithPriceResponse) GetTotalAmount() float64 {
 	return 0
 }
 
+func (x *GetSeatsWithPriceResponse) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *GetSeatsWithPriceResponse) GetQuoteExpiresAt() int64 {
+	if x != nil {
+		return x.QuoteExpiresAt
+	}
+	return 0
+}
+
 type SeatPriceData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SeatId        string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
-	SeatNumber    string                 `protobuf:"bytes,2,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
-	SeatType      string                 `protobuf:"bytes,3,opt,name=seat_type,json=seatType,proto3" json:"seat_type,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	Available     bool                   `protobuf:"varint,5,opt,name=available,proto3" json:"available,omitempty"`
-	SeatRow       string                 `protobuf:"bytes,6,opt,name=seat_row,json=seatRow,proto3" json:"seat_row,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SeatId          string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	SeatNumber      string                 `protobuf:"bytes,2,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	SeatType        string                 `protobuf:"bytes,3,opt,name=seat_type,json=seatType,proto3" json:"seat_type,omitempty"`
+	Price           float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Available       bool                   `protobuf:"varint,5,opt,name=available,proto3" json:"available,omitempty"`
+	SeatRow         string                 `protobuf:"bytes,6,opt,name=seat_row,json=seatRow,proto3" json:"seat_row,omitempty"`
+	BasePrice       float64                `protobuf:"fixed64,7,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	Adjustments     []*PriceAdjustment     `protobuf:"bytes,8,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
+	TicketCategory  string                 `protobuf:"bytes,9,opt,name=ticket_category,json=ticketCategory,proto3" json:"ticket_category,omitempty"`
+	RequiresIdCheck bool                   `protobuf:"varint,10,opt,name=requires_id_check,json=requiresIdCheck,proto3" json:"requires_id_check,omitempty"`
+	MinAge          int32                  `protobuf:"varint,11,opt,name=min_age,json=minAge,proto3" json:"min_age,omitempty"`
+	MaxAge          int32                  `protobuf:"varint,12,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *SeatPriceData) Reset() {
@@ -541,6 +614,132 @@ func (x *SeatPriceData) GetSeatRow() string {
 	return ""
 }
 
+func (x *SeatPriceData) GetBasePrice() float64 {
+	if x != nil {
+		return x.BasePrice
+	}
+	return 0
+}
+
+func (x *SeatPriceData) GetAdjustments() []*PriceAdjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
+func (x *SeatPriceData) GetTicketCategory() string {
+	if x != nil {
+		return x.TicketCategory
+	}
+	return ""
+}
+
+func (x *SeatPriceData) GetRequiresIdCheck() bool {
+	if x != nil {
+		return x.RequiresIdCheck
+	}
+	return false
+}
+
+func (x *SeatPriceData) GetMinAge() int32 {
+	if x != nil {
+		return x.MinAge
+	}
+	return 0
+}
+
+func (x *SeatPriceData) GetMaxAge() int32 {
+	if x != nil {
+		return x.MaxAge
+	}
+	return 0
+}
+
+type PriceAdjustment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RuleId         string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	RuleName       string                 `protobuf:"bytes,2,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	RuleType       string                 `protobuf:"bytes,3,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	AdjustmentType string                 `protobuf:"bytes,4,opt,name=adjustment_type,json=adjustmentType,proto3" json:"adjustment_type,omitempty"`
+	Value          float64                `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`
+	Amount         float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PriceAdjustment) Reset() {
+	*x = PriceAdjustment{}
+	mi := &file_movie_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceAdjustment) ProtoMessage() {}
+
+func (x *PriceAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceAdjustment.ProtoReflect.Descriptor instead.
+func (*PriceAdjustment) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PriceAdjustment) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *PriceAdjustment) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *PriceAdjustment) GetRuleType() string {
+	if x != nil {
+		return x.RuleType
+	}
+	return ""
+}
+
+func (x *PriceAdjustment) GetAdjustmentType() string {
+	if x != nil {
+		return x.AdjustmentType
+	}
+	return ""
+}
+
+func (x *PriceAdjustment) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *PriceAdjustment) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
 type GetSeatDetailsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SeatIds       []string               `protobuf:"bytes,1,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
@@ -550,7 +749,7 @@ type GetSeatDetailsRequest struct {
 
 func (x *GetSeatDetailsRequest) Reset() {
 	*x = GetSeatDetailsRequest{}
-	mi := &file_movie_proto_msgTypes[8]
+	mi := &file_movie_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -562,7 +761,7 @@ func (x *GetSeatDetailsRequest) String() string {
 func (*GetSeatDetailsRequest) ProtoMessage() {}
 
 func (x *GetSeatDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_movie_proto_msgTypes[8]
+	mi := &file_movie_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -575,7 +774,7 @@ func (x *GetSeatDetailsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSeatDetailsRequest.ProtoReflect.Descriptor instead.
 func (*GetSeatDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_movie_proto_rawDescGZIP(), []int{8}
+	return file_movie_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetSeatDetailsRequest) GetSeatIds() []string {
@@ -596,7 +795,7 @@ type GetSeatDetailsResponse struct {
 
 func (x *GetSeatDetailsResponse) Reset() {
 	*x = GetSeatDetailsResponse{}
-	mi := &file_movie_proto_msgTypes[9]
+	mi := &file_movie_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -608,7 +807,7 @@ func (x *GetSeatDetailsResponse) String() string {
 func (*GetSeatDetailsResponse) ProtoMessage() {}
 
 func (x *GetSeatDetailsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_movie_proto_msgTypes[9]
+	mi := &file_movie_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -621,7 +820,7 @@ func (x *GetSeatDetailsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSeatDetailsResponse.ProtoReflect.Descriptor instead.
 func (*GetSeatDetailsResponse) Descriptor() ([]byte, []int) {
-	return file_movie_proto_rawDescGZIP(), []int{9}
+	return file_movie_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetSeatDetailsResponse) GetSuccess() bool {
@@ -651,13 +850,14 @@ type SeatDetailData struct {
 	SeatRow       string                 `protobuf:"bytes,2,opt,name=seat_row,json=seatRow,proto3" json:"seat_row,omitempty"`
 	SeatNumber    int32                  `protobuf:"varint,3,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
 	SeatType      string                 `protobuf:"bytes,4,opt,name=seat_type,json=seatType,proto3" json:"seat_type,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SeatDetailData) Reset() {
 	*x = SeatDetailData{}
-	mi := &file_movie_proto_msgTypes[10]
+	mi := &file_movie_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -669,7 +869,7 @@ func (x *SeatDetailData) String() string {
 func (*SeatDetailData) ProtoMessage() {}
 
 func (x *SeatDetailData) ProtoReflect() protoreflect.Message {
-	mi := &file_movie_proto_msgTypes[10]
+	mi := &file_movie_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -682,7 +882,7 @@ func (x *SeatDetailData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SeatDetailData.ProtoReflect.Descriptor instead.
 func (*SeatDetailData) Descriptor() ([]byte, []int) {
-	return file_movie_proto_rawDescGZIP(), []int{10}
+	return file_movie_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SeatDetailData) GetSeatId() string {
@@ -713,6 +913,67 @@ func (x *SeatDetailData) GetSeatType() string {
 	return ""
 }
 
+func (x *SeatDetailData) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// GetShowtimeSeatsRequest lists every seat in the given rows of the
+// showtime's room.
+type GetShowtimeSeatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShowtimeId    string                 `protobuf:"bytes,1,opt,name=showtime_id,json=showtimeId,proto3" json:"showtime_id,omitempty"`
+	SeatRows      []string               `protobuf:"bytes,2,rep,name=seat_rows,json=seatRows,proto3" json:"seat_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShowtimeSeatsRequest) Reset() {
+	*x = GetShowtimeSeatsRequest{}
+	mi := &file_movie_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShowtimeSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShowtimeSeatsRequest) ProtoMessage() {}
+
+func (x *GetShowtimeSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShowtimeSeatsRequest.ProtoReflect.Descriptor instead.
+func (*GetShowtimeSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_movie_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetShowtimeSeatsRequest) GetShowtimeId() string {
+	if x != nil {
+		return x.ShowtimeId
+	}
+	return ""
+}
+
+func (x *GetShowtimeSeatsRequest) GetSeatRows() []string {
+	if x != nil {
+		return x.SeatRows
+	}
+	return nil
+}
+
 var File_movie_proto protoreflect.FileDescriptor
 
 const file_movie_proto_rawDesc = "" +
@@ -729,7 +990,7 @@ const file_movie_proto_rawDesc = "" +
 	"\x14GetShowtimesResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
-	"\x04data\x18\x03 \x03(\v2\x10.pb.ShowtimeDataR\x04data\"\xac\x02\n" +
+	"\x04data\x18\x03 \x03(\v2\x10.pb.ShowtimeDataR\x04data\"\x86\x03\n" +
 	"\fShowtimeData\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bmovie_id\x18\x02 \x01(\tR\amovieId\x12\x17\n" +
@@ -741,16 +1002,28 @@ const file_movie_proto_rawDesc = "" +
 	"\vroom_number\x18\a \x01(\tR\n" +
 	"roomNumber\x12!\n" +
 	"\fseat_numbers\x18\b \x03(\tR\vseatNumbers\x12)\n" +
-	"\x10duration_seconds\x18\t \x01(\x03R\x0fdurationSeconds\"V\n" +
+	"\x10duration_seconds\x18\t \x01(\x03R\x0fdurationSeconds\x12\x16\n" +
+	"\x06format\x18\n" +
+	" \x01(\tR\x06format\x12#\n" +
+	"\rseat_capacity\x18\v \x01(\x05R\fseatCapacity\x12\x1b\n" +
+	"\tcinema_id\x18\f \x01(\tR\bcinemaId\"\xbb\x02\n" +
 	"\x18GetSeatsWithPriceRequest\x12\x1f\n" +
 	"\vshowtime_id\x18\x01 \x01(\tR\n" +
 	"showtimeId\x12\x19\n" +
-	"\bseat_ids\x18\x02 \x03(\tR\aseatIds\"\x99\x01\n" +
+	"\bseat_ids\x18\x02 \x03(\tR\aseatIds\x12\x19\n" +
+	"\bquote_id\x18\x03 \x01(\tR\aquoteId\x12*\n" +
+	"\x11quote_ttl_seconds\x18\x04 \x01(\x03R\x0fquoteTtlSeconds\x12Y\n" +
+	"\x0fseat_categories\x18\x05 \x03(\v20.pb.GetSeatsWithPriceRequest.SeatCategoriesEntryR\x0eseatCategories\x1aA\n" +
+	"\x13SeatCategoriesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xde\x01\n" +
 	"\x19GetSeatsWithPriceResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12%\n" +
 	"\x04data\x18\x03 \x03(\v2\x11.pb.SeatPriceDataR\x04data\x12!\n" +
-	"\ftotal_amount\x18\x04 \x01(\x01R\vtotalAmount\"\xb5\x01\n" +
+	"\ftotal_amount\x18\x04 \x01(\x01R\vtotalAmount\x12\x19\n" +
+	"\bquote_id\x18\x05 \x01(\tR\aquoteId\x12(\n" +
+	"\x10quote_expires_at\x18\x06 \x01(\x03R\x0equoteExpiresAt\"\x92\x03\n" +
 	"\rSeatPriceData\x12\x17\n" +
 	"\aseat_id\x18\x01 \x01(\tR\x06seatId\x12\x1f\n" +
 	"\vseat_number\x18\x02 \x01(\tR\n" +
@@ -758,24 +1031,45 @@ const file_movie_proto_rawDesc = "" +
 	"\tseat_type\x18\x03 \x01(\tR\bseatType\x12\x14\n" +
 	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1c\n" +
 	"\tavailable\x18\x05 \x01(\bR\tavailable\x12\x19\n" +
-	"\bseat_row\x18\x06 \x01(\tR\aseatRow\"2\n" +
+	"\bseat_row\x18\x06 \x01(\tR\aseatRow\x12\x1d\n" +
+	"\n" +
+	"base_price\x18\a \x01(\x01R\tbasePrice\x125\n" +
+	"\vadjustments\x18\b \x03(\v2\x13.pb.PriceAdjustmentR\vadjustments\x12'\n" +
+	"\x0fticket_category\x18\t \x01(\tR\x0eticketCategory\x12*\n" +
+	"\x11requires_id_check\x18\n" +
+	" \x01(\bR\x0frequiresIdCheck\x12\x17\n" +
+	"\amin_age\x18\v \x01(\x05R\x06minAge\x12\x17\n" +
+	"\amax_age\x18\f \x01(\x05R\x06maxAge\"\xbb\x01\n" +
+	"\x0fPriceAdjustment\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\x12\x1b\n" +
+	"\trule_name\x18\x02 \x01(\tR\bruleName\x12\x1b\n" +
+	"\trule_type\x18\x03 \x01(\tR\bruleType\x12'\n" +
+	"\x0fadjustment_type\x18\x04 \x01(\tR\x0eadjustmentType\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\x01R\x05value\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\"2\n" +
 	"\x15GetSeatDetailsRequest\x12\x19\n" +
 	"\bseat_ids\x18\x01 \x03(\tR\aseatIds\"t\n" +
 	"\x16GetSeatDetailsResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
-	"\x04data\x18\x03 \x03(\v2\x12.pb.SeatDetailDataR\x04data\"\x82\x01\n" +
+	"\x04data\x18\x03 \x03(\v2\x12.pb.SeatDetailDataR\x04data\"\x9a\x01\n" +
 	"\x0eSeatDetailData\x12\x17\n" +
 	"\aseat_id\x18\x01 \x01(\tR\x06seatId\x12\x19\n" +
 	"\bseat_row\x18\x02 \x01(\tR\aseatRow\x12\x1f\n" +
 	"\vseat_number\x18\x03 \x01(\x05R\n" +
 	"seatNumber\x12\x1b\n" +
-	"\tseat_type\x18\x04 \x01(\tR\bseatType2\xac\x02\n" +
+	"\tseat_type\x18\x04 \x01(\tR\bseatType\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\"W\n" +
+	"\x17GetShowtimeSeatsRequest\x12\x1f\n" +
+	"\vshowtime_id\x18\x01 \x01(\tR\n" +
+	"showtimeId\x12\x1b\n" +
+	"\tseat_rows\x18\x02 \x03(\tR\bseatRows2\xf9\x02\n" +
 	"\fMovieService\x12>\n" +
 	"\vGetShowtime\x12\x16.pb.GetShowtimeRequest\x1a\x17.pb.GetShowtimeResponse\x12A\n" +
 	"\fGetShowtimes\x12\x17.pb.GetShowtimesRequest\x1a\x18.pb.GetShowtimesResponse\x12P\n" +
 	"\x11GetSeatsWithPrice\x12\x1c.pb.GetSeatsWithPriceRequest\x1a\x1d.pb.GetSeatsWithPriceResponse\x12G\n" +
-	"\x0eGetSeatDetails\x12\x19.pb.GetSeatDetailsRequest\x1a\x1a.pb.GetSeatDetailsResponseB\x18Z\x16movie-service/proto/pbb\x06proto3"
+	"\x0eGetSeatDetails\x12\x19.pb.GetSeatDetailsRequest\x1a\x1a.pb.GetSeatDetailsResponse\x12K\n" +
+	"\x10GetShowtimeSeats\x12\x1b.pb.GetShowtimeSeatsRequest\x1a\x1a.pb.GetSeatDetailsResponseB\x18Z\x16movie-service/proto/pbb\x06proto3"
 
 var (
 	file_movie_proto_rawDescOnce sync.Once
@@ -789,7 +1083,7 @@ func file_movie_proto_rawDescGZIP() []byte {
 	return file_movie_proto_rawDescData
 }
 
-var file_movie_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_movie_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_movie_proto_goTypes = []any{
 	(*GetShowtimeRequest)(nil),        // 0: pb.GetShowtimeRequest
 	(*GetShowtimeResponse)(nil),       // 1: pb.GetShowtimeResponse
@@ -799,28 +1093,35 @@ var file_movie_proto_goTypes = []any{
 	(*GetSeatsWithPriceRequest)(nil),  // 5: pb.GetSeatsWithPriceRequest
 	(*GetSeatsWithPriceResponse)(nil), // 6: pb.GetSeatsWithPriceResponse
 	(*SeatPriceData)(nil),             // 7: pb.SeatPriceData
-	(*GetSeatDetailsRequest)(nil),     // 8: pb.GetSeatDetailsRequest
-	(*GetSeatDetailsResponse)(nil),    // 9: pb.GetSeatDetailsResponse
-	(*SeatDetailData)(nil),            // 10: pb.SeatDetailData
+	(*PriceAdjustment)(nil),           // 8: pb.PriceAdjustment
+	(*GetSeatDetailsRequest)(nil),     // 9: pb.GetSeatDetailsRequest
+	(*GetSeatDetailsResponse)(nil),    // 10: pb.GetSeatDetailsResponse
+	(*SeatDetailData)(nil),            // 11: pb.SeatDetailData
+	(*GetShowtimeSeatsRequest)(nil),   // 12: pb.GetShowtimeSeatsRequest
+	nil,                               // 13: pb.GetSeatsWithPriceRequest.SeatCategoriesEntry
 }
 var file_movie_proto_depIdxs = []int32{
 	4,  // 0: pb.GetShowtimeResponse.data:type_name -> pb.ShowtimeData
 	4,  // 1: pb.GetShowtimesResponse.data:type_name -> pb.ShowtimeData
-	7,  // 2: pb.GetSeatsWithPriceResponse.data:type_name -> pb.SeatPriceData
-	10, // 3: pb.GetSeatDetailsResponse.data:type_name -> pb.SeatDetailData
-	0,  // 4: pb.MovieService.GetShowtime:input_type -> pb.GetShowtimeRequest
-	2,  // 5: pb.MovieService.GetShowtimes:input_type -> pb.GetShowtimesRequest
-	5,  // 6: pb.MovieService.GetSeatsWithPrice:input_type -> pb.GetSeatsWithPriceRequest
-	8,  // 7: pb.MovieService.GetSeatDetails:input_type -> pb.GetSeatDetailsRequest
-	1,  // 8: pb.MovieService.GetShowtime:output_type -> pb.GetShowtimeResponse
-	3,  // 9: pb.MovieService.GetShowtimes:output_type -> pb.GetShowtimesResponse
-	6,  // 10: pb.MovieService.GetSeatsWithPrice:output_type -> pb.GetSeatsWithPriceResponse
-	9,  // 11: pb.MovieService.GetSeatDetails:output_type -> pb.GetSeatDetailsResponse
-	8,  // [8:12] is the sub-list for method output_type
-	4,  // [4:8] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	13, // 2: pb.GetSeatsWithPriceRequest.seat_categories:type_name -> pb.GetSeatsWithPriceRequest.SeatCategoriesEntry
+	7,  // 3: pb.GetSeatsWithPriceResponse.data:type_name -> pb.SeatPriceData
+	8,  // 4: pb.SeatPriceData.adjustments:type_name -> pb.PriceAdjustment
+	11, // 5: pb.GetSeatDetailsResponse.data:type_name -> pb.SeatDetailData
+	0,  // 6: pb.MovieService.GetShowtime:input_type -> pb.GetShowtimeRequest
+	2,  // 7: pb.MovieService.GetShowtimes:input_type -> pb.GetShowtimesRequest
+	5,  // 8: pb.MovieService.GetSeatsWithPrice:input_type -> pb.GetSeatsWithPriceRequest
+	9,  // 9: pb.MovieService.GetSeatDetails:input_type -> pb.GetSeatDetailsRequest
+	12, // 10: pb.MovieService.GetShowtimeSeats:input_type -> pb.GetShowtimeSeatsRequest
+	1,  // 11: pb.MovieService.GetShowtime:output_type -> pb.GetShowtimeResponse
+	3,  // 12: pb.MovieService.GetShowtimes:output_type -> pb.GetShowtimesResponse
+	6,  // 13: pb.MovieService.GetSeatsWithPrice:output_type -> pb.GetSeatsWithPriceResponse
+	10, // 14: pb.MovieService.GetSeatDetails:output_type -> pb.GetSeatDetailsResponse
+	10, // 15: pb.MovieService.GetShowtimeSeats:output_type -> pb.GetSeatDetailsResponse
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_movie_proto_init() }
@@ -834,7 +1135,7 @@ func file_movie_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_movie_proto_rawDesc), len(file_movie_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -9,3 +9,7 @@ func notificationTopic(userId string) string {
 func bookingNotificationTopic(userId string) string {
 	return fmt.Sprintf("booking_%s", userId)
 }
+
+func seatMapTopic(showtimeId string) string {
+	return fmt.Sprintf("seat_map_%s", showtimeId)
+}
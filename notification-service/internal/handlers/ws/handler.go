@@ -139,3 +139,82 @@ func (h *WebSocketHandler) notificatonHandler(ctx *WSContext, request *WSRequest
 		Error:  nil,
 	}, nil
 }
+
+// seatMapHandler streams seat state diffs (held, released, booked) of a
+// showtime to the client until the connection closes.
+func (h *WebSocketHandler) seatMapHandler(ctx *WSContext, request *WSRequest) (*WSResponse, error) {
+	if request.Id <= 0 {
+		return &WSResponse{
+			Id:     request.Id,
+			Result: nil,
+			Error:  &WSError{Code: 400, Message: "Invalid request Id"},
+		}, nil
+	}
+
+	params := make(map[string]interface{})
+	if err := json.Unmarshal(request.Params, &params); err != nil {
+		return &WSResponse{
+			Id:     request.Id,
+			Result: nil,
+			Error:  &WSError{Code: 400, Message: "Invalid params"},
+		}, nil
+	}
+
+	showtimeId, ok := params["showtimeId"].(string)
+	if !ok || showtimeId == "" {
+		return &WSResponse{
+			Id:     request.Id,
+			Result: nil,
+			Error:  &WSError{Code: 400, Message: "Missing or invalid showtimeId"},
+		}, nil
+	}
+
+	subscriber, err := h.pubsub.Subscribe(ctx.Context(), []string{seatMapTopic(showtimeId)}, types.UnmarshalSeatMapMessage)
+	if err != nil {
+		return &WSResponse{
+			Id:     request.Id,
+			Result: nil,
+			Error:  &WSError{Code: 500, Message: "Failed to subscribe to topic"},
+		}, err
+	}
+
+	go func() {
+		defer func() {
+			_ = subscriber.Unsubscribe(ctx.Context())
+		}()
+
+		messageChan := subscriber.MessageChan()
+		for {
+			select {
+			case <-ctx.Context().Done():
+				return
+			case msg, ok := <-messageChan:
+				if !ok {
+					return
+				}
+
+				change, ok := msg.Data.(json.RawMessage)
+				if !ok {
+					continue
+				}
+
+				responseData, _ := json.Marshal(map[string]interface{}{
+					"type": "seat_map",
+					"data": change,
+				})
+
+				ctx.WSConn.sendMessage(&WSResponse{
+					Id:     request.Id,
+					Result: responseData,
+					Error:  nil,
+				})
+			}
+		}
+	}()
+
+	return &WSResponse{
+		Id:     request.Id,
+		Result: json.RawMessage(`{"status": "success", "message": "Subscribed to seat map"}`),
+		Error:  nil,
+	}, nil
+}
@@ -180,6 +180,13 @@ func (wsc *WSConnection) handleRequests() error {
 				return err
 			}
 
+			wsc.sendMessage(response)
+		case "SEAT_MAP":
+			response, err := wsc.handler.seatMapHandler(ctx, request)
+			if err != nil {
+				return err
+			}
+
 			wsc.sendMessage(response)
 		default:
 			return fmt.Errorf("websocket connection does not support this method")
@@ -18,6 +18,7 @@ const (
 	NotificationForgotPassword NotificationTitle = "Forgot Password"
 	NotificationEmailVerified  NotificationTitle = "Email Verified"
 	NotificationBookingSuccess NotificationTitle = "Booking Success"
+	NotificationWaitlistOffer  NotificationTitle = "Seats Available"
 )
 
 type Notification struct {
@@ -83,6 +83,12 @@ var emailTemplates = map[string]emailTemplate{
 		NotiTitle:   models.NotificationBookingSuccess,
 		NotiContent: "Scan the bar code to get tickets",
 	},
+	"waitlist_offer": {
+		Subject:     "Seats are available for your showtime",
+		BodyFunc:    waitlistOfferBody,
+		NotiTitle:   models.NotificationWaitlistOffer,
+		NotiContent: "Seats opened up for a showtime you are waiting for",
+	},
 	"staff_welcome": {
 		Subject:     "Welcome to HQ Cinema Staff",
 		BodyFunc:    staffWelcomeBody,
@@ -109,6 +115,11 @@ func (e *EmailService) SubscribeEmailNotificationQueue(ctx context.Context) erro
 			UnmarshalFn: types.UnmarshalBookingSuccess,
 			HandleFn:    e.handleTemplatedEmail,
 		},
+		{
+			Topics:      []string{"waitlist_offer"},
+			UnmarshalFn: types.UnmarshalWaitlistOffer,
+			HandleFn:    e.handleTemplatedEmail,
+		},
 		{
 			Topics:      []string{"staff_welcome"},
 			UnmarshalFn: types.UnmarshalStaffWelcome,
@@ -200,6 +211,8 @@ func extractToEmail(msg *pubsub.Message) string {
 			return data.To
 		}
 		return data.UserEmail
+	case *types.WaitlistOfferMessage:
+		return data.To
 	case *types.StaffWelcomeMessage:
 		return data.To
 	}
@@ -212,6 +225,8 @@ func extractUserID(msg *pubsub.Message) string {
 		return data.UserId
 	case *types.BookingSuccessMessage:
 		return data.UserId
+	case *types.WaitlistOfferMessage:
+		return data.UserId
 	case *types.StaffWelcomeMessage:
 		return data.UserId
 	}
@@ -267,6 +282,11 @@ func bookingSuccessBody(data any) string {
 	return renderBookingSuccess(m)
 }
 
+func waitlistOfferBody(data any) string {
+	m := data.(*types.WaitlistOfferMessage)
+	return renderWaitlistOffer(m)
+}
+
 func staffWelcomeBody(data any) string {
 	m := data.(*types.StaffWelcomeMessage)
 	return renderStaffWelcome(m)
@@ -315,6 +335,24 @@ func renderBookingSuccess(m *types.BookingSuccessMessage) string {
 	`, m.BookingId, barcodeURL, showtime, seats))
 }
 
+func renderWaitlistOffer(m *types.WaitlistOfferMessage) string {
+	expiresAt := time.Unix(m.ExpiresAt, 0).Format("15:04 02/01/2006")
+	showtime := renderShowtime(&m.Showtime)
+
+	return emailTemplateHTML("🍿 Seats Are Available!", fmt.Sprintf(`
+		<p>Good news! %d seat(s) opened up for a showtime you are on the waitlist for.</p>
+		<p>We are holding them for you. Complete your booking with the code below.</p>
+		<div class="code-block">
+			<h3>Your Hold Code</h3>
+			<p class="code">%s</p>
+		</div>
+		%s
+		<div class="tip">
+			<strong>⏰ Hurry:</strong> This offer expires at %s, after which the seats go to the next person in line.
+		</div>
+	`, len(m.SeatIds), m.HoldId, showtime, expiresAt))
+}
+
 func renderStaffWelcome(m *types.StaffWelcomeMessage) string {
 	return emailTemplateHTML("Welcome to HQ Cinema Team!", fmt.Sprintf(`
 		<p>Welcome aboard, <strong>%s</strong>!</p>
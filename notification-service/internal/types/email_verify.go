@@ -65,6 +65,17 @@ type StaffWelcomeMessage struct {
 	Role     string `json:"role"`
 }
 
+type WaitlistOfferMessage struct {
+	UserId     string                `json:"user_id"`
+	To         string                `json:"to"`
+	EntryId    string                `json:"entry_id"`
+	ShowtimeId string                `json:"showtime_id"`
+	HoldId     string                `json:"hold_id"`
+	SeatIds    []string              `json:"seat_ids"`
+	ExpiresAt  int64                 `json:"expires_at"`
+	Showtime   BookingShowtimeDetail `json:"showtime"`
+}
+
 func UnmarshalEmailVerify(data []byte) (interface{}, error) {
 	emailVerify := new(EmailVerifyMessage)
 	if err := json.Unmarshal(data, emailVerify); err != nil {
@@ -109,3 +120,20 @@ func UnmarshalStaffWelcome(data []byte) (interface{}, error) {
 	}
 	return staffWelcome, nil
 }
+
+func UnmarshalWaitlistOffer(data []byte) (interface{}, error) {
+	var wrapper map[string]json.RawMessage
+	if err := json.Unmarshal(data, &wrapper); err != nil {
+		return nil, err
+	}
+
+	if nested, ok := wrapper["Data"]; ok {
+		data = nested
+	}
+
+	waitlistOffer := new(WaitlistOfferMessage)
+	if err := json.Unmarshal(data, waitlistOffer); err != nil {
+		return nil, err
+	}
+	return waitlistOffer, nil
+}
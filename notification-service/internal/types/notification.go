@@ -158,3 +158,15 @@ func UnmarshalGenericMessage(data []byte) (interface{}, error) {
 	}
 	return msg, nil
 }
+
+// UnmarshalSeatMapMessage extracts the seat state diff published to a
+// showtime's seat map topic, leaving it untouched for relaying to clients.
+func UnmarshalSeatMapMessage(data []byte) (interface{}, error) {
+	var msg struct {
+		Data json.RawMessage `json:"Data"`
+	}
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return nil, err
+	}
+	return msg.Data, nil
+}
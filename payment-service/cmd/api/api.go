@@ -1,11 +1,17 @@
 package main
 
 import (
+	"time"
+
 	"payment-service/internal/container"
+	grpcRepo "payment-service/internal/module/payment/repository/grpc"
 	"payment-service/internal/module/payment/transport/rest"
+	"payment-service/internal/utils/env"
 	"payment-service/middleware"
 
 	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"github.com/samber/do"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
@@ -43,12 +49,39 @@ func startRouteV1(group *gin.RouterGroup) {
 		panic(err)
 	}
 
+	redisClient, err := do.InvokeNamed[redis.UniversalClient](i, "redis-db")
+	if err != nil {
+		panic(err)
+	}
+	authClient, err := do.Invoke[*grpcRepo.AuthGrpcClient](i)
+	if err != nil {
+		panic(err)
+	}
+	requireAuth := middleware.RequireAuth(authClient)
+	idempotency := middleware.Idempotency(redisClient, env.GetDuration("IDEMPOTENCY_KEY_TTL", 24*time.Hour))
+
+	// Idempotency keys are scoped by user, so routes that accept them
+	// require auth to know who the caller is.
 	payments := group.Group("/payments")
 	{
-		payments.POST("", paymentApi.CreatePayment)
+		payments.POST("", requireAuth, idempotency, paymentApi.CreatePayment)
 		payments.GET("/booking/:bookingId", paymentApi.GetPaymentByBookingId)
 		payments.POST("/crypto/verify", paymentApi.VerifyCryptoPayment)
 		payments.POST("/webhooks/sepay", paymentApi.SePayWebhook)
-		payments.PATCH("/:paymentId/confirm", paymentApi.ConfirmPayment)
+		payments.PATCH("/:paymentId/confirm", requireAuth, paymentApi.ConfirmPayment)
+		payments.GET("/booking/:bookingId/adjustments", requireAuth, paymentApi.GetPaymentAdjustmentsByBookingId)
+		payments.PATCH("/adjustments/:adjustmentId/confirm", requireAuth, paymentApi.ConfirmPaymentAdjustment)
+		payments.POST("/booking/:bookingId/gift-card", requireAuth, idempotency, paymentApi.PayWithGiftCard)
+		payments.DELETE("/booking/:bookingId/gift-card", requireAuth, paymentApi.ReleaseGiftCards)
+	}
+
+	giftCards := group.Group("/gift-cards")
+	{
+		giftCards.POST("", requireAuth, idempotency, paymentApi.IssueGiftCard)
+		giftCards.GET("/code/:code", requireAuth, paymentApi.GetGiftCardByCode)
+		giftCards.GET("/:giftCardId", requireAuth, paymentApi.GetGiftCardById)
+		giftCards.GET("/:giftCardId/transactions", requireAuth, paymentApi.GetGiftCardTransactions)
+		giftCards.POST("/:giftCardId/top-up", requireAuth, idempotency, paymentApi.TopUpGiftCard)
+		giftCards.POST("/:giftCardId/void", requireAuth, idempotency, paymentApi.VoidGiftCard)
 	}
 }
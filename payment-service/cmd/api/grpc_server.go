@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+	"net"
+
+	"payment-service/internal/container"
+	"payment-service/internal/module/payment/business"
+	"payment-service/internal/module/payment/transport/grpc"
+
+	pb "payment-service/proto/pb"
+
+	"github.com/samber/do"
+	"github.com/urfave/cli/v2"
+	grpc_server "google.golang.org/grpc"
+)
+
+func ServeGRPC() *cli.Command {
+	return &cli.Command{
+		Name:  "grpc",
+		Usage: "start the gRPC server",
+		Action: func(c *cli.Context) error {
+			i := container.NewContainer()
+
+			paymentBiz, err := do.Invoke[business.PaymentBiz](i)
+			if err != nil {
+				return fmt.Errorf("failed to create payment business: %w", err)
+			}
+
+			s := grpc_server.NewServer()
+
+			grpcServer := grpc.NewPaymentGRPCServer(paymentBiz)
+			pb.RegisterPaymentServiceServer(s, grpcServer)
+
+			lis, err := net.Listen("tcp", ":50086")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println("payment service gRPC listening on port 50086")
+			return s.Serve(lis)
+		},
+	}
+}
@@ -49,10 +49,13 @@ func NewContainer() *do.Injector {
 	do.Provide(injector, provideReadisCacheReadOnly)
 	do.Provide(injector, provideRedisPubsub)
 	do.Provide(injector, provideOutboxClient)
+	do.Provide(injector, grpcRepo.NewAuthGrpcClient)
 
 	// Payment module
 	do.Provide(injector, providePaymentRepository)
 	do.Provide(injector, providePaymentBusiness)
+	do.Provide(injector, provideGiftCardRepository)
+	do.Provide(injector, provideGiftCardBusiness)
 
 	return injector
 }
@@ -211,3 +214,15 @@ func provideOutboxClient(_ *do.Injector) (*grpcRepo.OutboxClient, error) {
 func providePaymentBusiness(i *do.Injector) (business.PaymentBiz, error) {
 	return business.NewPaymentBiz(i)
 }
+
+func provideGiftCardRepository(i *do.Injector) (repository.GiftCardRepository, error) {
+	db, err := do.Invoke[*bun.DB](i)
+	if err != nil {
+		return nil, err
+	}
+	return repository.NewGiftCardRepository(db), nil
+}
+
+func provideGiftCardBusiness(i *do.Injector) (business.GiftCardBiz, error) {
+	return business.NewGiftCardBiz(i)
+}
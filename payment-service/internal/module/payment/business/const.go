@@ -16,3 +16,7 @@ const (
 	CACHE_TTL_12_HOUR = 12 * time.Hour
 	CACHE_TTL_1_DAY   = 24 * time.Hour
 )
+
+// giftCardValidity is how long a gift card lasts when issued without an
+// explicit expiry.
+const giftCardValidity = 365 * 24 * time.Hour
@@ -0,0 +1,245 @@
+package business
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"payment-service/internal/module/payment/entity"
+	repository "payment-service/internal/module/payment/repository/postgres"
+
+	"github.com/google/uuid"
+	"github.com/samber/do"
+	"github.com/uptrace/bun"
+)
+
+var (
+	ErrInvalidGiftCardAmount = errors.New("gift card amount must be positive")
+	ErrInvalidGiftCardExpiry = errors.New("gift card expiry must be in the future")
+	ErrGiftCardNotFound      = errors.New("gift card not found")
+	ErrGiftCardNotUsable     = errors.New("gift card is voided or expired")
+	ErrGiftCardEmpty         = errors.New("gift card has no balance left")
+)
+
+type GiftCardBiz interface {
+	IssueGiftCard(ctx context.Context, amount float64, expiresAt *time.Time) (*entity.GiftCard, error)
+	GetGiftCardById(ctx context.Context, id string) (*entity.GiftCard, error)
+	GetGiftCardByCode(ctx context.Context, code string) (*entity.GiftCard, error)
+	GetGiftCardTransactions(ctx context.Context, id string) ([]*entity.GiftCardTransaction, error)
+	TopUpGiftCard(ctx context.Context, id string, amount float64, reason string) (*entity.GiftCard, error)
+	VoidGiftCard(ctx context.Context, id string, reason string) (*entity.GiftCard, error)
+}
+
+type giftCardBiz struct {
+	db   *bun.DB
+	repo repository.GiftCardRepository
+}
+
+func NewGiftCardBiz(i *do.Injector) (GiftCardBiz, error) {
+	db, err := do.Invoke[*bun.DB](i)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := do.Invoke[repository.GiftCardRepository](i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &giftCardBiz{
+		db:   db,
+		repo: repo,
+	}, nil
+}
+
+func (b *giftCardBiz) IssueGiftCard(ctx context.Context, amount float64, expiresAt *time.Time) (*entity.GiftCard, error) {
+	amount = roundAmount(amount)
+	if amount <= 0 {
+		return nil, ErrInvalidGiftCardAmount
+	}
+
+	now := time.Now()
+	expiry := now.Add(giftCardValidity)
+	if expiresAt != nil {
+		if !expiresAt.After(now) {
+			return nil, ErrInvalidGiftCardExpiry
+		}
+		expiry = *expiresAt
+	}
+
+	card := &entity.GiftCard{
+		Id:             uuid.New().String(),
+		Code:           newGiftCardCode(),
+		InitialBalance: amount,
+		Balance:        amount,
+		Status:         entity.GiftCardStatusActive,
+		ExpiresAt:      expiry,
+		CreatedAt:      now,
+	}
+
+	err := b.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := b.repo.Create(ctx, tx, card); err != nil {
+			return fmt.Errorf("failed to create gift card: %w", err)
+		}
+
+		return b.repo.CreateTransaction(ctx, tx, &entity.GiftCardTransaction{
+			Id:           uuid.New().String(),
+			GiftCardId:   card.Id,
+			Type:         entity.GiftCardTransactionIssue,
+			Amount:       amount,
+			BalanceAfter: amount,
+			CreatedAt:    now,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return card, nil
+}
+
+func (b *giftCardBiz) GetGiftCardById(ctx context.Context, id string) (*entity.GiftCard, error) {
+	card, err := b.repo.GetById(ctx, id)
+	if err != nil {
+		return nil, giftCardLookupError(err)
+	}
+	return card, nil
+}
+
+func (b *giftCardBiz) GetGiftCardByCode(ctx context.Context, code string) (*entity.GiftCard, error) {
+	card, err := b.repo.GetByCode(ctx, normalizeGiftCardCode(code))
+	if err != nil {
+		return nil, giftCardLookupError(err)
+	}
+	return card, nil
+}
+
+func (b *giftCardBiz) GetGiftCardTransactions(ctx context.Context, id string) ([]*entity.GiftCardTransaction, error) {
+	if _, err := b.GetGiftCardById(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return b.repo.GetTransactions(ctx, id)
+}
+
+func (b *giftCardBiz) TopUpGiftCard(ctx context.Context, id string, amount float64, reason string) (*entity.GiftCard, error) {
+	amount = roundAmount(amount)
+	if amount <= 0 {
+		return nil, ErrInvalidGiftCardAmount
+	}
+
+	var card *entity.GiftCard
+	err := b.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var err error
+		card, err = b.repo.LockById(ctx, tx, id)
+		if err != nil {
+			return giftCardLookupError(err)
+		}
+
+		if !card.IsUsable(time.Now()) {
+			return ErrGiftCardNotUsable
+		}
+
+		return postGiftCardTransaction(ctx, tx, b.repo, card, entity.GiftCardTransactionTopUp, amount, nil, reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return card, nil
+}
+
+// VoidGiftCard closes a card and writes off what is left on it. Voiding a
+// card twice is a no-op.
+func (b *giftCardBiz) VoidGiftCard(ctx context.Context, id string, reason string) (*entity.GiftCard, error) {
+	var card *entity.GiftCard
+	err := b.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var err error
+		card, err = b.repo.LockById(ctx, tx, id)
+		if err != nil {
+			return giftCardLookupError(err)
+		}
+
+		if card.Status == entity.GiftCardStatusVoided {
+			return nil
+		}
+
+		if err = postGiftCardTransaction(ctx, tx, b.repo, card, entity.GiftCardTransactionVoid, -card.Balance, nil, reason); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		card.Status = entity.GiftCardStatusVoided
+		card.VoidedAt = &now
+
+		return b.repo.UpdateFields(ctx, tx, card.Id, map[string]interface{}{
+			"status":    card.Status,
+			"voided_at": now,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return card, nil
+}
+
+// postGiftCardTransaction moves a locked card's balance by amount and records
+// it in the card's ledger, against the payment if one is given.
+func postGiftCardTransaction(ctx context.Context, tx bun.Tx, repo repository.GiftCardRepository, card *entity.GiftCard, transactionType entity.GiftCardTransactionType, amount float64, payment *entity.Payment, reason string) error {
+	now := time.Now()
+	card.Balance = roundAmount(card.Balance + amount)
+	card.UpdatedAt = &now
+
+	err := repo.UpdateFields(ctx, tx, card.Id, map[string]interface{}{
+		"balance":    card.Balance,
+		"updated_at": now,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update gift card balance: %w", err)
+	}
+
+	transaction := &entity.GiftCardTransaction{
+		Id:           uuid.New().String(),
+		GiftCardId:   card.Id,
+		Type:         transactionType,
+		Amount:       amount,
+		BalanceAfter: card.Balance,
+		Reason:       reason,
+		CreatedAt:    now,
+	}
+	if payment != nil {
+		transaction.PaymentId = payment.Id
+		transaction.BookingId = payment.BookingId
+	}
+
+	if err = repo.CreateTransaction(ctx, tx, transaction); err != nil {
+		return fmt.Errorf("failed to create gift card transaction: %w", err)
+	}
+
+	return nil
+}
+
+func giftCardLookupError(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrGiftCardNotFound
+	}
+	return fmt.Errorf("failed to get gift card: %w", err)
+}
+
+// newGiftCardCode returns a random 16 character code to print on the card.
+func newGiftCardCode() string {
+	return strings.ToUpper(strings.ReplaceAll(uuid.New().String(), "-", "")[:16])
+}
+
+func normalizeGiftCardCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func roundAmount(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -2,11 +2,12 @@ package business
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
 	"payment-service/internal/module/payment/entity"
-	grpcRepo "payment-service/internal/module/payment/repository/grpc"
 	repository "payment-service/internal/module/payment/repository/postgres"
 	"payment-service/internal/module/payment/service"
 	"payment-service/internal/pkg/pubsub"
@@ -16,19 +17,35 @@ import (
 	"github.com/uptrace/bun"
 )
 
+var (
+	ErrStaffSessionRequired = errors.New("staff session is required for cash payments")
+	ErrStaffSessionNotOpen  = errors.New("staff session is not open")
+	ErrStaffSessionNotOwned = errors.New("staff session belongs to another staff member")
+	ErrPaymentNotPending    = errors.New("payment is not pending")
+	ErrBookingNotFound      = errors.New("booking not found")
+	ErrBookingAccessDenied  = errors.New("booking does not belong to user")
+)
+
 type PaymentBiz interface {
 	CreatePayment(ctx context.Context, bookingId string, amount float64) (*entity.Payment, error)
 	GetPaymentByBookingId(ctx context.Context, bookingId string) (*entity.Payment, error)
 	ProcessSePayWebhook(ctx context.Context, webhook *entity.SePayWebhook) error
 	VerifyCryptoPayment(ctx context.Context, req *entity.CryptoVerificationRequest) error
-	ConfirmPayment(ctx context.Context, paymentId string, paymentMethod entity.PaymentMethod) error
+	ConfirmPayment(ctx context.Context, paymentId string, paymentMethod entity.PaymentMethod, staffSessionId, staffId string) error
+	CreatePaymentAdjustment(ctx context.Context, bookingId, referenceId string, amount float64, reason string) (*entity.PaymentAdjustment, error)
+	GetPaymentAdjustmentsByBookingId(ctx context.Context, bookingId string) ([]*entity.PaymentAdjustment, error)
+	ConfirmPaymentAdjustment(ctx context.Context, adjustmentId string, paymentMethod entity.PaymentMethod, staffSessionId, staffId string) error
+	PayWithGiftCard(ctx context.Context, bookingId, code string, amount float64) (*entity.Payment, error)
+	ReleaseGiftCards(ctx context.Context, bookingId string) (float64, error)
+	ForceCompletePayment(ctx context.Context, bookingId string, amount float64, reason string) (*entity.Payment, error)
+	CheckBookingOwner(ctx context.Context, bookingId, userId string) error
 }
 
 type paymentBiz struct {
 	container         *do.Injector
 	db                *bun.DB
 	repo              repository.PaymentRepository
-	outboxClient      *grpcRepo.OutboxClient
+	giftCardRepo      repository.GiftCardRepository
 	blockchainService service.BlockchainService
 	pubsub            pubsub.PubSub
 }
@@ -44,7 +61,7 @@ func NewPaymentBiz(i *do.Injector) (PaymentBiz, error) {
 		return nil, err
 	}
 
-	outboxClient, err := do.Invoke[*grpcRepo.OutboxClient](i)
+	giftCardRepo, err := do.Invoke[repository.GiftCardRepository](i)
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +80,7 @@ func NewPaymentBiz(i *do.Injector) (PaymentBiz, error) {
 		container:         i,
 		db:                db,
 		repo:              repo,
-		outboxClient:      outboxClient,
+		giftCardRepo:      giftCardRepo,
 		blockchainService: blockchainService,
 		pubsub:            pubsubClient,
 	}, nil
@@ -118,8 +135,13 @@ func (b *paymentBiz) ProcessSePayWebhook(ctx context.Context, webhook *entity.Se
 		return fmt.Errorf("payment already completed with different transaction")
 	}
 
-	if webhook.TransferAmount != payment.Amount {
-		return fmt.Errorf("amount mismatch: expected %.2f, got %.2f", payment.Amount, webhook.TransferAmount)
+	if payment.Status == entity.PaymentStatusExpired {
+		return fmt.Errorf("payment for booking %s has expired", payment.BookingId)
+	}
+
+	// Whatever was paid from gift cards is not transferred.
+	if amountDue := amountDue(payment); webhook.TransferAmount != amountDue {
+		return fmt.Errorf("amount mismatch: expected %.2f, got %.2f", amountDue, webhook.TransferAmount)
 	}
 
 	payload, err := webhook.ToPayload()
@@ -128,13 +150,14 @@ func (b *paymentBiz) ProcessSePayWebhook(ctx context.Context, webhook *entity.Se
 	}
 
 	eventData := map[string]interface{}{
-		"payment_id":     payment.Id,
-		"booking_id":     payment.BookingId,
-		"amount":         payment.Amount,
-		"status":         entity.PaymentStatusCompleted,
-		"payment_method": entity.PaymentMethodBankTransfer,
-		"transaction_id": transactionId,
-		"timestamp":      time.Now().Unix(),
+		"payment_id":       payment.Id,
+		"booking_id":       payment.BookingId,
+		"amount":           payment.Amount,
+		"gift_card_amount": payment.GiftCardAmount,
+		"status":           entity.PaymentStatusCompleted,
+		"payment_method":   entity.PaymentMethodBankTransfer,
+		"transaction_id":   transactionId,
+		"timestamp":        time.Now().Unix(),
 	}
 
 	fields := map[string]interface{}{
@@ -145,11 +168,13 @@ func (b *paymentBiz) ProcessSePayWebhook(ctx context.Context, webhook *entity.Se
 		"updated_at":     time.Now(),
 	}
 
-	if err = b.repo.UpdatePaymentFields(ctx, b.db, payment.Id, fields); err != nil {
-		return err
-	}
+	return b.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := b.repo.UpdatePaymentFields(ctx, tx, payment.Id, fields); err != nil {
+			return err
+		}
 
-	return b.outboxClient.CreateOutboxEvent(ctx, string(entity.EventTypePaymentCompleted), eventData)
+		return b.repo.CreateOutboxEvent(ctx, tx, entity.EventTypePaymentCompleted, eventData)
+	})
 }
 
 func (b *paymentBiz) VerifyCryptoPayment(ctx context.Context, req *entity.CryptoVerificationRequest) error {
@@ -162,24 +187,40 @@ func (b *paymentBiz) VerifyCryptoPayment(ctx context.Context, req *entity.Crypto
 		return err
 	}
 
-	_ = b.repo.UpdatePaymentFields(ctx, b.db, payment.Id, map[string]interface{}{
+	if payment.Status == entity.PaymentStatusExpired {
+		return fmt.Errorf("payment for booking %s has expired", payment.BookingId)
+	}
+
+	fields := map[string]interface{}{
 		"transaction_id": req.TxHash,
 		"payment_method": entity.PaymentMethodCryptoCurrency,
 		"status":         entity.PaymentStatusCompleted,
 		"updated_at":     time.Now(),
-	})
+	}
 
-	return b.outboxClient.CreateOutboxEvent(ctx, string(entity.EventTypePaymentCompleted), map[string]interface{}{
+	eventData := map[string]interface{}{
 		"payment_id":     payment.Id,
 		"booking_id":     req.BookingId,
 		"amount":         req.AmountVnd,
 		"payment_method": entity.PaymentMethodCryptoCurrency,
 		"tx_hash":        req.TxHash,
 		"status":         entity.PaymentStatusCompleted,
+	}
+
+	return b.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := b.repo.UpdatePaymentFields(ctx, tx, payment.Id, fields); err != nil {
+			return err
+		}
+
+		return b.repo.CreateOutboxEvent(ctx, tx, entity.EventTypePaymentCompleted, eventData)
 	})
 }
 
-func (b *paymentBiz) ConfirmPayment(ctx context.Context, paymentId string, paymentMethod entity.PaymentMethod) error {
+func (b *paymentBiz) ConfirmPayment(ctx context.Context, paymentId string, paymentMethod entity.PaymentMethod, staffSessionId, staffId string) error {
+	if paymentMethod == entity.PaymentMethodCash && staffSessionId == "" {
+		return ErrStaffSessionRequired
+	}
+
 	payment, err := b.repo.GetById(ctx, paymentId)
 	if err != nil {
 		return fmt.Errorf("payment not found: %w", err)
@@ -199,20 +240,354 @@ func (b *paymentBiz) ConfirmPayment(ctx context.Context, paymentId string, payme
 		"updated_at":     time.Now(),
 	}
 
-	if err = b.repo.UpdatePaymentFields(ctx, b.db, payment.Id, fields); err != nil {
-		return fmt.Errorf("failed to update payment: %w", err)
+	eventData := map[string]interface{}{
+		"payment_id":       payment.Id,
+		"booking_id":       payment.BookingId,
+		"amount":           payment.Amount,
+		"gift_card_amount": payment.GiftCardAmount,
+		"status":           entity.PaymentStatusCompleted,
+		"payment_method":   paymentMethod,
+		"timestamp":        time.Now().Unix(),
 	}
 
-	eventData := map[string]interface{}{
-		"payment_id":     payment.Id,
-		"booking_id":     payment.BookingId,
-		"amount":         payment.Amount,
+	if staffSessionId != "" {
+		fields["staff_session_id"] = staffSessionId
+	}
+
+	return b.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := b.lockStaffSession(ctx, tx, staffSessionId, staffId); err != nil {
+			return err
+		}
+
+		if err := b.repo.UpdatePaymentFields(ctx, tx, payment.Id, fields); err != nil {
+			return fmt.Errorf("failed to update payment: %w", err)
+		}
+
+		return b.repo.CreateOutboxEvent(ctx, tx, entity.EventTypePaymentCompleted, eventData)
+	})
+}
+
+// CreatePaymentAdjustment records the difference owed after a paid booking
+// changes price. A positive amount is charged to the customer and a negative
+// one credited back. It is idempotent on referenceId.
+func (b *paymentBiz) CreatePaymentAdjustment(ctx context.Context, bookingId, referenceId string, amount float64, reason string) (*entity.PaymentAdjustment, error) {
+	if referenceId == "" {
+		return nil, fmt.Errorf("reference id is required")
+	}
+
+	if amount == 0 {
+		return nil, fmt.Errorf("adjustment amount must not be zero")
+	}
+
+	existing, _ := b.repo.FindAdjustmentByReferenceId(ctx, referenceId)
+	if existing != nil {
+		return existing, nil
+	}
+
+	payment, err := b.repo.FindByBookingId(ctx, bookingId)
+	if err != nil {
+		return nil, err
+	}
+
+	if payment.Status != entity.PaymentStatusCompleted {
+		return nil, fmt.Errorf("cannot adjust payment with status %s", payment.Status)
+	}
+
+	adjustmentType := entity.PaymentAdjustmentTypeCharge
+	if amount < 0 {
+		adjustmentType = entity.PaymentAdjustmentTypeRefund
+		amount = -amount
+	}
+
+	adjustment := &entity.PaymentAdjustment{
+		Id:          uuid.New().String(),
+		PaymentId:   payment.Id,
+		BookingId:   bookingId,
+		ReferenceId: referenceId,
+		Type:        adjustmentType,
+		Amount:      amount,
+		Reason:      reason,
+		Status:      entity.PaymentStatusPending,
+		CreatedAt:   time.Now(),
+	}
+
+	if err = b.repo.CreateAdjustment(ctx, adjustment); err != nil {
+		return nil, fmt.Errorf("failed to create payment adjustment: %w", err)
+	}
+
+	// A concurrent call with the same reference may have won the insert.
+	return b.repo.FindAdjustmentByReferenceId(ctx, referenceId)
+}
+
+func (b *paymentBiz) GetPaymentAdjustmentsByBookingId(ctx context.Context, bookingId string) ([]*entity.PaymentAdjustment, error) {
+	return b.repo.FindAdjustmentsByBookingId(ctx, bookingId)
+}
+
+func (b *paymentBiz) ConfirmPaymentAdjustment(ctx context.Context, adjustmentId string, paymentMethod entity.PaymentMethod, staffSessionId, staffId string) error {
+	if paymentMethod == entity.PaymentMethodCash && staffSessionId == "" {
+		return ErrStaffSessionRequired
+	}
+
+	adjustment, err := b.repo.GetAdjustmentById(ctx, adjustmentId)
+	if err != nil {
+		return err
+	}
+
+	if adjustment.Status == entity.PaymentStatusCompleted {
+		return nil
+	}
+
+	if adjustment.Status != entity.PaymentStatusPending {
+		return fmt.Errorf("cannot confirm adjustment with status %s", adjustment.Status)
+	}
+
+	fields := map[string]interface{}{
 		"status":         entity.PaymentStatusCompleted,
 		"payment_method": paymentMethod,
-		"timestamp":      time.Now().Unix(),
+		"updated_at":     time.Now(),
+	}
+
+	if staffSessionId != "" {
+		fields["staff_session_id"] = staffSessionId
 	}
 
-	return b.outboxClient.CreateOutboxEvent(ctx, string(entity.EventTypePaymentCompleted), eventData)
+	return b.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := b.lockStaffSession(ctx, tx, staffSessionId, staffId); err != nil {
+			return err
+		}
+
+		if err := b.repo.UpdateAdjustmentFields(ctx, tx, adjustment.Id, fields); err != nil {
+			return fmt.Errorf("failed to update payment adjustment: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// PayWithGiftCard pays a booking's pending payment from a gift card, up to
+// amount if it is positive and otherwise as much as the card covers. The rest
+// is left for a bank transfer; once cards cover it all the payment completes.
+func (b *paymentBiz) PayWithGiftCard(ctx context.Context, bookingId, code string, amount float64) (*entity.Payment, error) {
+	amount = roundAmount(amount)
+	if amount < 0 {
+		return nil, ErrInvalidGiftCardAmount
+	}
+
+	var payment *entity.Payment
+	err := b.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var err error
+		payment, err = b.repo.LockByBookingId(ctx, tx, bookingId)
+		if err != nil {
+			return err
+		}
+
+		if payment.Status != entity.PaymentStatusPending {
+			return ErrPaymentNotPending
+		}
+
+		card, err := b.giftCardRepo.LockByCode(ctx, tx, normalizeGiftCardCode(code))
+		if err != nil {
+			return giftCardLookupError(err)
+		}
+
+		now := time.Now()
+		if !card.IsUsable(now) {
+			return ErrGiftCardNotUsable
+		}
+		if card.Balance <= 0 {
+			return ErrGiftCardEmpty
+		}
+
+		redeem := min(amountDue(payment), card.Balance)
+		if amount > 0 {
+			redeem = min(redeem, amount)
+		}
+
+		err = postGiftCardTransaction(ctx, tx, b.giftCardRepo, card, entity.GiftCardTransactionRedeem, -redeem, payment,
+			fmt.Sprintf("Payment for booking %s", payment.BookingId))
+		if err != nil {
+			return err
+		}
+
+		payment.GiftCardAmount = roundAmount(payment.GiftCardAmount + redeem)
+		fields := map[string]interface{}{
+			"gift_card_amount": payment.GiftCardAmount,
+		}
+
+		settled := amountDue(payment) <= 0
+		if settled {
+			payment.Status = entity.PaymentStatusCompleted
+			payment.PaymentMethod = entity.PaymentMethodGiftCard
+			fields["status"] = payment.Status
+			fields["payment_method"] = payment.PaymentMethod
+		}
+
+		if err = b.repo.UpdatePaymentFields(ctx, tx, payment.Id, fields); err != nil {
+			return fmt.Errorf("failed to update payment: %w", err)
+		}
+
+		if !settled {
+			return nil
+		}
+
+		eventData := map[string]interface{}{
+			"payment_id":       payment.Id,
+			"booking_id":       payment.BookingId,
+			"amount":           payment.Amount,
+			"gift_card_amount": payment.GiftCardAmount,
+			"status":           entity.PaymentStatusCompleted,
+			"payment_method":   entity.PaymentMethodGiftCard,
+			"timestamp":        now.Unix(),
+		}
+
+		return b.repo.CreateOutboxEvent(ctx, tx, entity.EventTypePaymentCompleted, eventData)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// ReleaseGiftCards gives back what a booking's unpaid payment took from gift
+// cards, e.g. once the payment expires. It returns the amount released and
+// is safe to repeat.
+func (b *paymentBiz) ReleaseGiftCards(ctx context.Context, bookingId string) (float64, error) {
+	released := 0.0
+	err := b.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		payment, err := b.repo.LockByBookingId(ctx, tx, bookingId)
+		if err != nil {
+			return err
+		}
+
+		if payment.Status == entity.PaymentStatusCompleted {
+			return ErrPaymentNotPending
+		}
+
+		holds, err := b.giftCardRepo.GetPaymentHolds(ctx, tx, payment.Id)
+		if err != nil {
+			return fmt.Errorf("failed to get gift card holds: %w", err)
+		}
+
+		for _, hold := range holds {
+			card, err := b.giftCardRepo.LockById(ctx, tx, hold.GiftCardId)
+			if err != nil {
+				return giftCardLookupError(err)
+			}
+
+			err = postGiftCardTransaction(ctx, tx, b.giftCardRepo, card, entity.GiftCardTransactionRelease, hold.Amount, payment,
+				fmt.Sprintf("Released from unpaid booking %s", payment.BookingId))
+			if err != nil {
+				return err
+			}
+			released = roundAmount(released + hold.Amount)
+		}
+
+		if payment.GiftCardAmount == 0 {
+			return nil
+		}
+
+		return b.repo.UpdatePaymentFields(ctx, tx, payment.Id, map[string]interface{}{
+			"gift_card_amount": 0,
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return released, nil
+}
+
+// ForceCompletePayment settles a booking's payment outside any gateway, e.g.
+// when an admin confirms a transfer that never reached the webhook. The
+// payment is created first if the customer never opened one. Completing it
+// here, rather than confirming the booking directly, means a late webhook is
+// rejected as a duplicate and the worker confirms the booking as it does for
+// any other payment. It is safe to repeat.
+func (b *paymentBiz) ForceCompletePayment(ctx context.Context, bookingId string, amount float64, reason string) (*entity.Payment, error) {
+	if _, err := b.CreatePayment(ctx, bookingId, roundAmount(amount)); err != nil {
+		return nil, err
+	}
+
+	payload, err := json.Marshal(map[string]string{"reason": reason})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payment payload: %w", err)
+	}
+
+	var payment *entity.Payment
+	err = b.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var err error
+		payment, err = b.repo.LockByBookingId(ctx, tx, bookingId)
+		if err != nil {
+			return err
+		}
+
+		if payment.Status == entity.PaymentStatusCompleted {
+			return nil
+		}
+
+		if payment.Status != entity.PaymentStatusPending {
+			return fmt.Errorf("cannot complete payment with status %s", payment.Status)
+		}
+
+		now := time.Now()
+		payment.Status = entity.PaymentStatusCompleted
+		payment.PaymentMethod = entity.PaymentMethodManual
+
+		fields := map[string]interface{}{
+			"status":         payment.Status,
+			"payment_method": payment.PaymentMethod,
+			"payload":        string(payload),
+			"updated_at":     now,
+		}
+		if err = b.repo.UpdatePaymentFields(ctx, tx, payment.Id, fields); err != nil {
+			return fmt.Errorf("failed to update payment: %w", err)
+		}
+
+		eventData := map[string]interface{}{
+			"payment_id":       payment.Id,
+			"booking_id":       payment.BookingId,
+			"amount":           payment.Amount,
+			"gift_card_amount": payment.GiftCardAmount,
+			"status":           payment.Status,
+			"payment_method":   payment.PaymentMethod,
+			"timestamp":        now.Unix(),
+		}
+
+		return b.repo.CreateOutboxEvent(ctx, tx, entity.EventTypePaymentCompleted, eventData)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// amountDue is what is left to pay once gift cards are taken off.
+func amountDue(payment *entity.Payment) float64 {
+	return roundAmount(payment.Amount - payment.GiftCardAmount)
+}
+
+// lockStaffSession checks that the drawer session a payment is taken in is
+// still open and was opened by the staff member taking the payment, and keeps
+// it from being closed until the transaction ends.
+func (b *paymentBiz) lockStaffSession(ctx context.Context, tx bun.Tx, staffSessionId, staffId string) error {
+	if staffSessionId == "" {
+		return nil
+	}
+
+	status, owner, err := b.repo.LockStaffSession(ctx, tx, staffSessionId)
+	if err != nil {
+		return fmt.Errorf("failed to check staff session: %w", err)
+	}
+	if status != "OPEN" {
+		return ErrStaffSessionNotOpen
+	}
+	if owner != staffId {
+		return ErrStaffSessionNotOwned
+	}
+
+	return nil
 }
 
 // extractUUIDNoHyphens extracts 32-character UUID without hyphens from content or description
@@ -267,3 +642,18 @@ func isValidUUIDNoHyphens(s string) bool {
 
 	return true
 }
+
+// CheckBookingOwner makes sure the booking exists and belongs to userId.
+func (b *paymentBiz) CheckBookingOwner(ctx context.Context, bookingId, userId string) error {
+	ownerId, err := b.repo.GetBookingUserId(ctx, bookingId)
+	if err != nil {
+		return fmt.Errorf("failed to get booking: %w", err)
+	}
+	if ownerId == "" {
+		return ErrBookingNotFound
+	}
+	if ownerId != userId {
+		return ErrBookingAccessDenied
+	}
+	return nil
+}
@@ -12,6 +12,7 @@ const (
 	PaymentStatusPending   PaymentStatus = "PENDING"
 	PaymentStatusCompleted PaymentStatus = "COMPLETED"
 	PaymentStatusFailed    PaymentStatus = "FAILED"
+	PaymentStatusExpired   PaymentStatus = "EXPIRED"
 )
 
 type PaymentMethod string
@@ -20,6 +21,8 @@ const (
 	PaymentMethodBankTransfer   PaymentMethod = "BANK_TRANSFER"
 	PaymentMethodCryptoCurrency PaymentMethod = "CRYPTOCURRENCY"
 	PaymentMethodCash           PaymentMethod = "CASH"
+	PaymentMethodGiftCard       PaymentMethod = "GIFT_CARD"
+	PaymentMethodManual         PaymentMethod = "MANUAL"
 )
 
 type Payment struct {
@@ -34,6 +37,13 @@ type Payment struct {
 	Status        PaymentStatus `bun:"status,notnull,default:'PENDING'" json:"status"`
 	Payload       *string       `bun:"payload" json:"payload,omitempty"`
 
+	// GiftCardAmount is the part of Amount paid from gift cards. The rest is
+	// settled through PaymentMethod.
+	GiftCardAmount float64 `bun:"gift_card_amount,notnull,default:0,type:decimal(10,2)" json:"gift_card_amount"`
+
+	// StaffSessionId is the box-office drawer session that took the payment.
+	StaffSessionId string `bun:"staff_session_id,nullzero" json:"staff_session_id,omitempty"`
+
 	CreatedAt time.Time  `bun:"created_at,nullzero,default:current_timestamp" json:"created_at"`
 	UpdatedAt *time.Time `bun:"updated_at" json:"updated_at,omitempty"`
 }
@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type PaymentAdjustmentType string
+
+const (
+	PaymentAdjustmentTypeCharge PaymentAdjustmentType = "CHARGE"
+	PaymentAdjustmentTypeRefund PaymentAdjustmentType = "REFUND"
+)
+
+// PaymentAdjustment records money owed by or to the customer after a booking
+// changes price, e.g. on an exchange. Amount is always positive; Type gives
+// the direction.
+type PaymentAdjustment struct {
+	bun.BaseModel `bun:"table:payment_adjustments"`
+
+	Id            string                `bun:"id,pk" json:"id"`
+	PaymentId     string                `bun:"payment_id,notnull" json:"payment_id"`
+	BookingId     string                `bun:"booking_id,notnull" json:"booking_id"`
+	ReferenceId   string                `bun:"reference_id,notnull,unique" json:"reference_id"`
+	Type          PaymentAdjustmentType `bun:"type,notnull" json:"type"`
+	Amount        float64               `bun:"amount,notnull,type:decimal(10,2)" json:"amount"`
+	Reason        string                `bun:"reason" json:"reason,omitempty"`
+	Status        PaymentStatus         `bun:"status,notnull,default:'PENDING'" json:"status"`
+	PaymentMethod PaymentMethod         `bun:"payment_method,nullzero" json:"payment_method,omitempty"`
+
+	StaffSessionId string `bun:"staff_session_id,nullzero" json:"staff_session_id,omitempty"`
+
+	CreatedAt time.Time  `bun:"created_at,nullzero,default:current_timestamp" json:"created_at"`
+	UpdatedAt *time.Time `bun:"updated_at" json:"updated_at,omitempty"`
+}
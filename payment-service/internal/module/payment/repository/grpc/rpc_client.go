@@ -17,7 +17,7 @@ type AuthGrpcClient struct {
 func NewAuthGrpcClient(_ *do.Injector) (*AuthGrpcClient, error) {
 	authGrpcServer := os.Getenv("AUTH_RPC")
 	if authGrpcServer == "" {
-		authGrpcServer = "localhost:50051" // Default to localhost if not set
+		authGrpcServer = "localhost:50052"
 	}
 
 	conn, err := grpc.NewClient(authGrpcServer, grpc.WithTransportCredentials(insecure.NewCredentials()))
@@ -30,13 +30,13 @@ func NewAuthGrpcClient(_ *do.Injector) (*AuthGrpcClient, error) {
 	}, nil
 }
 
-func (c *AuthGrpcClient) Validate(ctx context.Context, jwtToken string) (int, string, []string, error) {
+func (c *AuthGrpcClient) Validate(ctx context.Context, jwtToken string) (int, string, string, []string, error) {
 	validated, err := c.client.Validate(ctx, &pb.ValidateRequest{
 		Token: jwtToken,
 	})
 	if err != nil {
-		return 0, "", nil, err
+		return 0, "", "", nil, err
 	}
 
-	return int(validated.Status), validated.Role, validated.Permissions, nil
+	return int(validated.Status), validated.Id, validated.Role, validated.Permissions, nil
 }
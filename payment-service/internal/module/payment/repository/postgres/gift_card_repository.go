@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"payment-service/internal/module/payment/entity"
+
+	"github.com/uptrace/bun"
+)
+
+// GiftCardHold is what a payment has taken from one gift card and not given
+// back yet.
+type GiftCardHold struct {
+	GiftCardId string  `bun:"gift_card_id"`
+	Amount     float64 `bun:"amount"`
+}
+
+type GiftCardRepository interface {
+	Create(ctx context.Context, db bun.IDB, card *entity.GiftCard) error
+	GetById(ctx context.Context, id string) (*entity.GiftCard, error)
+	GetByCode(ctx context.Context, code string) (*entity.GiftCard, error)
+	LockById(ctx context.Context, db bun.IDB, id string) (*entity.GiftCard, error)
+	LockByCode(ctx context.Context, db bun.IDB, code string) (*entity.GiftCard, error)
+	UpdateFields(ctx context.Context, db bun.IDB, id string, fields map[string]interface{}) error
+	CreateTransaction(ctx context.Context, db bun.IDB, transaction *entity.GiftCardTransaction) error
+	GetTransactions(ctx context.Context, giftCardId string) ([]*entity.GiftCardTransaction, error)
+	GetPaymentHolds(ctx context.Context, db bun.IDB, paymentId string) ([]*GiftCardHold, error)
+}
+
+type giftCardRepository struct {
+	db *bun.DB
+}
+
+func NewGiftCardRepository(db *bun.DB) GiftCardRepository {
+	return &giftCardRepository{db: db}
+}
+
+func (r *giftCardRepository) Create(ctx context.Context, db bun.IDB, card *entity.GiftCard) error {
+	_, err := db.NewInsert().
+		Model(card).
+		Exec(ctx)
+	return err
+}
+
+func (r *giftCardRepository) GetById(ctx context.Context, id string) (*entity.GiftCard, error) {
+	return r.get(ctx, r.db, "id = ?", id, false)
+}
+
+func (r *giftCardRepository) GetByCode(ctx context.Context, code string) (*entity.GiftCard, error) {
+	return r.get(ctx, r.db, "code = ?", code, false)
+}
+
+func (r *giftCardRepository) LockById(ctx context.Context, db bun.IDB, id string) (*entity.GiftCard, error) {
+	return r.get(ctx, db, "id = ?", id, true)
+}
+
+func (r *giftCardRepository) LockByCode(ctx context.Context, db bun.IDB, code string) (*entity.GiftCard, error) {
+	return r.get(ctx, db, "code = ?", code, true)
+}
+
+func (r *giftCardRepository) get(ctx context.Context, db bun.IDB, where string, arg string, forUpdate bool) (*entity.GiftCard, error) {
+	card := new(entity.GiftCard)
+	query := db.NewSelect().
+		Model(card).
+		Where(where, arg)
+	if forUpdate {
+		query = query.For("UPDATE")
+	}
+
+	err := query.Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("gift card not found: %w", err)
+	}
+	return card, err
+}
+
+func (r *giftCardRepository) UpdateFields(ctx context.Context, db bun.IDB, id string, fields map[string]interface{}) error {
+	query := db.NewUpdate().
+		Model((*entity.GiftCard)(nil)).
+		Where("id = ?", id)
+
+	for key, value := range fields {
+		query = query.Set("? = ?", bun.Ident(key), value)
+	}
+
+	_, err := query.Exec(ctx)
+	return err
+}
+
+func (r *giftCardRepository) CreateTransaction(ctx context.Context, db bun.IDB, transaction *entity.GiftCardTransaction) error {
+	_, err := db.NewInsert().
+		Model(transaction).
+		Exec(ctx)
+	return err
+}
+
+func (r *giftCardRepository) GetTransactions(ctx context.Context, giftCardId string) ([]*entity.GiftCardTransaction, error) {
+	transactions := make([]*entity.GiftCardTransaction, 0)
+	err := r.db.NewSelect().
+		Model(&transactions).
+		Where("gift_card_id = ?", giftCardId).
+		Order("created_at ASC").
+		Scan(ctx)
+	return transactions, err
+}
+
+// GetPaymentHolds nets the redemptions and releases a payment has posted,
+// per card, keeping the cards it still owes money back to.
+func (r *giftCardRepository) GetPaymentHolds(ctx context.Context, db bun.IDB, paymentId string) ([]*GiftCardHold, error) {
+	holds := make([]*GiftCardHold, 0)
+	err := db.NewSelect().
+		Model((*entity.GiftCardTransaction)(nil)).
+		Column("gift_card_id").
+		ColumnExpr("-SUM(amount) AS amount").
+		Where("payment_id = ?", paymentId).
+		Where("type IN (?)", bun.In([]entity.GiftCardTransactionType{
+			entity.GiftCardTransactionRedeem,
+			entity.GiftCardTransactionRelease,
+		})).
+		Group("gift_card_id").
+		Having("SUM(amount) < 0").
+		Order("gift_card_id ASC").
+		Scan(ctx, &holds)
+	return holds, err
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -19,6 +20,15 @@ type PaymentRepository interface {
 	UpdatePaymentFields(ctx context.Context, db bun.IDB, id string, fields map[string]interface{}) error
 	Create(ctx context.Context, payment *entity.Payment) error
 	GetById(ctx context.Context, id string) (*entity.Payment, error)
+	LockByBookingId(ctx context.Context, db bun.IDB, bookingId string) (*entity.Payment, error)
+	CreateOutboxEvent(ctx context.Context, db bun.IDB, eventType entity.OutboxEventType, eventData interface{}) error
+	CreateAdjustment(ctx context.Context, adjustment *entity.PaymentAdjustment) error
+	FindAdjustmentByReferenceId(ctx context.Context, referenceId string) (*entity.PaymentAdjustment, error)
+	FindAdjustmentsByBookingId(ctx context.Context, bookingId string) ([]*entity.PaymentAdjustment, error)
+	GetAdjustmentById(ctx context.Context, id string) (*entity.PaymentAdjustment, error)
+	UpdateAdjustmentFields(ctx context.Context, db bun.IDB, id string, fields map[string]interface{}) error
+	LockStaffSession(ctx context.Context, db bun.IDB, id string) (string, string, error)
+	GetBookingUserId(ctx context.Context, bookingId string) (string, error)
 }
 
 type paymentRepository struct {
@@ -60,6 +70,19 @@ func (r *paymentRepository) FindByBookingId(ctx context.Context, bookingId strin
 	return payment, err
 }
 
+func (r *paymentRepository) LockByBookingId(ctx context.Context, db bun.IDB, bookingId string) (*entity.Payment, error) {
+	payment := new(entity.Payment)
+	err := db.NewSelect().
+		Model(payment).
+		Where("booking_id = ?", bookingId).
+		For("UPDATE").
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("payment not found for booking %s", bookingId)
+	}
+	return payment, err
+}
+
 func (r *paymentRepository) FindByContent(ctx context.Context, content string) (*entity.Payment, error) {
 	payment := new(entity.Payment)
 	err := r.db.NewSelect().
@@ -110,3 +133,110 @@ func (r *paymentRepository) UpdatePaymentFields(ctx context.Context, db bun.IDB,
 	_, err := query.Exec(ctx)
 	return err
 }
+
+func (r *paymentRepository) CreateOutboxEvent(ctx context.Context, db bun.IDB, eventType entity.OutboxEventType, eventData interface{}) error {
+	payload, err := json.Marshal(eventData)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event data: %w", err)
+	}
+
+	event := &entity.OutboxEvent{
+		EventType: string(eventType),
+		Payload:   string(payload),
+		Status:    string(entity.OutboxStatusPending),
+	}
+
+	_, err = db.NewInsert().
+		Model(event).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create outbox event: %w", err)
+	}
+
+	return nil
+}
+
+func (r *paymentRepository) CreateAdjustment(ctx context.Context, adjustment *entity.PaymentAdjustment) error {
+	_, err := r.db.NewInsert().
+		Model(adjustment).
+		On("CONFLICT (reference_id) DO NOTHING").
+		Exec(ctx)
+	return err
+}
+
+func (r *paymentRepository) FindAdjustmentByReferenceId(ctx context.Context, referenceId string) (*entity.PaymentAdjustment, error) {
+	adjustment := new(entity.PaymentAdjustment)
+	err := r.db.NewSelect().
+		Model(adjustment).
+		Where("reference_id = ?", referenceId).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("payment adjustment not found for reference %s", referenceId)
+	}
+	return adjustment, err
+}
+
+func (r *paymentRepository) FindAdjustmentsByBookingId(ctx context.Context, bookingId string) ([]*entity.PaymentAdjustment, error) {
+	adjustments := make([]*entity.PaymentAdjustment, 0)
+	err := r.db.NewSelect().
+		Model(&adjustments).
+		Where("booking_id = ?", bookingId).
+		Order("created_at ASC").
+		Scan(ctx)
+	return adjustments, err
+}
+
+func (r *paymentRepository) GetAdjustmentById(ctx context.Context, id string) (*entity.PaymentAdjustment, error) {
+	adjustment := new(entity.PaymentAdjustment)
+	err := r.db.NewSelect().
+		Model(adjustment).
+		Where("id = ?", id).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("payment adjustment not found")
+	}
+	return adjustment, err
+}
+
+func (r *paymentRepository) UpdateAdjustmentFields(ctx context.Context, db bun.IDB, id string, fields map[string]interface{}) error {
+	query := db.NewUpdate().
+		Model((*entity.PaymentAdjustment)(nil)).
+		Where("id = ?", id)
+
+	for key, value := range fields {
+		query = query.Set("? = ?", bun.Ident(key), value)
+	}
+
+	_, err := query.Exec(ctx)
+	return err
+}
+
+// LockStaffSession share-locks a box-office drawer session owned by
+// booking-service, so the session cannot be closed while a payment is being
+// recorded against it, and returns its status and the staff member who opened
+// it. Both are empty if the session does not exist.
+func (r *paymentRepository) LockStaffSession(ctx context.Context, db bun.IDB, id string) (string, string, error) {
+	var status, staffId string
+	err := db.NewRaw("SELECT status, staff_id FROM staff_sessions WHERE id = ? FOR SHARE", id).Scan(ctx, &status, &staffId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", "", nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+	return status, staffId, nil
+}
+
+// GetBookingUserId returns the owner of a booking, or "" if there is no such
+// booking.
+func (r *paymentRepository) GetBookingUserId(ctx context.Context, bookingId string) (string, error) {
+	var userId string
+	err := r.db.NewRaw("SELECT user_id FROM bookings WHERE id = ?", bookingId).Scan(ctx, &userId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return userId, nil
+}
@@ -0,0 +1,72 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"payment-service/internal/module/payment/business"
+	"payment-service/proto/pb"
+)
+
+type PaymentServiceServer struct {
+	pb.UnimplementedPaymentServiceServer
+	paymentBiz business.PaymentBiz
+}
+
+func NewPaymentGRPCServer(paymentBiz business.PaymentBiz) *PaymentServiceServer {
+	return &PaymentServiceServer{
+		paymentBiz: paymentBiz,
+	}
+}
+
+func (s *PaymentServiceServer) CreatePaymentAdjustment(ctx context.Context, req *pb.CreatePaymentAdjustmentRequest) (*pb.CreatePaymentAdjustmentResponse, error) {
+	adjustment, err := s.paymentBiz.CreatePaymentAdjustment(ctx, req.BookingId, req.ReferenceId, req.Amount, req.Reason)
+	if err != nil {
+		return &pb.CreatePaymentAdjustmentResponse{
+			Success: false,
+			Message: fmt.Sprintf("failed to create payment adjustment: %v", err),
+		}, err
+	}
+
+	return &pb.CreatePaymentAdjustmentResponse{
+		Success:      true,
+		Message:      "Payment adjustment created successfully",
+		AdjustmentId: adjustment.Id,
+		Type:         string(adjustment.Type),
+		Status:       string(adjustment.Status),
+		Amount:       adjustment.Amount,
+	}, nil
+}
+
+func (s *PaymentServiceServer) ReleaseGiftCards(ctx context.Context, req *pb.ReleaseGiftCardsRequest) (*pb.ReleaseGiftCardsResponse, error) {
+	released, err := s.paymentBiz.ReleaseGiftCards(ctx, req.BookingId)
+	if err != nil {
+		return &pb.ReleaseGiftCardsResponse{
+			Success: false,
+			Message: fmt.Sprintf("failed to release gift cards: %v", err),
+		}, err
+	}
+
+	return &pb.ReleaseGiftCardsResponse{
+		Success:        true,
+		Message:        "Gift cards released successfully",
+		ReleasedAmount: released,
+	}, nil
+}
+
+func (s *PaymentServiceServer) ForceCompletePayment(ctx context.Context, req *pb.ForceCompletePaymentRequest) (*pb.ForceCompletePaymentResponse, error) {
+	payment, err := s.paymentBiz.ForceCompletePayment(ctx, req.BookingId, req.Amount, req.Reason)
+	if err != nil {
+		return &pb.ForceCompletePaymentResponse{
+			Success: false,
+			Message: fmt.Sprintf("failed to complete payment: %v", err),
+		}, err
+	}
+
+	return &pb.ForceCompletePaymentResponse{
+		Success:   true,
+		Message:   "Payment completed successfully",
+		PaymentId: payment.Id,
+		Status:    string(payment.Status),
+	}, nil
+}
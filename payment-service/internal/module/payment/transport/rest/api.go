@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -12,7 +13,8 @@ import (
 )
 
 type handler struct {
-	paymentBiz business.PaymentBiz
+	paymentBiz  business.PaymentBiz
+	giftCardBiz business.GiftCardBiz
 }
 
 func NewAPI(i *do.Injector) (*handler, error) {
@@ -21,8 +23,14 @@ func NewAPI(i *do.Injector) (*handler, error) {
 		return nil, err
 	}
 
+	giftCardBiz, err := do.Invoke[business.GiftCardBiz](i)
+	if err != nil {
+		return nil, err
+	}
+
 	return &handler{
-		paymentBiz: paymentBiz,
+		paymentBiz:  paymentBiz,
+		giftCardBiz: giftCardBiz,
 	}, nil
 }
 
@@ -149,7 +157,8 @@ func (h *handler) ConfirmPayment(c *gin.Context) {
 	}
 
 	var req struct {
-		PaymentMethod string `json:"payment_method" binding:"required"`
+		PaymentMethod  string `json:"payment_method" binding:"required"`
+		StaffSessionId string `json:"staff_session_id"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -171,8 +180,16 @@ func (h *handler) ConfirmPayment(c *gin.Context) {
 		return
 	}
 
-	err := h.paymentBiz.ConfirmPayment(c.Request.Context(), paymentId, paymentMethod)
+	err := h.paymentBiz.ConfirmPayment(c.Request.Context(), paymentId, paymentMethod, req.StaffSessionId, c.GetString("user_id"))
 	if err != nil {
+		if isStaffSessionError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"message": err.Error(),
+			})
+			return
+		}
+
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"success": false,
 			"message": "Failed to confirm payment",
@@ -186,3 +203,99 @@ func (h *handler) ConfirmPayment(c *gin.Context) {
 		"message": "Payment confirmed successfully",
 	})
 }
+
+func (h *handler) GetPaymentAdjustmentsByBookingId(c *gin.Context) {
+	bookingId := c.Param("bookingId")
+	if bookingId == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Booking ID is required",
+		})
+		return
+	}
+
+	if !h.requireBookingAccess(c, bookingId) {
+		return
+	}
+
+	adjustments, err := h.paymentBiz.GetPaymentAdjustmentsByBookingId(c.Request.Context(), bookingId)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": "Failed to get payment adjustments",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    adjustments,
+	})
+}
+
+func (h *handler) ConfirmPaymentAdjustment(c *gin.Context) {
+	if !requireRole(c, isStaffRole, "Only staff can confirm payment adjustments") {
+		return
+	}
+
+	adjustmentId := c.Param("adjustmentId")
+	if adjustmentId == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Adjustment ID is required",
+		})
+		return
+	}
+
+	var req struct {
+		PaymentMethod  string `json:"payment_method" binding:"required"`
+		StaffSessionId string `json:"staff_session_id"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Invalid request payload",
+		})
+		return
+	}
+
+	paymentMethod := entity.PaymentMethod(req.PaymentMethod)
+	if paymentMethod != entity.PaymentMethodCash &&
+		paymentMethod != entity.PaymentMethodBankTransfer &&
+		paymentMethod != entity.PaymentMethodCryptoCurrency {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Invalid payment method",
+		})
+		return
+	}
+
+	err := h.paymentBiz.ConfirmPaymentAdjustment(c.Request.Context(), adjustmentId, paymentMethod, req.StaffSessionId, c.GetString("user_id"))
+	if err != nil {
+		if isStaffSessionError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": "Failed to confirm payment adjustment",
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Payment adjustment confirmed successfully",
+	})
+}
+
+func isStaffSessionError(err error) bool {
+	return errors.Is(err, business.ErrStaffSessionRequired) || errors.Is(err, business.ErrStaffSessionNotOpen) ||
+		errors.Is(err, business.ErrStaffSessionNotOwned)
+}
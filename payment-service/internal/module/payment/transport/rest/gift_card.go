@@ -0,0 +1,268 @@
+package rest
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"payment-service/internal/module/payment/business"
+
+	"github.com/gin-gonic/gin"
+)
+
+func isStaffRole(role string) bool {
+	return role == "ticket_staff" || role == "admin" || role == "manager_staff"
+}
+
+func isManagerRole(role string) bool {
+	return role == "admin" || role == "manager_staff"
+}
+
+// requireRole rejects the request unless the caller's role passes allowed.
+func requireRole(c *gin.Context, allowed func(string) bool, message string) bool {
+	if allowed(c.GetString("userRole")) {
+		return true
+	}
+
+	c.JSON(http.StatusForbidden, gin.H{
+		"success": false,
+		"message": message,
+	})
+	return false
+}
+
+// requireBookingAccess lets staff through and customers only for their own
+// bookings.
+func (h *handler) requireBookingAccess(c *gin.Context, bookingId string) bool {
+	if isStaffRole(c.GetString("userRole")) {
+		return true
+	}
+
+	if err := h.paymentBiz.CheckBookingOwner(c.Request.Context(), bookingId, c.GetString("user_id")); err != nil {
+		respondGiftCardError(c, err, "Failed to get booking")
+		return false
+	}
+	return true
+}
+
+func (h *handler) IssueGiftCard(c *gin.Context) {
+	if !requireRole(c, isManagerRole, "Only managers and admins can issue gift cards") {
+		return
+	}
+
+	var req struct {
+		Amount    float64    `json:"amount" binding:"required"`
+		ExpiresAt *time.Time `json:"expires_at"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Invalid request payload",
+		})
+		return
+	}
+
+	card, err := h.giftCardBiz.IssueGiftCard(c.Request.Context(), req.Amount, req.ExpiresAt)
+	if err != nil {
+		respondGiftCardError(c, err, "Failed to issue gift card")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    card,
+	})
+}
+
+func (h *handler) GetGiftCardByCode(c *gin.Context) {
+	if !requireRole(c, isStaffRole, "Only staff can look up gift cards") {
+		return
+	}
+
+	card, err := h.giftCardBiz.GetGiftCardByCode(c.Request.Context(), c.Param("code"))
+	if err != nil {
+		respondGiftCardError(c, err, "Failed to get gift card")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    card,
+	})
+}
+
+func (h *handler) GetGiftCardById(c *gin.Context) {
+	if !requireRole(c, isStaffRole, "Only staff can look up gift cards") {
+		return
+	}
+
+	card, err := h.giftCardBiz.GetGiftCardById(c.Request.Context(), c.Param("giftCardId"))
+	if err != nil {
+		respondGiftCardError(c, err, "Failed to get gift card")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    card,
+	})
+}
+
+func (h *handler) GetGiftCardTransactions(c *gin.Context) {
+	if !requireRole(c, isStaffRole, "Only staff can look up gift cards") {
+		return
+	}
+
+	transactions, err := h.giftCardBiz.GetGiftCardTransactions(c.Request.Context(), c.Param("giftCardId"))
+	if err != nil {
+		respondGiftCardError(c, err, "Failed to get gift card transactions")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    transactions,
+	})
+}
+
+func (h *handler) TopUpGiftCard(c *gin.Context) {
+	if !requireRole(c, isManagerRole, "Only managers and admins can top up gift cards") {
+		return
+	}
+
+	var req struct {
+		Amount float64 `json:"amount" binding:"required"`
+		Reason string  `json:"reason"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Invalid request payload",
+		})
+		return
+	}
+
+	card, err := h.giftCardBiz.TopUpGiftCard(c.Request.Context(), c.Param("giftCardId"), req.Amount, req.Reason)
+	if err != nil {
+		respondGiftCardError(c, err, "Failed to top up gift card")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    card,
+	})
+}
+
+func (h *handler) VoidGiftCard(c *gin.Context) {
+	if !requireRole(c, isManagerRole, "Only managers and admins can void gift cards") {
+		return
+	}
+
+	var req struct {
+		Reason string `json:"reason" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Invalid request payload",
+		})
+		return
+	}
+
+	card, err := h.giftCardBiz.VoidGiftCard(c.Request.Context(), c.Param("giftCardId"), req.Reason)
+	if err != nil {
+		respondGiftCardError(c, err, "Failed to void gift card")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    card,
+	})
+}
+
+func (h *handler) PayWithGiftCard(c *gin.Context) {
+	var req struct {
+		Code   string  `json:"code" binding:"required"`
+		Amount float64 `json:"amount"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": "Invalid request payload",
+		})
+		return
+	}
+
+	bookingId := c.Param("bookingId")
+	if !h.requireBookingAccess(c, bookingId) {
+		return
+	}
+
+	payment, err := h.paymentBiz.PayWithGiftCard(c.Request.Context(), bookingId, req.Code, req.Amount)
+	if err != nil {
+		respondGiftCardError(c, err, "Failed to pay with gift card")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    payment,
+	})
+}
+
+func (h *handler) ReleaseGiftCards(c *gin.Context) {
+	bookingId := c.Param("bookingId")
+	if !h.requireBookingAccess(c, bookingId) {
+		return
+	}
+
+	released, err := h.paymentBiz.ReleaseGiftCards(c.Request.Context(), bookingId)
+	if err != nil {
+		respondGiftCardError(c, err, "Failed to release gift cards")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": gin.H{
+			"released_amount": released,
+		},
+	})
+}
+
+func respondGiftCardError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, business.ErrGiftCardNotFound),
+		errors.Is(err, business.ErrBookingNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"message": err.Error(),
+		})
+	case errors.Is(err, business.ErrBookingAccessDenied):
+		c.JSON(http.StatusForbidden, gin.H{
+			"success": false,
+			"message": err.Error(),
+		})
+	case errors.Is(err, business.ErrInvalidGiftCardAmount),
+		errors.Is(err, business.ErrInvalidGiftCardExpiry),
+		errors.Is(err, business.ErrGiftCardNotUsable),
+		errors.Is(err, business.ErrGiftCardEmpty),
+		errors.Is(err, business.ErrPaymentNotPending):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"message": err.Error(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"message": message,
+			"error":   err.Error(),
+		})
+	}
+}
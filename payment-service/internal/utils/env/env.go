@@ -5,6 +5,7 @@ import (
 	"os"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -26,8 +27,16 @@ func LoadOnce(inits ...func()) {
 func EnvsRequired(envs ...string) error {
 	for _, env := range envs {
 		if value := strings.TrimSpace(os.Getenv(env)); value == "" {
-			return fmt.Errorf("Required environment variable not set: " + env)
+			return fmt.Errorf("Required environment variable not set: %s", env)
 		}
 	}
 	return nil
 }
+
+func GetDuration(env string, fallback time.Duration) time.Duration {
+	v, err := time.ParseDuration(os.Getenv(env))
+	if err != nil || v <= 0 {
+		return fallback
+	}
+	return v
+}
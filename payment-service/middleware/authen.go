@@ -10,7 +10,7 @@ import (
 )
 
 type AuthService interface {
-	Validate(ctx context.Context, jwtToken string) (int, string, []string, error)
+	Validate(ctx context.Context, jwtToken string) (int, string, string, []string, error)
 }
 
 func RequireAuth(auth AuthService) func(*gin.Context) {
@@ -23,11 +23,11 @@ func RequireAuth(auth AuthService) func(*gin.Context) {
 
 		token, err := extractTokenFromHeaderString(authorizerHeader)
 		if err != nil {
-			c.Abort()
+			c.AbortWithStatus(http.StatusUnauthorized)
 			return
 		}
 
-		status, role, permissions, err := auth.Validate(c.Request.Context(), token)
+		status, userId, role, permissions, err := auth.Validate(c.Request.Context(), token)
 		if err != nil {
 			c.AbortWithStatusJSON(401, gin.H{"error": "Unauthorized: token validation failed"})
 			return
@@ -38,6 +38,12 @@ func RequireAuth(auth AuthService) func(*gin.Context) {
 			return
 		}
 
+		if userId == "" {
+			c.AbortWithStatusJSON(401, gin.H{"error": "Unauthorized: user ID not found in token"})
+			return
+		}
+
+		c.Set("user_id", userId)
 		c.Set("userRole", role)
 		c.Set("userPermissions", permissions)
 
@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"payment-service/internal/pkg/idempotency"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+// bodyRecorder tees the response body so it can be stored for replays.
+type bodyRecorder struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (r *bodyRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *bodyRecorder) WriteString(s string) (int, error) {
+	r.body.WriteString(s)
+	return r.ResponseWriter.WriteString(s)
+}
+
+// Idempotency replays the stored response when a request is retried with the
+// same Idempotency-Key header and body. Keys are scoped to the authenticated
+// user, so it must run after RequireAuth. Reusing a key with a different body,
+// or while the first request is in flight, is rejected with 409.
+func Idempotency(redisClient redis.UniversalClient, ttl time.Duration) gin.HandlerFunc {
+	store := idempotency.NewStore(redisClient, "payment", ttl)
+
+	return func(c *gin.Context) {
+		idempotencyKey := c.GetHeader(idempotency.HeaderKey)
+		if idempotencyKey == "" {
+			c.Next()
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			abortIdempotency(c, http.StatusBadRequest, "Invalid request payload")
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		ctx := c.Request.Context()
+		requestHash := idempotency.RequestHash(c.Request.Method, c.FullPath(), body)
+
+		lease, record, err := store.Begin(ctx, c.GetString("user_id"), idempotencyKey, requestHash)
+		switch {
+		case errors.Is(err, idempotency.ErrKeyTooLong):
+			abortIdempotency(c, http.StatusBadRequest, "Idempotency-Key header is too long")
+			return
+		case errors.Is(err, idempotency.ErrInProgress):
+			abortIdempotency(c, http.StatusConflict, "A request with this Idempotency-Key is being processed")
+			return
+		case errors.Is(err, idempotency.ErrKeyMismatch):
+			abortIdempotency(c, http.StatusConflict, "Idempotency-Key was already used with a different request")
+			return
+		case err != nil:
+			abortIdempotency(c, http.StatusInternalServerError, "Failed to process Idempotency-Key")
+			return
+		}
+
+		if record != nil {
+			c.Header(idempotency.HeaderReplayed, "true")
+			c.Data(record.StatusCode, record.ContentType, record.Body)
+			c.Abort()
+			return
+		}
+
+		recorder := &bodyRecorder{ResponseWriter: c.Writer, body: new(bytes.Buffer)}
+		c.Writer = recorder
+
+		c.Next()
+
+		// Use a fresh context so a cancelled request still settles its key.
+		lease.Complete(context.WithoutCancel(ctx), recorder.Status(), recorder.Header().Get("Content-Type"), recorder.body.Bytes())
+	}
+}
+
+func abortIdempotency(c *gin.Context, statusCode int, message string) {
+	c.AbortWithStatusJSON(statusCode, gin.H{
+		"success": false,
+		"message": message,
+	})
+}
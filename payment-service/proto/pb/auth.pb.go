@@ -156,7 +156,7 @@ const file_auth_proto_rawDesc = "" +
 	"\x04role\x18\x04 \x01(\tR\x04role\x12 \n" +
 	"\vpermissions\x18\x05 \x03(\tR\vpermissions2D\n" +
 	"\vAuthService\x125\n" +
-	"\bvalidate\x12\x13.pb.ValidateRequest\x1a\x14.pb.ValidateResponseB\x05Z\x03pb/b\x06proto3"
+	"\bValidate\x12\x13.pb.ValidateRequest\x1a\x14.pb.ValidateResponseB\x05Z\x03pb/b\x06proto3"
 
 var (
 	file_auth_proto_rawDescOnce sync.Once
@@ -176,8 +176,8 @@ var file_auth_proto_goTypes = []any{
 	(*ValidateResponse)(nil), // 1: pb.ValidateResponse
 }
 var file_auth_proto_depIdxs = []int32{
-	0, // 0: pb.AuthService.validate:input_type -> pb.ValidateRequest
-	1, // 1: pb.AuthService.validate:output_type -> pb.ValidateResponse
+	0, // 0: pb.AuthService.Validate:input_type -> pb.ValidateRequest
+	1, // 1: pb.AuthService.Validate:output_type -> pb.ValidateResponse
 	1, // [1:2] is the sub-list for method output_type
 	0, // [0:1] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
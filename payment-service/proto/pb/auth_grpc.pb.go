@@ -19,7 +19,7 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AuthService_Validate_FullMethodName = "/pb.AuthService/validate"
+	AuthService_Validate_FullMethodName = "/pb.AuthService/Validate"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -112,7 +112,7 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 	HandlerType: (*AuthServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "validate",
+			MethodName: "Validate",
 			Handler:    _AuthService_Validate_Handler,
 		},
 	},
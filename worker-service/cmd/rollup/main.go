@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"worker-service/internal/container"
+	"worker-service/internal/jobs/rollup"
+)
+
+func main() {
+	ctn := container.New()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rollupWorker, err := rollup.NewWorker(ctn)
+	if err != nil {
+		log.Fatal("Failed to create rollup worker:", err)
+	}
+
+	go func() {
+		if err = rollupWorker.Start(ctx); err != nil {
+			log.Printf("Rollup worker error: %v", err)
+		}
+	}()
+
+	log.Println("Analytics rollup worker started...")
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+	<-sigChan
+
+	log.Println("Shutting down rollup worker...")
+	cancel()
+	time.Sleep(2 * time.Second)
+}
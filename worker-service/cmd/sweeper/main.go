@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"worker-service/internal/container"
+	"worker-service/internal/jobs/sweeper"
+)
+
+func main() {
+	ctn := container.New()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sweeperWorker, err := sweeper.NewWorker(ctn)
+	if err != nil {
+		log.Fatal("Failed to create sweeper worker:", err)
+	}
+
+	go func() {
+		if err = sweeperWorker.Start(ctx); err != nil {
+			log.Printf("Sweeper worker error: %v", err)
+		}
+	}()
+
+	log.Println("Booking sweeper worker started...")
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+	<-sigChan
+
+	log.Println("Shutting down sweeper worker...")
+	cancel()
+	time.Sleep(2 * time.Second)
+}
@@ -46,6 +46,9 @@ func New() *do.Injector {
 	do.Provide(injector, provideRedisPubsub)
 	do.Provide(injector, provideOutboxRepository)
 	do.Provide(injector, provideNewsArticleRepository)
+	do.Provide(injector, provideBookingRepository)
+	do.Provide(injector, providePaymentRepository)
+	do.Provide(injector, provideAnalyticsRollupRepository)
 
 	return injector
 }
@@ -147,3 +150,15 @@ func provideOutboxRepository(i *do.Injector) (datastore.OutboxRepository, error)
 func provideNewsArticleRepository(i *do.Injector) (datastore.NewsArticleRepository, error) {
 	return datastore.NewNewsArticleRepository(i)
 }
+
+func provideBookingRepository(i *do.Injector) (datastore.BookingRepository, error) {
+	return datastore.NewBookingRepository(i)
+}
+
+func providePaymentRepository(i *do.Injector) (datastore.PaymentRepository, error) {
+	return datastore.NewPaymentRepository(i)
+}
+
+func provideAnalyticsRollupRepository(i *do.Injector) (datastore.AnalyticsRollupRepository, error) {
+	return datastore.NewAnalyticsRollupRepository(i)
+}
@@ -0,0 +1,200 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"worker-service/internal/models"
+
+	"github.com/samber/do"
+	"github.com/uptrace/bun"
+)
+
+type AnalyticsRollupRepository interface {
+	RefreshRollup(ctx context.Context, spec RollupSpec, closedUntil, refreshedAt time.Time) (int, error)
+}
+
+// RollupSpec describes one rollup table. Buckets are computed from
+// b.created_at in the session timezone, which is set to Timezone for the
+// whole refresh.
+type RollupSpec struct {
+	Name       string
+	Timezone   string
+	Table      string
+	Column     string
+	ColumnType string
+	BucketExpr string
+}
+
+var (
+	HourlyRollup = RollupSpec{
+		Name:       models.AnalyticsRollupHourly,
+		Timezone:   "UTC",
+		Table:      "analytics_hourly_rollups",
+		Column:     "bucket_start",
+		ColumnType: "TIMESTAMPTZ",
+		BucketExpr: "DATE_TRUNC('hour', b.created_at)",
+	}
+	DailyRollup = RollupSpec{
+		Name:       models.AnalyticsRollupDaily,
+		Timezone:   "UTC",
+		Table:      "analytics_daily_rollups",
+		Column:     "bucket_date",
+		ColumnType: "DATE",
+		BucketExpr: "b.created_at::date",
+	}
+)
+
+type analyticsRollupRepository struct {
+	db *bun.DB
+}
+
+func NewAnalyticsRollupRepository(i *do.Injector) (AnalyticsRollupRepository, error) {
+	db, err := do.Invoke[*bun.DB](i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &analyticsRollupRepository{
+		db: db,
+	}, nil
+}
+
+// RefreshRollup recomputes every bucket before closedUntil that is new since
+// the last run or holds a booking or ticket changed since then, and returns
+// how many buckets it rebuilt. refreshedAt is stored as the point from which
+// the next run looks for changes, so it should trail the current time by the
+// longest expected transaction. Changing the spec's timezone rebuilds the
+// whole table.
+func (r *analyticsRollupRepository) RefreshRollup(ctx context.Context, spec RollupSpec, closedUntil, refreshedAt time.Time) (int, error) {
+	var refreshed int
+
+	err := r.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.ExecContext(ctx, "SET LOCAL TIME ZONE ?", spec.Timezone); err != nil {
+			return fmt.Errorf("failed to set rollup timezone: %w", err)
+		}
+
+		state := &models.AnalyticsRollupState{Name: spec.Name, Timezone: spec.Timezone}
+		_, err := tx.NewInsert().
+			Model(state).
+			On("CONFLICT (name) DO NOTHING").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create rollup state: %w", err)
+		}
+
+		err = tx.NewSelect().
+			Model(state).
+			WherePK().
+			For("UPDATE").
+			Scan(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to lock rollup state: %w", err)
+		}
+
+		if state.Timezone != spec.Timezone {
+			if _, err = tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s", spec.Table)); err != nil {
+				return fmt.Errorf("failed to reset %s rollups: %w", spec.Name, err)
+			}
+			state.ClosedUntil = nil
+			state.RefreshedAt = nil
+		}
+
+		if state.ClosedUntil != nil && state.ClosedUntil.After(closedUntil) {
+			closedUntil = *state.ClosedUntil
+		}
+
+		_, err = tx.ExecContext(ctx, fmt.Sprintf(
+			"CREATE TEMP TABLE analytics_rollup_dirty (bucket %s PRIMARY KEY) ON COMMIT DROP", spec.ColumnType,
+		))
+		if err != nil {
+			return fmt.Errorf("failed to create dirty bucket table: %w", err)
+		}
+
+		dirtyQuery := fmt.Sprintf(`
+			INSERT INTO analytics_rollup_dirty (bucket)
+			SELECT DISTINCT %s FROM bookings b
+			WHERE b.created_at < ?
+		`, spec.BucketExpr)
+		args := []interface{}{closedUntil}
+
+		// Bookings created just before the last run may have committed after
+		// it, so anything created since refreshed_at is rechecked as well.
+		if state.ClosedUntil != nil && state.RefreshedAt != nil {
+			dirtyQuery += `
+				AND (
+					b.created_at >= ?
+					OR b.created_at >= ?
+					OR b.updated_at >= ?
+					OR EXISTS (
+						SELECT 1 FROM tickets t
+						WHERE t.booking_id = b.id
+						  AND (t.created_at >= ? OR t.updated_at >= ?)
+					)
+				)
+			`
+			args = append(args, *state.ClosedUntil, *state.RefreshedAt, *state.RefreshedAt, *state.RefreshedAt, *state.RefreshedAt)
+		}
+
+		res, err := tx.ExecContext(ctx, dirtyQuery, args...)
+		if err != nil {
+			return fmt.Errorf("failed to collect dirty %s buckets: %w", spec.Name, err)
+		}
+
+		dirty, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if dirty > 0 {
+			_, err = tx.ExecContext(ctx, fmt.Sprintf(`
+				DELETE FROM %s r
+				USING analytics_rollup_dirty d
+				WHERE r.%s = d.bucket
+			`, spec.Table, spec.Column))
+			if err != nil {
+				return fmt.Errorf("failed to clear dirty %s buckets: %w", spec.Name, err)
+			}
+
+			_, err = tx.ExecContext(ctx, fmt.Sprintf(`
+				INSERT INTO %s (%s, showtime_id, booking_type, total_bookings, total_tickets, total_revenue, updated_at)
+				SELECT %s, b.showtime_id, b.booking_type, COUNT(*), COALESCE(SUM(tc.tickets), 0), SUM(b.total_amount), NOW()
+				FROM bookings b
+				INNER JOIN analytics_rollup_dirty d ON d.bucket = %s
+				LEFT JOIN LATERAL (
+					SELECT COUNT(*) AS tickets FROM tickets t
+					WHERE t.booking_id = b.id AND t.status != 'CANCELLED'
+				) tc ON TRUE
+				WHERE b.status = ?
+				  AND b.created_at >= (SELECT MIN(bucket)::timestamptz FROM analytics_rollup_dirty)
+				  AND b.created_at < ?
+				GROUP BY 1, 2, 3
+			`, spec.Table, spec.Column, spec.BucketExpr, spec.BucketExpr), models.BookingStatusConfirmed, closedUntil)
+			if err != nil {
+				return fmt.Errorf("failed to rebuild %s buckets: %w", spec.Name, err)
+			}
+		}
+
+		_, err = tx.NewUpdate().
+			Model(state).
+			Set("timezone = ?", spec.Timezone).
+			Set("closed_until = ?", closedUntil).
+			Set("refreshed_at = ?", refreshedAt).
+			Set("updated_at = CURRENT_TIMESTAMP").
+			WherePK().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update rollup state: %w", err)
+		}
+
+		refreshed = int(dirty)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return refreshed, nil
+}
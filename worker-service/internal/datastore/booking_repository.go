@@ -0,0 +1,78 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"worker-service/internal/models"
+
+	"github.com/samber/do"
+	"github.com/uptrace/bun"
+)
+
+type BookingRepository interface {
+	GetBookingByID(ctx context.Context, bookingID string) (*models.Booking, error)
+	GetBookingSeatIDs(ctx context.Context, bookingID string) ([]string, error)
+	GetExpiredPendingBookings(ctx context.Context, createdBefore time.Time, limit int) ([]models.Booking, error)
+}
+
+type bookingRepository struct {
+	db *bun.DB
+}
+
+func NewBookingRepository(i *do.Injector) (BookingRepository, error) {
+	db, err := do.Invoke[*bun.DB](i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bookingRepository{
+		db: db,
+	}, nil
+}
+
+func (r *bookingRepository) GetBookingByID(ctx context.Context, bookingID string) (*models.Booking, error) {
+	booking := new(models.Booking)
+	err := r.db.NewSelect().
+		Model(booking).
+		Where("id = ?", bookingID).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get booking: %w", err)
+	}
+
+	return booking, nil
+}
+
+func (r *bookingRepository) GetBookingSeatIDs(ctx context.Context, bookingID string) ([]string, error) {
+	seatIDs := make([]string, 0)
+	err := r.db.NewSelect().
+		Model((*models.BookingSeat)(nil)).
+		Column("seat_id").
+		Where("booking_id = ?", bookingID).
+		OrderExpr("created_at ASC").
+		Scan(ctx, &seatIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get booking seats: %w", err)
+	}
+
+	return seatIDs, nil
+}
+
+func (r *bookingRepository) GetExpiredPendingBookings(ctx context.Context, createdBefore time.Time, limit int) ([]models.Booking, error) {
+	bookings := make([]models.Booking, 0)
+
+	err := r.db.NewSelect().
+		Model(&bookings).
+		Where("status = ?", models.BookingStatusPending).
+		Where("created_at < ?", createdBefore).
+		OrderExpr("created_at ASC").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query expired bookings: %w", err)
+	}
+
+	return bookings, nil
+}
@@ -12,7 +12,6 @@ import (
 
 type OutboxRepository interface {
 	GetPendingEvents(ctx context.Context, limit int) ([]models.OutboxEvent, error)
-	GetBookingEventByBookingID(ctx context.Context, bookingID string) (*models.OutboxEvent, error)
 	MarkEventAsSent(ctx context.Context, eventID int) error
 	MarkEventAsFailed(ctx context.Context, eventID int) error
 }
@@ -48,22 +47,6 @@ func (r *outboxRepository) GetPendingEvents(ctx context.Context, limit int) ([]m
 	return events, nil
 }
 
-func (r *outboxRepository) GetBookingEventByBookingID(ctx context.Context, bookingID string) (*models.OutboxEvent, error) {
-	event := new(models.OutboxEvent)
-	err := r.db.NewSelect().
-		Model(event).
-		Where("event_type = ?", models.EventTypeBookingCreated).
-		Where("payload::jsonb->>'booking_id' = ?", bookingID).
-		Order("id DESC").
-		Limit(1).
-		Scan(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get booking event: %w", err)
-	}
-
-	return event, nil
-}
-
 func (r *outboxRepository) MarkEventAsSent(ctx context.Context, eventID int) error {
 	_, err := r.db.NewUpdate().
 		Model((*models.OutboxEvent)(nil)).
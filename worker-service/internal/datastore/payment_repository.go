@@ -0,0 +1,63 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"worker-service/internal/models"
+
+	"github.com/samber/do"
+	"github.com/uptrace/bun"
+)
+
+type PaymentRepository interface {
+	ExpirePendingPayment(ctx context.Context, bookingID string) (*models.Payment, error)
+}
+
+type paymentRepository struct {
+	db *bun.DB
+}
+
+func NewPaymentRepository(i *do.Injector) (PaymentRepository, error) {
+	db, err := do.Invoke[*bun.DB](i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &paymentRepository{
+		db: db,
+	}, nil
+}
+
+// ExpirePendingPayment marks the booking's payment as EXPIRED if it is still
+// PENDING and returns the payment as it stands afterwards, or nil when the
+// booking never reached the payment step.
+func (r *paymentRepository) ExpirePendingPayment(ctx context.Context, bookingID string) (*models.Payment, error) {
+	_, err := r.db.NewUpdate().
+		Model((*models.Payment)(nil)).
+		Set("status = ?", models.PaymentStatusExpired).
+		SetColumn("updated_at", "NOW()").
+		Where("booking_id = ?", bookingID).
+		Where("status = ?", models.PaymentStatusPending).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to expire payment: %w", err)
+	}
+
+	payment := new(models.Payment)
+	err = r.db.NewSelect().
+		Model(payment).
+		Where("booking_id = ?", bookingID).
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get payment: %w", err)
+	}
+
+	return payment, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"worker-service/proto/pb"
 
@@ -73,3 +74,118 @@ func (c *BookingClient) CreateTicketsWithDetails(ctx context.Context, bookingId
 
 	return resp, nil
 }
+
+func (c *BookingClient) CancelBooking(ctx context.Context, bookingId, userId, reason string) (*pb.CancelBookingResponse, error) {
+	req := &pb.CancelBookingRequest{
+		BookingId: bookingId,
+		UserId:    userId,
+		Reason:    reason,
+	}
+
+	resp, err := c.client.CancelBooking(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel booking via gRPC: %w", err)
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("cancel booking failed: %s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+func (c *BookingClient) ProcessWaitlist(ctx context.Context, showtimeId string, seatIds []string) ([]*pb.WaitlistOffer, error) {
+	req := &pb.ProcessWaitlistRequest{
+		ShowtimeId: showtimeId,
+		SeatIds:    seatIds,
+	}
+
+	resp, err := c.client.ProcessWaitlist(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to process waitlist via gRPC: %w", err)
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("process waitlist failed: %s", resp.Message)
+	}
+
+	return resp.Offers, nil
+}
+
+func (c *BookingClient) ExpireWaitlistOffers(ctx context.Context, limit int) (int, error) {
+	req := &pb.ExpireWaitlistOffersRequest{
+		Limit: int32(limit),
+	}
+
+	resp, err := c.client.ExpireWaitlistOffers(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to expire waitlist offers via gRPC: %w", err)
+	}
+
+	if !resp.Success {
+		return 0, fmt.Errorf("expire waitlist offers failed: %s", resp.Message)
+	}
+
+	return int(resp.Expired), nil
+}
+
+func (c *BookingClient) ExpireSeatHolds(ctx context.Context, limit int) (int, error) {
+	req := &pb.ExpireSeatHoldsRequest{
+		Limit: int32(limit),
+	}
+
+	resp, err := c.client.ExpireSeatHolds(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to expire seat holds via gRPC: %w", err)
+	}
+
+	if !resp.Success {
+		return 0, fmt.Errorf("expire seat holds failed: %s", resp.Message)
+	}
+
+	return int(resp.Expired), nil
+}
+
+// ClaimBookingSeats sets the seats a booking owns to the given seat state and
+// returns the seats the booking owns afterwards.
+func (c *BookingClient) ClaimBookingSeats(ctx context.Context, showtimeId, bookingId string, seatIds []string, state string, ttl time.Duration) ([]string, error) {
+	req := &pb.ClaimBookingSeatsRequest{
+		ShowtimeId: showtimeId,
+		BookingId:  bookingId,
+		SeatIds:    seatIds,
+		State:      state,
+		TtlSeconds: int64(ttl / time.Second),
+	}
+
+	resp, err := c.client.ClaimBookingSeats(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim booking seats via gRPC: %w", err)
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("claim booking seats failed: %s", resp.Message)
+	}
+
+	return resp.ClaimedSeatIds, nil
+}
+
+// ReleaseBookingSeats drops the seats still owned by a booking and returns
+// those that are free afterwards.
+func (c *BookingClient) ReleaseBookingSeats(ctx context.Context, showtimeId, bookingId string, seatIds []string) ([]string, error) {
+	req := &pb.ReleaseBookingSeatsRequest{
+		ShowtimeId: showtimeId,
+		BookingId:  bookingId,
+		SeatIds:    seatIds,
+	}
+
+	resp, err := c.client.ReleaseBookingSeats(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to release booking seats via gRPC: %w", err)
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("release booking seats failed: %s", resp.Message)
+	}
+
+	return resp.ReleasedSeatIds, nil
+}
@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"worker-service/proto/pb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type PaymentClient struct {
+	conn   *grpc.ClientConn
+	client pb.PaymentServiceClient
+}
+
+func NewPaymentClient() (*PaymentClient, error) {
+	paymentServiceURL := os.Getenv("PAYMENT_SERVICE_GRPC_URL")
+	if paymentServiceURL == "" {
+		paymentServiceURL = "payment-service:50086"
+	}
+
+	conn, err := grpc.NewClient(
+		paymentServiceURL,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to payment service: %w", err)
+	}
+
+	client := pb.NewPaymentServiceClient(conn)
+
+	return &PaymentClient{
+		conn:   conn,
+		client: client,
+	}, nil
+}
+
+func (c *PaymentClient) CreatePaymentAdjustment(ctx context.Context, bookingId, referenceId string, amount float64, reason string) (*pb.CreatePaymentAdjustmentResponse, error) {
+	req := &pb.CreatePaymentAdjustmentRequest{
+		BookingId:   bookingId,
+		ReferenceId: referenceId,
+		Amount:      amount,
+		Reason:      reason,
+	}
+
+	resp, err := c.client.CreatePaymentAdjustment(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create payment adjustment via gRPC: %w", err)
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("create payment adjustment failed: %s", resp.Message)
+	}
+
+	return resp, nil
+}
+
+func (c *PaymentClient) ReleaseGiftCards(ctx context.Context, bookingId string) (float64, error) {
+	resp, err := c.client.ReleaseGiftCards(ctx, &pb.ReleaseGiftCardsRequest{BookingId: bookingId})
+	if err != nil {
+		return 0, fmt.Errorf("failed to release gift cards via gRPC: %w", err)
+	}
+
+	if !resp.Success {
+		return 0, fmt.Errorf("release gift cards failed: %s", resp.Message)
+	}
+
+	return resp.ReleasedAmount, nil
+}
+
+func (c *PaymentClient) Close() error {
+	if c.conn != nil {
+		return c.conn.Close()
+	}
+	return nil
+}
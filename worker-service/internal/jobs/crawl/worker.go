@@ -46,7 +46,7 @@ func (w *Worker) Start(ctx context.Context) error {
 			return ctx.Err()
 		case <-ticker.C:
 			if err := w.crawl(ctx); err != nil {
-				logrus.Error("Crawl failed: %v", err)
+				logrus.Errorf("Crawl failed: %v", err)
 			}
 		}
 	}
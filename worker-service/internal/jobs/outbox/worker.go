@@ -12,18 +12,24 @@ import (
 	"worker-service/internal/pkg/logger"
 	"worker-service/internal/pkg/pubsub"
 
-	"github.com/redis/go-redis/v9"
 	"github.com/samber/do"
 )
 
+// seatMapTopic is the topic booking-service publishes seat holds to; the
+// worker adds bookings and releases, and notification-service relays them.
+func seatMapTopic(showtimeId string) string {
+	return fmt.Sprintf("seat_map_%s", showtimeId)
+}
+
 type Worker struct {
 	logger        logger.Logger
 	pubsub        pubsub.PubSub
-	redisClient   redis.UniversalClient
 	bookingClient *grpc.BookingClient
 	userClient    *grpc.UserClient
 	movieClient   *grpc.MovieClient
+	paymentClient *grpc.PaymentClient
 	outboxRepo    datastore.OutboxRepository
+	bookingRepo   datastore.BookingRepository
 }
 
 func NewWorker(ctn *do.Injector) (*Worker, error) {
@@ -37,14 +43,14 @@ func NewWorker(ctn *do.Injector) (*Worker, error) {
 		return nil, err
 	}
 
-	redisClient, err := do.InvokeNamed[redis.UniversalClient](ctn, "redis-db")
+	outboxRepo, err := do.Invoke[datastore.OutboxRepository](ctn)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to get outbox repository: %w", err)
 	}
 
-	outboxRepo, err := do.Invoke[datastore.OutboxRepository](ctn)
+	bookingRepo, err := do.Invoke[datastore.BookingRepository](ctn)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get outbox repository: %w", err)
+		return nil, fmt.Errorf("failed to get booking repository: %w", err)
 	}
 
 	bookingClient, err := grpc.NewBookingClient()
@@ -62,14 +68,20 @@ func NewWorker(ctn *do.Injector) (*Worker, error) {
 		return nil, fmt.Errorf("failed to create movie client: %w", err)
 	}
 
+	paymentClient, err := grpc.NewPaymentClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create payment client: %w", err)
+	}
+
 	return &Worker{
 		logger:        log,
 		pubsub:        pubsub,
-		redisClient:   redisClient,
 		outboxRepo:    outboxRepo,
+		bookingRepo:   bookingRepo,
 		bookingClient: bookingClient,
 		userClient:    userClient,
 		movieClient:   movieClient,
+		paymentClient: paymentClient,
 	}, nil
 }
 
@@ -129,6 +141,10 @@ func (w *Worker) processEvent(ctx context.Context, event models.OutboxEvent) err
 		return w.handleBookingCreated(ctx, event)
 	case models.EventTypePaymentCompleted:
 		return w.handlePaymentCompleted(ctx, event)
+	case models.EventTypeSeatReleased:
+		return w.handleSeatReleased(ctx, event)
+	case models.EventTypeBookingExchanged:
+		return w.handleBookingExchanged(ctx, event)
 	default:
 		w.logger.Warn("Unknown event type: %s", event.EventType)
 		return nil
@@ -145,12 +161,11 @@ func (w *Worker) handleBookingCreated(ctx context.Context, event models.OutboxEv
 	seatIds := data.SeatIds
 	showtimeId := data.ShowtimeId
 
-	for _, seatId := range seatIds {
-		lockKey := fmt.Sprintf("seat_lock:%s:%s", showtimeId, seatId)
-
-		if err := w.redisClient.Set(ctx, lockKey, bookingID, 5*time.Minute).Err(); err != nil {
-			w.logger.Error("Failed to cache seat lock %s: %v", lockKey, err)
-		}
+	// The seats stay HELD until the booking is paid. Seat map subscribers
+	// already see them held by the user, so there is nothing to publish yet.
+	_, err := w.bookingClient.ClaimBookingSeats(ctx, showtimeId, bookingID, seatIds, string(models.SeatStateHeld), 5*time.Minute)
+	if err != nil {
+		w.logger.Error("Failed to cache seat locks for booking %s: %v", bookingID, err)
 	}
 
 	w.logger.Info("Successfully cached seat locks for booking %s with %d seats", bookingID, len(seatIds))
@@ -182,27 +197,43 @@ func (w *Worker) handlePaymentCompleted(ctx context.Context, event models.Outbox
 		amount = float64(amountInt)
 	}
 
-	bookingEvent, err := w.outboxRepo.GetBookingEventByBookingID(ctx, bookingID)
+	booking, err := w.bookingRepo.GetBookingByID(ctx, bookingID)
 	if err != nil {
-		return fmt.Errorf("failed to get booking event: %w", err)
+		return err
 	}
 
-	bookingEventData := new(models.BookingEventData)
-	if err = json.Unmarshal([]byte(bookingEvent.Payload), bookingEventData); err != nil {
-		return fmt.Errorf("failed to unmarshal booking event data: %w", err)
+	// The booking was cancelled while the payment was in flight; its seats
+	// are released and may be sold again, so it is not confirmed.
+	if booking.Status == models.BookingStatusCancelled {
+		w.logger.Warn("Payment %s completed for cancelled booking %s", paymentID, bookingID)
+		return nil
 	}
 
-	if len(bookingEventData.SeatIds) == 0 {
+	seatIds, err := w.bookingRepo.GetBookingSeatIDs(ctx, bookingID)
+	if err != nil {
+		return err
+	}
+
+	if len(seatIds) == 0 {
 		return fmt.Errorf("no seats found for booking %s", bookingID)
 	}
 
+	bookingEventData := &models.BookingEventData{
+		BookingId:   booking.Id,
+		UserId:      booking.UserId,
+		ShowtimeId:  booking.ShowtimeId,
+		SeatIds:     seatIds,
+		TotalAmount: booking.TotalAmount,
+		Status:      string(booking.Status),
+	}
+
 	resp, err := w.bookingClient.UpdateBookingStatusWithResponse(ctx, bookingID, "CONFIRMED")
 	if err != nil {
 		return err
 	}
 
-	if err = w.extendSeatLocksUntilMovieEnds(ctx, bookingEventData); err != nil {
-		w.logger.Error("Failed to extend seat locks for booking %s: %v", bookingID, err)
+	if err = w.bookSeatsUntilMovieEnds(ctx, bookingEventData); err != nil {
+		w.logger.Error("Failed to mark seats booked for booking %s: %v", bookingID, err)
 	}
 
 	userID := resp.UserId
@@ -226,6 +257,10 @@ func (w *Worker) handlePaymentCompleted(ctx context.Context, event models.Outbox
 		"title":      "Payment Successful",
 		"message":    fmt.Sprintf("Your booking %s has been confirmed. Payment of %.2f VND received.", bookingID, amount),
 	}
+	if resp.PointsEarned > 0 {
+		notificationData["points_earned"] = resp.PointsEarned
+		notificationData["message"] = fmt.Sprintf("Your booking %s has been confirmed. Payment of %.2f VND received. You earned %d loyalty points.", bookingID, amount, resp.PointsEarned)
+	}
 
 	emailData := map[string]interface{}{
 		"user_id":    userID,
@@ -274,7 +309,216 @@ func (w *Worker) handlePaymentCompleted(ctx context.Context, event models.Outbox
 	return w.pubsub.Publish(ctx, userMessage)
 }
 
-func (w *Worker) extendSeatLocksUntilMovieEnds(ctx context.Context, eventData *models.BookingEventData) error {
+func (w *Worker) handleSeatReleased(ctx context.Context, event models.OutboxEvent) error {
+	data := new(models.SeatReleasedEventData)
+	if err := json.Unmarshal([]byte(event.Payload), data); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+
+	w.releaseSeatLocks(ctx, data.BookingId, data.ShowtimeId, data.SeatIds)
+
+	w.logger.Info("Released %d seats for cancelled booking %s", len(data.SeatIds), data.BookingId)
+
+	w.offerWaitlistSeats(ctx, data.ShowtimeId, data.SeatIds)
+
+	if data.UserId == "" || data.Reason == models.SeatReleaseReasonHoldExpired {
+		return nil
+	}
+
+	message := fmt.Sprintf("Your booking %s has been cancelled.", data.BookingId)
+	if data.Reason != "" {
+		message = fmt.Sprintf("Your booking %s has been cancelled: %s", data.BookingId, data.Reason)
+	}
+
+	notificationData := map[string]interface{}{
+		"user_id":    data.UserId,
+		"booking_id": data.BookingId,
+		"status":     "CANCELLED",
+		"timestamp":  time.Now().Unix(),
+		"title":      "Booking Cancelled",
+		"message":    message,
+	}
+
+	userMessage := &pubsub.Message{
+		Topic: fmt.Sprintf("booking_%s", data.UserId),
+		Data:  notificationData,
+	}
+
+	return w.pubsub.Publish(ctx, userMessage)
+}
+
+// handleBookingExchanged moves the seat locks of an exchanged booking to its
+// new showtime and settles the price difference with payment-service.
+func (w *Worker) handleBookingExchanged(ctx context.Context, event models.OutboxEvent) error {
+	data := new(models.BookingExchangedEventData)
+	if err := json.Unmarshal([]byte(event.Payload), data); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+
+	w.releaseSeatLocks(ctx, data.BookingId, data.OldShowtimeId, data.OldSeatIds)
+	w.offerWaitlistSeats(ctx, data.OldShowtimeId, data.OldSeatIds)
+
+	bookingEventData := &models.BookingEventData{
+		BookingId:  data.BookingId,
+		UserId:     data.UserId,
+		ShowtimeId: data.NewShowtimeId,
+		SeatIds:    data.NewSeatIds,
+	}
+
+	if err := w.bookSeatsUntilMovieEnds(ctx, bookingEventData); err != nil {
+		w.logger.Error("Failed to mark seats booked for booking %s: %v", data.BookingId, err)
+	}
+
+	message := fmt.Sprintf("Your booking %s has been moved to a new showtime.", data.BookingId)
+
+	if data.PriceDifference != 0 {
+		reason := fmt.Sprintf("Exchange of booking %s from showtime %s to %s", data.BookingId, data.OldShowtimeId, data.NewShowtimeId)
+
+		resp, err := w.paymentClient.CreatePaymentAdjustment(ctx, data.BookingId, data.ExchangeId, data.PriceDifference, reason)
+		if err != nil {
+			return err
+		}
+
+		w.logger.Info("Created %s adjustment %s of %.2f for exchanged booking %s", resp.Type, resp.AdjustmentId, resp.Amount, data.BookingId)
+
+		if data.PriceDifference > 0 {
+			message = fmt.Sprintf("%s An additional %.2f VND is due.", message, data.PriceDifference)
+		} else {
+			message = fmt.Sprintf("%s %.2f VND will be refunded.", message, -data.PriceDifference)
+		}
+	}
+
+	notificationData := map[string]interface{}{
+		"user_id":    data.UserId,
+		"booking_id": data.BookingId,
+		"status":     "EXCHANGED",
+		"timestamp":  time.Now().Unix(),
+		"title":      "Booking Exchanged",
+		"message":    message,
+	}
+
+	userMessage := &pubsub.Message{
+		Topic: fmt.Sprintf("booking_%s", data.UserId),
+		Data:  notificationData,
+	}
+
+	return w.pubsub.Publish(ctx, userMessage)
+}
+
+// offerWaitlistSeats hands released seats to the showtime's waitlist and tells
+// each user who got an offer. Failures are only logged: the seats stay free
+// for regular bookings either way.
+func (w *Worker) offerWaitlistSeats(ctx context.Context, showtimeId string, seatIds []string) {
+	if len(seatIds) == 0 {
+		return
+	}
+
+	offers, err := w.bookingClient.ProcessWaitlist(ctx, showtimeId, seatIds)
+	if err != nil {
+		w.logger.Error("Failed to process waitlist for showtime %s: %v", showtimeId, err)
+		return
+	}
+
+	for _, offer := range offers {
+		expiresAt := time.Unix(offer.ExpiresAt, 0)
+
+		notificationData := map[string]interface{}{
+			"user_id":     offer.UserId,
+			"entry_id":    offer.EntryId,
+			"showtime_id": offer.ShowtimeId,
+			"hold_id":     offer.HoldId,
+			"seat_ids":    offer.SeatIds,
+			"expires_at":  offer.ExpiresAt,
+			"status":      "WAITLIST_OFFERED",
+			"timestamp":   time.Now().Unix(),
+			"title":       "Seats Available",
+			"message":     fmt.Sprintf("%d seat(s) opened up for a showtime you are waiting for. Book them before %s.", len(offer.SeatIds), expiresAt.Format("15:04")),
+		}
+
+		userMessage := &pubsub.Message{
+			Topic: fmt.Sprintf("booking_%s", offer.UserId),
+			Data:  notificationData,
+		}
+		if err = w.pubsub.Publish(ctx, userMessage); err != nil {
+			w.logger.Error("Failed to notify user %s of waitlist offer %s: %v", offer.UserId, offer.EntryId, err)
+		}
+
+		userEmail, err := w.userClient.GetUserEmailById(ctx, offer.UserId)
+		if err != nil {
+			w.logger.Error("Failed to get email of user %s for waitlist offer %s: %v", offer.UserId, offer.EntryId, err)
+			continue
+		}
+
+		emailData := map[string]interface{}{
+			"user_id":     offer.UserId,
+			"to":          userEmail,
+			"entry_id":    offer.EntryId,
+			"showtime_id": offer.ShowtimeId,
+			"hold_id":     offer.HoldId,
+			"seat_ids":    offer.SeatIds,
+			"expires_at":  offer.ExpiresAt,
+		}
+
+		if showtime, err := w.movieClient.GetShowtime(ctx, offer.ShowtimeId); err == nil {
+			emailData["showtime"] = map[string]interface{}{
+				"showtime_id": offer.ShowtimeId,
+				"start_time":  fmt.Sprintf("%s %s", showtime.ShowtimeDate, showtime.ShowtimeTime),
+				"movie_name":  showtime.MovieTitle,
+				"room_name":   showtime.RoomNumber,
+			}
+		}
+
+		emailMessage := &pubsub.Message{
+			Topic: "waitlist_offer",
+			Data:  emailData,
+		}
+		if err = w.pubsub.Publish(ctx, emailMessage); err != nil {
+			w.logger.Error("Failed to send waitlist offer email for entry %s: %v", offer.EntryId, err)
+		}
+	}
+
+	if len(offers) > 0 {
+		w.logger.Info("Offered released seats of showtime %s to %d waitlisted users", showtimeId, len(offers))
+	}
+}
+
+// releaseSeatLocks deletes the seat locks still held by the booking. Seats
+// already locked for another booking are left out of the seat map update.
+func (w *Worker) releaseSeatLocks(ctx context.Context, bookingId, showtimeId string, seatIds []string) {
+	released, err := w.bookingClient.ReleaseBookingSeats(ctx, showtimeId, bookingId, seatIds)
+	if err != nil {
+		w.logger.Error("Failed to release seat locks for booking %s: %v", bookingId, err)
+		return
+	}
+
+	w.publishSeatStates(ctx, showtimeId, released, models.SeatStateReleased)
+}
+
+// publishSeatStates sends the same diff booking-service sends for holds. The
+// event is handled either way, so a failure is only logged.
+func (w *Worker) publishSeatStates(ctx context.Context, showtimeId string, seatIds []string, state models.SeatState) {
+	if showtimeId == "" || len(seatIds) == 0 {
+		return
+	}
+
+	message := &pubsub.Message{
+		Topic: seatMapTopic(showtimeId),
+		Data: &models.SeatStateChange{
+			ShowtimeId: showtimeId,
+			SeatIds:    seatIds,
+			State:      state,
+			Timestamp:  time.Now().Unix(),
+		},
+	}
+
+	if err := w.pubsub.Publish(ctx, message); err != nil {
+		w.logger.Error("Failed to publish seat map update for showtime %s: %v", showtimeId, err)
+	}
+}
+
+// bookSeatsUntilMovieEnds marks the seats of a paid booking BOOKED until its
+// showtime is over and tells seat map subscribers.
+func (w *Worker) bookSeatsUntilMovieEnds(ctx context.Context, eventData *models.BookingEventData) error {
 	showtimeData, err := w.movieClient.GetShowtime(ctx, eventData.ShowtimeId)
 	if err != nil {
 		return fmt.Errorf("failed to get showtime data: %w", err)
@@ -290,15 +534,12 @@ func (w *Worker) extendSeatLocksUntilMovieEnds(ctx context.Context, eventData *m
 	movieEndTime := showtimeStart.Add(duration)
 	ttl := time.Until(movieEndTime)
 
-	for _, seatId := range eventData.SeatIds {
-		lockKey := fmt.Sprintf("seat_lock:%s:%s", eventData.ShowtimeId, seatId)
-
-		err = w.redisClient.Expire(ctx, lockKey, ttl).Err()
-		if err != nil {
-			return fmt.Errorf("failed to extend lock for seat %s: %w", seatId, err)
-		}
+	booked, err := w.bookingClient.ClaimBookingSeats(ctx, eventData.ShowtimeId, eventData.BookingId, eventData.SeatIds, string(models.SeatStateBooked), ttl)
+	if err != nil {
+		return fmt.Errorf("failed to book seat locks: %w", err)
 	}
 
+	w.publishSeatStates(ctx, eventData.ShowtimeId, booked, models.SeatStateBooked)
 	return nil
 }
 
@@ -0,0 +1,118 @@
+package rollup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"worker-service/internal/datastore"
+	"worker-service/internal/pkg/logger"
+
+	"github.com/samber/do"
+)
+
+const (
+	DefaultRefreshInterval = 5 * time.Minute
+	// RefreshOverlap covers bookings committed after a run that were created
+	// or changed shortly before it.
+	RefreshOverlap = 2 * time.Minute
+)
+
+// Worker keeps the analytics rollup tables up to date. Hourly rollups are
+// bucketed in UTC, daily rollups in the business timezone set by
+// ANALYTICS_TIMEZONE.
+type Worker struct {
+	logger     logger.Logger
+	rollupRepo datastore.AnalyticsRollupRepository
+	location   *time.Location
+	interval   time.Duration
+}
+
+func NewWorker(ctn *do.Injector) (*Worker, error) {
+	log, err := do.Invoke[logger.Logger](ctn)
+	if err != nil {
+		return nil, err
+	}
+
+	rollupRepo, err := do.Invoke[datastore.AnalyticsRollupRepository](ctn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get analytics rollup repository: %w", err)
+	}
+
+	timezone := os.Getenv("ANALYTICS_TIMEZONE")
+	if timezone == "" {
+		timezone = "UTC"
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ANALYTICS_TIMEZONE %q: %w", timezone, err)
+	}
+
+	interval := DefaultRefreshInterval
+	if value := os.Getenv("ANALYTICS_ROLLUP_INTERVAL"); value != "" {
+		interval, err = time.ParseDuration(value)
+		if err != nil || interval <= 0 {
+			return nil, fmt.Errorf("invalid ANALYTICS_ROLLUP_INTERVAL %q", value)
+		}
+	}
+
+	return &Worker{
+		logger:     log,
+		rollupRepo: rollupRepo,
+		location:   location,
+		interval:   interval,
+	}, nil
+}
+
+func (w *Worker) Start(ctx context.Context) error {
+	w.logger.Info("Starting analytics rollup worker (timezone %s, every %s)...", w.location, w.interval)
+
+	if err := w.refresh(ctx); err != nil {
+		w.logger.Error("Failed to refresh analytics rollups: %v", err)
+	}
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Analytics rollup worker stopped")
+			return ctx.Err()
+		case <-ticker.C:
+			if err := w.refresh(ctx); err != nil {
+				w.logger.Error("Failed to refresh analytics rollups: %v", err)
+			}
+		}
+	}
+}
+
+// refresh closes every bucket that ended before now. The bucket in progress
+// is left to the live queries of booking-service.
+func (w *Worker) refresh(ctx context.Context) error {
+	now := time.Now()
+	refreshedAt := now.Add(-RefreshOverlap)
+
+	hourly, err := w.rollupRepo.RefreshRollup(ctx, datastore.HourlyRollup, now.UTC().Truncate(time.Hour), refreshedAt)
+	if err != nil {
+		return err
+	}
+
+	local := now.In(w.location)
+	today := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, w.location)
+
+	daily := datastore.DailyRollup
+	daily.Timezone = w.location.String()
+
+	dailyCount, err := w.rollupRepo.RefreshRollup(ctx, daily, today, refreshedAt)
+	if err != nil {
+		return err
+	}
+
+	if hourly > 0 || dailyCount > 0 {
+		w.logger.Info("Refreshed %d hourly and %d daily analytics buckets", hourly, dailyCount)
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package sweeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"worker-service/internal/datastore"
+	"worker-service/internal/grpc"
+	"worker-service/internal/models"
+	"worker-service/internal/pkg/logger"
+	"worker-service/internal/pkg/pubsub"
+
+	"github.com/samber/do"
+)
+
+const (
+	// HoldWindow mirrors the seat lock TTL applied by booking-service on CreateBooking.
+	HoldWindow     = 5 * time.Minute
+	SweepInterval  = 1 * time.Minute
+	SweepBatchSize = 100
+)
+
+type Worker struct {
+	logger        logger.Logger
+	pubsub        pubsub.PubSub
+	bookingClient *grpc.BookingClient
+	paymentClient *grpc.PaymentClient
+	bookingRepo   datastore.BookingRepository
+	paymentRepo   datastore.PaymentRepository
+}
+
+func NewWorker(ctn *do.Injector) (*Worker, error) {
+	log, err := do.Invoke[logger.Logger](ctn)
+	if err != nil {
+		return nil, err
+	}
+
+	pubsub, err := do.Invoke[pubsub.PubSub](ctn)
+	if err != nil {
+		return nil, err
+	}
+
+	bookingRepo, err := do.Invoke[datastore.BookingRepository](ctn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get booking repository: %w", err)
+	}
+
+	paymentRepo, err := do.Invoke[datastore.PaymentRepository](ctn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment repository: %w", err)
+	}
+
+	bookingClient, err := grpc.NewBookingClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create booking client: %w", err)
+	}
+
+	paymentClient, err := grpc.NewPaymentClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create payment client: %w", err)
+	}
+
+	return &Worker{
+		logger:        log,
+		pubsub:        pubsub,
+		bookingClient: bookingClient,
+		paymentClient: paymentClient,
+		bookingRepo:   bookingRepo,
+		paymentRepo:   paymentRepo,
+	}, nil
+}
+
+func (w *Worker) Start(ctx context.Context) error {
+	w.logger.Info("Starting booking sweeper worker...")
+
+	ticker := time.NewTicker(SweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Booking sweeper worker stopped")
+			return ctx.Err()
+		case <-ticker.C:
+			if err := w.sweep(ctx); err != nil {
+				w.logger.Error("Failed to sweep expired bookings: %v", err)
+			}
+			if err := w.expireWaitlistOffers(ctx); err != nil {
+				w.logger.Error("Failed to expire waitlist offers: %v", err)
+			}
+			if err := w.expireSeatHolds(ctx); err != nil {
+				w.logger.Error("Failed to expire seat holds: %v", err)
+			}
+		}
+	}
+}
+
+func (w *Worker) sweep(ctx context.Context) error {
+	bookings, err := w.bookingRepo.GetExpiredPendingBookings(ctx, time.Now().Add(-HoldWindow), SweepBatchSize)
+	if err != nil {
+		return err
+	}
+
+	if len(bookings) == 0 {
+		return nil
+	}
+
+	w.logger.Debug("Sweeping %d expired bookings", len(bookings))
+
+	expired := 0
+	for _, booking := range bookings {
+		if err = w.expireBooking(ctx, booking); err != nil {
+			w.logger.Error("Failed to expire booking %s: %v", booking.Id, err)
+			continue
+		}
+		expired++
+	}
+
+	w.logger.Info("Expired %d/%d pending bookings", expired, len(bookings))
+	return nil
+}
+
+// expireWaitlistOffers closes unclaimed waitlist offers. Their seats come back
+// through SEAT_RELEASED events and are offered to the next in line.
+func (w *Worker) expireWaitlistOffers(ctx context.Context) error {
+	expired, err := w.bookingClient.ExpireWaitlistOffers(ctx, SweepBatchSize)
+	if err != nil {
+		return err
+	}
+
+	if expired > 0 {
+		w.logger.Info("Expired %d waitlist offers", expired)
+	}
+	return nil
+}
+
+// expireSeatHolds releases seat holds that ran out. Their seats come back
+// through SEAT_RELEASED events and are offered to the waitlist.
+func (w *Worker) expireSeatHolds(ctx context.Context) error {
+	expired, err := w.bookingClient.ExpireSeatHolds(ctx, SweepBatchSize)
+	if err != nil {
+		return err
+	}
+
+	if expired > 0 {
+		w.logger.Info("Expired %d seat holds", expired)
+	}
+	return nil
+}
+
+func (w *Worker) expireBooking(ctx context.Context, booking models.Booking) error {
+	// Expire the payment first so a late webhook can no longer complete it.
+	payment, err := w.paymentRepo.ExpirePendingPayment(ctx, booking.Id)
+	if err != nil {
+		return err
+	}
+
+	if payment != nil && payment.Status == models.PaymentStatusCompleted {
+		w.logger.Info("Skipping booking %s: payment already completed", booking.Id)
+		return nil
+	}
+
+	// Give back anything the unpaid payment took from gift cards.
+	if payment != nil && payment.GiftCardAmount > 0 {
+		released, err := w.paymentClient.ReleaseGiftCards(ctx, booking.Id)
+		if err != nil {
+			return err
+		}
+		w.logger.Info("Released %.2f in gift card balance from booking %s", released, booking.Id)
+	}
+
+	if _, err = w.bookingClient.CancelBooking(ctx, booking.Id, booking.UserId, models.SeatReleaseReasonHoldExpired); err != nil {
+		return err
+	}
+
+	notificationData := map[string]interface{}{
+		"user_id":    booking.UserId,
+		"booking_id": booking.Id,
+		"status":     "EXPIRED",
+		"timestamp":  time.Now().Unix(),
+		"title":      "Booking Expired",
+		"message":    fmt.Sprintf("Your seat hold for booking %s has expired because payment was not completed in time.", booking.Id),
+	}
+
+	userMessage := &pubsub.Message{
+		Topic: fmt.Sprintf("booking_%s", booking.UserId),
+		Data:  notificationData,
+	}
+
+	return w.pubsub.Publish(ctx, userMessage)
+}
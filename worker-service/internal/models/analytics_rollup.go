@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+const (
+	AnalyticsRollupHourly = "hourly"
+	AnalyticsRollupDaily  = "daily"
+)
+
+type AnalyticsRollupState struct {
+	bun.BaseModel `bun:"table:analytics_rollup_states,alias:ars"`
+
+	Name        string     `bun:"name,pk" json:"name"`
+	Timezone    string     `bun:"timezone,notnull" json:"timezone"`
+	ClosedUntil *time.Time `bun:"closed_until" json:"closed_until,omitempty"`
+	RefreshedAt *time.Time `bun:"refreshed_at" json:"refreshed_at,omitempty"`
+	UpdatedAt   time.Time  `bun:"updated_at,nullzero,default:current_timestamp" json:"updated_at"`
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type BookingStatus string
+
+const (
+	BookingStatusPending   BookingStatus = "PENDING"
+	BookingStatusConfirmed BookingStatus = "CONFIRMED"
+	BookingStatusCancelled BookingStatus = "CANCELLED"
+)
+
+type Booking struct {
+	bun.BaseModel `bun:"table:bookings,alias:b"`
+
+	Id          string        `bun:"id,pk" json:"id"`
+	UserId      string        `bun:"user_id,notnull" json:"user_id"`
+	ShowtimeId  string        `bun:"showtime_id,notnull" json:"showtime_id"`
+	TotalAmount float64       `bun:"total_amount,notnull" json:"total_amount"`
+	Status      BookingStatus `bun:"status,notnull" json:"status"`
+	CreatedAt   time.Time     `bun:"created_at,nullzero,default:current_timestamp" json:"created_at"`
+	UpdatedAt   *time.Time    `bun:"updated_at" json:"updated_at,omitempty"`
+}
+
+type BookingSeat struct {
+	bun.BaseModel `bun:"table:booking_seats,alias:bs"`
+
+	Id         string    `bun:"id,pk" json:"id"`
+	BookingId  string    `bun:"booking_id,notnull" json:"booking_id"`
+	ShowtimeId string    `bun:"showtime_id,notnull" json:"showtime_id"`
+	SeatId     string    `bun:"seat_id,notnull" json:"seat_id"`
+	Status     string    `bun:"status,notnull" json:"status"`
+	CreatedAt  time.Time `bun:"created_at,nullzero,default:current_timestamp" json:"created_at"`
+}
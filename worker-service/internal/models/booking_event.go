@@ -9,3 +9,45 @@ type BookingEventData struct {
 	TotalAmount float64  `json:"total_amount"`
 	Status      string   `json:"status"`
 }
+
+// SeatReleaseReasonHoldExpired is sent by the sweeper, which notifies the
+// user itself.
+const SeatReleaseReasonHoldExpired = "HOLD_EXPIRED"
+
+type SeatReleasedEventData struct {
+	BookingId  string   `json:"booking_id"`
+	UserId     string   `json:"user_id"`
+	ShowtimeId string   `json:"showtime_id"`
+	SeatIds    []string `json:"seat_ids"`
+	Reason     string   `json:"reason,omitempty"`
+}
+
+type BookingExchangedEventData struct {
+	ExchangeId      string   `json:"exchange_id"`
+	BookingId       string   `json:"booking_id"`
+	UserId          string   `json:"user_id"`
+	StaffId         string   `json:"staff_id"`
+	OldShowtimeId   string   `json:"old_showtime_id"`
+	OldSeatIds      []string `json:"old_seat_ids"`
+	NewShowtimeId   string   `json:"new_showtime_id"`
+	NewSeatIds      []string `json:"new_seat_ids"`
+	OldAmount       float64  `json:"old_amount"`
+	NewAmount       float64  `json:"new_amount"`
+	PriceDifference float64  `json:"price_difference"`
+}
+
+type SeatState string
+
+const (
+	SeatStateHeld     SeatState = "HELD"
+	SeatStateReleased SeatState = "RELEASED"
+	SeatStateBooked   SeatState = "BOOKED"
+)
+
+// SeatStateChange mirrors the seat map diff published by booking-service.
+type SeatStateChange struct {
+	ShowtimeId string    `json:"showtime_id"`
+	SeatIds    []string  `json:"seat_ids"`
+	State      SeatState `json:"state"`
+	Timestamp  int64     `json:"timestamp"`
+}
@@ -25,6 +25,7 @@ const (
 	EventTypeSeatReserved     OutboxEventType = "SEAT_RESERVED"
 	EventTypeSeatReleased     OutboxEventType = "SEAT_RELEASED"
 	EventTypeNotificationSent OutboxEventType = "NOTIFICATION_SENT"
+	EventTypeBookingExchanged OutboxEventType = "BOOKING_EXCHANGED"
 )
 
 type OutboxEventStatus string
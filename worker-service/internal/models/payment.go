@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type PaymentStatus string
+
+const (
+	PaymentStatusPending   PaymentStatus = "PENDING"
+	PaymentStatusCompleted PaymentStatus = "COMPLETED"
+	PaymentStatusFailed    PaymentStatus = "FAILED"
+	PaymentStatusExpired   PaymentStatus = "EXPIRED"
+)
+
+type Payment struct {
+	bun.BaseModel `bun:"table:payments"`
+
+	Id             string        `bun:"id,pk" json:"id"`
+	BookingId      string        `bun:"booking_id,notnull" json:"booking_id"`
+	Amount         float64       `bun:"amount,notnull" json:"amount"`
+	GiftCardAmount float64       `bun:"gift_card_amount" json:"gift_card_amount"`
+	Status         PaymentStatus `bun:"status,notnull" json:"status"`
+	CreatedAt      time.Time     `bun:"created_at,nullzero,default:current_timestamp" json:"created_at"`
+	UpdatedAt      *time.Time    `bun:"updated_at" json:"updated_at,omitempty"`
+}
@@ -79,6 +79,7 @@ type UpdateBookingStatusResponse struct {
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	BookingId     string                 `protobuf:"bytes,4,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	PointsEarned  int32                  `protobuf:"varint,5,opt,name=points_earned,json=pointsEarned,proto3" json:"points_earned,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -141,29 +142,591 @@ func (x *UpdateBookingStatusResponse) GetBookingId() string {
 	return ""
 }
 
+func (x *UpdateBookingStatusResponse) GetPointsEarned() int32 {
+	if x != nil {
+		return x.PointsEarned
+	}
+	return 0
+}
+
 type CreateTicketsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
-	SeatIds       []string               `protobuf:"bytes,2,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	SeatIds       []string               `protobuf:"bytes,2,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTicketsRequest) Reset() {
+	*x = CreateTicketsRequest{}
+	mi := &file_booking_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTicketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTicketsRequest) ProtoMessage() {}
+
+func (x *CreateTicketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTicketsRequest.ProtoReflect.Descriptor instead.
+func (*CreateTicketsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateTicketsRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *CreateTicketsRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+type CreateTicketsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	TicketsCreated int32                  `protobuf:"varint,3,opt,name=tickets_created,json=ticketsCreated,proto3" json:"tickets_created,omitempty"`
+	BookingDetails *BookingDetails        `protobuf:"bytes,4,opt,name=booking_details,json=bookingDetails,proto3" json:"booking_details,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateTicketsResponse) Reset() {
+	*x = CreateTicketsResponse{}
+	mi := &file_booking_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTicketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTicketsResponse) ProtoMessage() {}
+
+func (x *CreateTicketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTicketsResponse.ProtoReflect.Descriptor instead.
+func (*CreateTicketsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateTicketsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateTicketsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateTicketsResponse) GetTicketsCreated() int32 {
+	if x != nil {
+		return x.TicketsCreated
+	}
+	return 0
+}
+
+func (x *CreateTicketsResponse) GetBookingDetails() *BookingDetails {
+	if x != nil {
+		return x.BookingDetails
+	}
+	return nil
+}
+
+type CancelBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // when set, cancel on behalf of the owner (PENDING bookings only)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingRequest) Reset() {
+	*x = CancelBookingRequest{}
+	mi := &file_booking_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingRequest) ProtoMessage() {}
+
+func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
+func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CancelBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *CancelBookingRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CancelBookingRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CancelBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	BookingId     string                 `protobuf:"bytes,3,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingResponse) Reset() {
+	*x = CancelBookingResponse{}
+	mi := &file_booking_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingResponse) ProtoMessage() {}
+
+func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingResponse.ProtoReflect.Descriptor instead.
+func (*CancelBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CancelBookingResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CancelBookingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CancelBookingResponse) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *CancelBookingResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CancelBookingResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ProcessWaitlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShowtimeId    string                 `protobuf:"bytes,1,opt,name=showtime_id,json=showtimeId,proto3" json:"showtime_id,omitempty"`
+	SeatIds       []string               `protobuf:"bytes,2,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessWaitlistRequest) Reset() {
+	*x = ProcessWaitlistRequest{}
+	mi := &file_booking_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessWaitlistRequest) ProtoMessage() {}
+
+func (x *ProcessWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*ProcessWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ProcessWaitlistRequest) GetShowtimeId() string {
+	if x != nil {
+		return x.ShowtimeId
+	}
+	return ""
+}
+
+func (x *ProcessWaitlistRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+type WaitlistOffer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ShowtimeId    string                 `protobuf:"bytes,3,opt,name=showtime_id,json=showtimeId,proto3" json:"showtime_id,omitempty"`
+	HoldId        string                 `protobuf:"bytes,4,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	SeatIds       []string               `protobuf:"bytes,5,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // unix seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitlistOffer) Reset() {
+	*x = WaitlistOffer{}
+	mi := &file_booking_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitlistOffer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitlistOffer) ProtoMessage() {}
+
+func (x *WaitlistOffer) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitlistOffer.ProtoReflect.Descriptor instead.
+func (*WaitlistOffer) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WaitlistOffer) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *WaitlistOffer) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WaitlistOffer) GetShowtimeId() string {
+	if x != nil {
+		return x.ShowtimeId
+	}
+	return ""
+}
+
+func (x *WaitlistOffer) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *WaitlistOffer) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *WaitlistOffer) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type ProcessWaitlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Offers        []*WaitlistOffer       `protobuf:"bytes,3,rep,name=offers,proto3" json:"offers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessWaitlistResponse) Reset() {
+	*x = ProcessWaitlistResponse{}
+	mi := &file_booking_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessWaitlistResponse) ProtoMessage() {}
+
+func (x *ProcessWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*ProcessWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ProcessWaitlistResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ProcessWaitlistResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ProcessWaitlistResponse) GetOffers() []*WaitlistOffer {
+	if x != nil {
+		return x.Offers
+	}
+	return nil
+}
+
+type ExpireWaitlistOffersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpireWaitlistOffersRequest) Reset() {
+	*x = ExpireWaitlistOffersRequest{}
+	mi := &file_booking_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpireWaitlistOffersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireWaitlistOffersRequest) ProtoMessage() {}
+
+func (x *ExpireWaitlistOffersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireWaitlistOffersRequest.ProtoReflect.Descriptor instead.
+func (*ExpireWaitlistOffersRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ExpireWaitlistOffersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ExpireWaitlistOffersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Expired       int32                  `protobuf:"varint,3,opt,name=expired,proto3" json:"expired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpireWaitlistOffersResponse) Reset() {
+	*x = ExpireWaitlistOffersResponse{}
+	mi := &file_booking_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpireWaitlistOffersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireWaitlistOffersResponse) ProtoMessage() {}
+
+func (x *ExpireWaitlistOffersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireWaitlistOffersResponse.ProtoReflect.Descriptor instead.
+func (*ExpireWaitlistOffersResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ExpireWaitlistOffersResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ExpireWaitlistOffersResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ExpireWaitlistOffersResponse) GetExpired() int32 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+type ExpireSeatHoldsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTicketsRequest) Reset() {
-	*x = CreateTicketsRequest{}
-	mi := &file_booking_proto_msgTypes[2]
+func (x *ExpireSeatHoldsRequest) Reset() {
+	*x = ExpireSeatHoldsRequest{}
+	mi := &file_booking_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTicketsRequest) String() string {
+func (x *ExpireSeatHoldsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTicketsRequest) ProtoMessage() {}
+func (*ExpireSeatHoldsRequest) ProtoMessage() {}
 
-func (x *CreateTicketsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[2]
+func (x *ExpireSeatHoldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -174,50 +737,186 @@ func (x *CreateTicketsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTicketsRequest.ProtoReflect.Descriptor instead.
-func (*CreateTicketsRequest) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ExpireSeatHoldsRequest.ProtoReflect.Descriptor instead.
+func (*ExpireSeatHoldsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CreateTicketsRequest) GetBookingId() string {
+func (x *ExpireSeatHoldsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ExpireSeatHoldsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Expired       int32                  `protobuf:"varint,3,opt,name=expired,proto3" json:"expired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpireSeatHoldsResponse) Reset() {
+	*x = ExpireSeatHoldsResponse{}
+	mi := &file_booking_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpireSeatHoldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireSeatHoldsResponse) ProtoMessage() {}
+
+func (x *ExpireSeatHoldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireSeatHoldsResponse.ProtoReflect.Descriptor instead.
+func (*ExpireSeatHoldsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ExpireSeatHoldsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ExpireSeatHoldsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ExpireSeatHoldsResponse) GetExpired() int32 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+type ClaimBookingSeatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShowtimeId    string                 `protobuf:"bytes,1,opt,name=showtime_id,json=showtimeId,proto3" json:"showtime_id,omitempty"`
+	BookingId     string                 `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	PrevOwner     string                 `protobuf:"bytes,3,opt,name=prev_owner,json=prevOwner,proto3" json:"prev_owner,omitempty"` // another owner whose entries are taken over, if any
+	SeatIds       []string               `protobuf:"bytes,4,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	State         string                 `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"` // HELD or BOOKED
+	TtlSeconds    int64                  `protobuf:"varint,6,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimBookingSeatsRequest) Reset() {
+	*x = ClaimBookingSeatsRequest{}
+	mi := &file_booking_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimBookingSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimBookingSeatsRequest) ProtoMessage() {}
+
+func (x *ClaimBookingSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimBookingSeatsRequest.ProtoReflect.Descriptor instead.
+func (*ClaimBookingSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ClaimBookingSeatsRequest) GetShowtimeId() string {
+	if x != nil {
+		return x.ShowtimeId
+	}
+	return ""
+}
+
+func (x *ClaimBookingSeatsRequest) GetBookingId() string {
 	if x != nil {
 		return x.BookingId
 	}
 	return ""
 }
 
-func (x *CreateTicketsRequest) GetSeatIds() []string {
+func (x *ClaimBookingSeatsRequest) GetPrevOwner() string {
+	if x != nil {
+		return x.PrevOwner
+	}
+	return ""
+}
+
+func (x *ClaimBookingSeatsRequest) GetSeatIds() []string {
 	if x != nil {
 		return x.SeatIds
 	}
 	return nil
 }
 
-type CreateTicketsResponse struct {
+func (x *ClaimBookingSeatsRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ClaimBookingSeatsRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type ClaimBookingSeatsResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	TicketsCreated int32                  `protobuf:"varint,3,opt,name=tickets_created,json=ticketsCreated,proto3" json:"tickets_created,omitempty"`
-	BookingDetails *BookingDetails        `protobuf:"bytes,4,opt,name=booking_details,json=bookingDetails,proto3" json:"booking_details,omitempty"`
+	ClaimedSeatIds []string               `protobuf:"bytes,3,rep,name=claimed_seat_ids,json=claimedSeatIds,proto3" json:"claimed_seat_ids,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateTicketsResponse) Reset() {
-	*x = CreateTicketsResponse{}
-	mi := &file_booking_proto_msgTypes[3]
+func (x *ClaimBookingSeatsResponse) Reset() {
+	*x = ClaimBookingSeatsResponse{}
+	mi := &file_booking_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTicketsResponse) String() string {
+func (x *ClaimBookingSeatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTicketsResponse) ProtoMessage() {}
+func (*ClaimBookingSeatsResponse) ProtoMessage() {}
 
-func (x *CreateTicketsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[3]
+func (x *ClaimBookingSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -228,35 +927,148 @@ func (x *CreateTicketsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTicketsResponse.ProtoReflect.Descriptor instead.
-func (*CreateTicketsResponse) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ClaimBookingSeatsResponse.ProtoReflect.Descriptor instead.
+func (*ClaimBookingSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CreateTicketsResponse) GetSuccess() bool {
+func (x *ClaimBookingSeatsResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *CreateTicketsResponse) GetMessage() string {
+func (x *ClaimBookingSeatsResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *CreateTicketsResponse) GetTicketsCreated() int32 {
+func (x *ClaimBookingSeatsResponse) GetClaimedSeatIds() []string {
 	if x != nil {
-		return x.TicketsCreated
+		return x.ClaimedSeatIds
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateTicketsResponse) GetBookingDetails() *BookingDetails {
+type ReleaseBookingSeatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShowtimeId    string                 `protobuf:"bytes,1,opt,name=showtime_id,json=showtimeId,proto3" json:"showtime_id,omitempty"`
+	BookingId     string                 `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	SeatIds       []string               `protobuf:"bytes,3,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseBookingSeatsRequest) Reset() {
+	*x = ReleaseBookingSeatsRequest{}
+	mi := &file_booking_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseBookingSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseBookingSeatsRequest) ProtoMessage() {}
+
+func (x *ReleaseBookingSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[15]
 	if x != nil {
-		return x.BookingDetails
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseBookingSeatsRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseBookingSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReleaseBookingSeatsRequest) GetShowtimeId() string {
+	if x != nil {
+		return x.ShowtimeId
+	}
+	return ""
+}
+
+func (x *ReleaseBookingSeatsRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *ReleaseBookingSeatsRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+type ReleaseBookingSeatsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ReleasedSeatIds []string               `protobuf:"bytes,3,rep,name=released_seat_ids,json=releasedSeatIds,proto3" json:"released_seat_ids,omitempty"` // seats free afterwards
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReleaseBookingSeatsResponse) Reset() {
+	*x = ReleaseBookingSeatsResponse{}
+	mi := &file_booking_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseBookingSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseBookingSeatsResponse) ProtoMessage() {}
+
+func (x *ReleaseBookingSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseBookingSeatsResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseBookingSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReleaseBookingSeatsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReleaseBookingSeatsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReleaseBookingSeatsResponse) GetReleasedSeatIds() []string {
+	if x != nil {
+		return x.ReleasedSeatIds
 	}
 	return nil
 }
@@ -273,7 +1085,7 @@ type BookingDetails struct {
 
 func (x *BookingDetails) Reset() {
 	*x = BookingDetails{}
-	mi := &file_booking_proto_msgTypes[4]
+	mi := &file_booking_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -285,7 +1097,7 @@ func (x *BookingDetails) String() string {
 func (*BookingDetails) ProtoMessage() {}
 
 func (x *BookingDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[4]
+	mi := &file_booking_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -298,7 +1110,7 @@ func (x *BookingDetails) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BookingDetails.ProtoReflect.Descriptor instead.
 func (*BookingDetails) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{4}
+	return file_booking_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *BookingDetails) GetBookingId() string {
@@ -340,7 +1152,7 @@ type SeatInfo struct {
 
 func (x *SeatInfo) Reset() {
 	*x = SeatInfo{}
-	mi := &file_booking_proto_msgTypes[5]
+	mi := &file_booking_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -352,7 +1164,7 @@ func (x *SeatInfo) String() string {
 func (*SeatInfo) ProtoMessage() {}
 
 func (x *SeatInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[5]
+	mi := &file_booking_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -365,7 +1177,7 @@ func (x *SeatInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SeatInfo.ProtoReflect.Descriptor instead.
 func (*SeatInfo) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{5}
+	return file_booking_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *SeatInfo) GetSeatRow() string {
@@ -401,7 +1213,7 @@ type ShowtimeInfo struct {
 
 func (x *ShowtimeInfo) Reset() {
 	*x = ShowtimeInfo{}
-	mi := &file_booking_proto_msgTypes[6]
+	mi := &file_booking_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -413,7 +1225,7 @@ func (x *ShowtimeInfo) String() string {
 func (*ShowtimeInfo) ProtoMessage() {}
 
 func (x *ShowtimeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[6]
+	mi := &file_booking_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -426,7 +1238,7 @@ func (x *ShowtimeInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShowtimeInfo.ProtoReflect.Descriptor instead.
 func (*ShowtimeInfo) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{6}
+	return file_booking_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ShowtimeInfo) GetShowtimeId() string {
@@ -465,13 +1277,14 @@ const file_booking_proto_rawDesc = "" +
 	"\x1aUpdateBookingStatusRequest\x12\x1d\n" +
 	"\n" +
 	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\"\x89\x01\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\xae\x01\n" +
 	"\x1bUpdateBookingStatusResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x17\n" +
 	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
-	"booking_id\x18\x04 \x01(\tR\tbookingId\"P\n" +
+	"booking_id\x18\x04 \x01(\tR\tbookingId\x12#\n" +
+	"\rpoints_earned\x18\x05 \x01(\x05R\fpointsEarned\"P\n" +
 	"\x14CreateTicketsRequest\x12\x1d\n" +
 	"\n" +
 	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x19\n" +
@@ -480,7 +1293,73 @@ const file_booking_proto_rawDesc = "" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12'\n" +
 	"\x0ftickets_created\x18\x03 \x01(\x05R\x0eticketsCreated\x12;\n" +
-	"\x0fbooking_details\x18\x04 \x01(\v2\x12.pb.BookingDetailsR\x0ebookingDetails\"\xa0\x01\n" +
+	"\x0fbooking_details\x18\x04 \x01(\v2\x12.pb.BookingDetailsR\x0ebookingDetails\"f\n" +
+	"\x14CancelBookingRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"\x9b\x01\n" +
+	"\x15CancelBookingResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x03 \x01(\tR\tbookingId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\"T\n" +
+	"\x16ProcessWaitlistRequest\x12\x1f\n" +
+	"\vshowtime_id\x18\x01 \x01(\tR\n" +
+	"showtimeId\x12\x19\n" +
+	"\bseat_ids\x18\x02 \x03(\tR\aseatIds\"\xb7\x01\n" +
+	"\rWaitlistOffer\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\tR\aentryId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vshowtime_id\x18\x03 \x01(\tR\n" +
+	"showtimeId\x12\x17\n" +
+	"\ahold_id\x18\x04 \x01(\tR\x06holdId\x12\x19\n" +
+	"\bseat_ids\x18\x05 \x03(\tR\aseatIds\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\x03R\texpiresAt\"x\n" +
+	"\x17ProcessWaitlistResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12)\n" +
+	"\x06offers\x18\x03 \x03(\v2\x11.pb.WaitlistOfferR\x06offers\"3\n" +
+	"\x1bExpireWaitlistOffersRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"l\n" +
+	"\x1cExpireWaitlistOffersResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\aexpired\x18\x03 \x01(\x05R\aexpired\".\n" +
+	"\x16ExpireSeatHoldsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"g\n" +
+	"\x17ExpireSeatHoldsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\aexpired\x18\x03 \x01(\x05R\aexpired\"\xcb\x01\n" +
+	"\x18ClaimBookingSeatsRequest\x12\x1f\n" +
+	"\vshowtime_id\x18\x01 \x01(\tR\n" +
+	"showtimeId\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\tR\tbookingId\x12\x1d\n" +
+	"\n" +
+	"prev_owner\x18\x03 \x01(\tR\tprevOwner\x12\x19\n" +
+	"\bseat_ids\x18\x04 \x03(\tR\aseatIds\x12\x14\n" +
+	"\x05state\x18\x05 \x01(\tR\x05state\x12\x1f\n" +
+	"\vttl_seconds\x18\x06 \x01(\x03R\n" +
+	"ttlSeconds\"y\n" +
+	"\x19ClaimBookingSeatsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
+	"\x10claimed_seat_ids\x18\x03 \x03(\tR\x0eclaimedSeatIds\"w\n" +
+	"\x1aReleaseBookingSeatsRequest\x12\x1f\n" +
+	"\vshowtime_id\x18\x01 \x01(\tR\n" +
+	"showtimeId\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\tR\tbookingId\x12\x19\n" +
+	"\bseat_ids\x18\x03 \x03(\tR\aseatIds\"}\n" +
+	"\x1bReleaseBookingSeatsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
+	"\x11released_seat_ids\x18\x03 \x03(\tR\x0freleasedSeatIds\"\xa0\x01\n" +
 	"\x0eBookingDetails\x12\x1d\n" +
 	"\n" +
 	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x1d\n" +
@@ -500,10 +1379,16 @@ const file_booking_proto_rawDesc = "" +
 	"start_time\x18\x02 \x01(\tR\tstartTime\x12\x1d\n" +
 	"\n" +
 	"movie_name\x18\x03 \x01(\tR\tmovieName\x12\x1b\n" +
-	"\troom_name\x18\x04 \x01(\tR\broomName2\xae\x01\n" +
+	"\troom_name\x18\x04 \x01(\tR\broomName2\x91\x05\n" +
 	"\x0eBookingService\x12V\n" +
 	"\x13UpdateBookingStatus\x12\x1e.pb.UpdateBookingStatusRequest\x1a\x1f.pb.UpdateBookingStatusResponse\x12D\n" +
-	"\rCreateTickets\x12\x18.pb.CreateTicketsRequest\x1a\x19.pb.CreateTicketsResponseB\x19Z\x17worker-service/proto/pbb\x06proto3"
+	"\rCreateTickets\x12\x18.pb.CreateTicketsRequest\x1a\x19.pb.CreateTicketsResponse\x12D\n" +
+	"\rCancelBooking\x12\x18.pb.CancelBookingRequest\x1a\x19.pb.CancelBookingResponse\x12J\n" +
+	"\x0fProcessWaitlist\x12\x1a.pb.ProcessWaitlistRequest\x1a\x1b.pb.ProcessWaitlistResponse\x12Y\n" +
+	"\x14ExpireWaitlistOffers\x12\x1f.pb.ExpireWaitlistOffersRequest\x1a .pb.ExpireWaitlistOffersResponse\x12J\n" +
+	"\x0fExpireSeatHolds\x12\x1a.pb.ExpireSeatHoldsRequest\x1a\x1b.pb.ExpireSeatHoldsResponse\x12P\n" +
+	"\x11ClaimBookingSeats\x12\x1c.pb.ClaimBookingSeatsRequest\x1a\x1d.pb.ClaimBookingSeatsResponse\x12V\n" +
+	"\x13ReleaseBookingSeats\x12\x1e.pb.ReleaseBookingSeatsRequest\x1a\x1f.pb.ReleaseBookingSeatsResponseB\x19Z\x17worker-service/proto/pbb\x06proto3"
 
 var (
 	file_booking_proto_rawDescOnce sync.Once
@@ -517,29 +1402,55 @@ func file_booking_proto_rawDescGZIP() []byte {
 	return file_booking_proto_rawDescData
 }
 
-var file_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_booking_proto_goTypes = []any{
-	(*UpdateBookingStatusRequest)(nil),  // 0: pb.UpdateBookingStatusRequest
-	(*UpdateBookingStatusResponse)(nil), // 1: pb.UpdateBookingStatusResponse
-	(*CreateTicketsRequest)(nil),        // 2: pb.CreateTicketsRequest
-	(*CreateTicketsResponse)(nil),       // 3: pb.CreateTicketsResponse
-	(*BookingDetails)(nil),              // 4: pb.BookingDetails
-	(*SeatInfo)(nil),                    // 5: pb.SeatInfo
-	(*ShowtimeInfo)(nil),                // 6: pb.ShowtimeInfo
+	(*UpdateBookingStatusRequest)(nil),   // 0: pb.UpdateBookingStatusRequest
+	(*UpdateBookingStatusResponse)(nil),  // 1: pb.UpdateBookingStatusResponse
+	(*CreateTicketsRequest)(nil),         // 2: pb.CreateTicketsRequest
+	(*CreateTicketsResponse)(nil),        // 3: pb.CreateTicketsResponse
+	(*CancelBookingRequest)(nil),         // 4: pb.CancelBookingRequest
+	(*CancelBookingResponse)(nil),        // 5: pb.CancelBookingResponse
+	(*ProcessWaitlistRequest)(nil),       // 6: pb.ProcessWaitlistRequest
+	(*WaitlistOffer)(nil),                // 7: pb.WaitlistOffer
+	(*ProcessWaitlistResponse)(nil),      // 8: pb.ProcessWaitlistResponse
+	(*ExpireWaitlistOffersRequest)(nil),  // 9: pb.ExpireWaitlistOffersRequest
+	(*ExpireWaitlistOffersResponse)(nil), // 10: pb.ExpireWaitlistOffersResponse
+	(*ExpireSeatHoldsRequest)(nil),       // 11: pb.ExpireSeatHoldsRequest
+	(*ExpireSeatHoldsResponse)(nil),      // 12: pb.ExpireSeatHoldsResponse
+	(*ClaimBookingSeatsRequest)(nil),     // 13: pb.ClaimBookingSeatsRequest
+	(*ClaimBookingSeatsResponse)(nil),    // 14: pb.ClaimBookingSeatsResponse
+	(*ReleaseBookingSeatsRequest)(nil),   // 15: pb.ReleaseBookingSeatsRequest
+	(*ReleaseBookingSeatsResponse)(nil),  // 16: pb.ReleaseBookingSeatsResponse
+	(*BookingDetails)(nil),               // 17: pb.BookingDetails
+	(*SeatInfo)(nil),                     // 18: pb.SeatInfo
+	(*ShowtimeInfo)(nil),                 // 19: pb.ShowtimeInfo
 }
 var file_booking_proto_depIdxs = []int32{
-	4, // 0: pb.CreateTicketsResponse.booking_details:type_name -> pb.BookingDetails
-	5, // 1: pb.BookingDetails.seats:type_name -> pb.SeatInfo
-	6, // 2: pb.BookingDetails.showtime:type_name -> pb.ShowtimeInfo
-	0, // 3: pb.BookingService.UpdateBookingStatus:input_type -> pb.UpdateBookingStatusRequest
-	2, // 4: pb.BookingService.CreateTickets:input_type -> pb.CreateTicketsRequest
-	1, // 5: pb.BookingService.UpdateBookingStatus:output_type -> pb.UpdateBookingStatusResponse
-	3, // 6: pb.BookingService.CreateTickets:output_type -> pb.CreateTicketsResponse
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	17, // 0: pb.CreateTicketsResponse.booking_details:type_name -> pb.BookingDetails
+	7,  // 1: pb.ProcessWaitlistResponse.offers:type_name -> pb.WaitlistOffer
+	18, // 2: pb.BookingDetails.seats:type_name -> pb.SeatInfo
+	19, // 3: pb.BookingDetails.showtime:type_name -> pb.ShowtimeInfo
+	0,  // 4: pb.BookingService.UpdateBookingStatus:input_type -> pb.UpdateBookingStatusRequest
+	2,  // 5: pb.BookingService.CreateTickets:input_type -> pb.CreateTicketsRequest
+	4,  // 6: pb.BookingService.CancelBooking:input_type -> pb.CancelBookingRequest
+	6,  // 7: pb.BookingService.ProcessWaitlist:input_type -> pb.ProcessWaitlistRequest
+	9,  // 8: pb.BookingService.ExpireWaitlistOffers:input_type -> pb.ExpireWaitlistOffersRequest
+	11, // 9: pb.BookingService.ExpireSeatHolds:input_type -> pb.ExpireSeatHoldsRequest
+	13, // 10: pb.BookingService.ClaimBookingSeats:input_type -> pb.ClaimBookingSeatsRequest
+	15, // 11: pb.BookingService.ReleaseBookingSeats:input_type -> pb.ReleaseBookingSeatsRequest
+	1,  // 12: pb.BookingService.UpdateBookingStatus:output_type -> pb.UpdateBookingStatusResponse
+	3,  // 13: pb.BookingService.CreateTickets:output_type -> pb.CreateTicketsResponse
+	5,  // 14: pb.BookingService.CancelBooking:output_type -> pb.CancelBookingResponse
+	8,  // 15: pb.BookingService.ProcessWaitlist:output_type -> pb.ProcessWaitlistResponse
+	10, // 16: pb.BookingService.ExpireWaitlistOffers:output_type -> pb.ExpireWaitlistOffersResponse
+	12, // 17: pb.BookingService.ExpireSeatHolds:output_type -> pb.ExpireSeatHoldsResponse
+	14, // 18: pb.BookingService.ClaimBookingSeats:output_type -> pb.ClaimBookingSeatsResponse
+	16, // 19: pb.BookingService.ReleaseBookingSeats:output_type -> pb.ReleaseBookingSeatsResponse
+	12, // [12:20] is the sub-list for method output_type
+	4,  // [4:12] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_booking_proto_init() }
@@ -553,7 +1464,7 @@ func file_booking_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_proto_rawDesc), len(file_booking_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,197 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: payment.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PaymentService_CreatePaymentAdjustment_FullMethodName = "/pb.PaymentService/CreatePaymentAdjustment"
+	PaymentService_ReleaseGiftCards_FullMethodName        = "/pb.PaymentService/ReleaseGiftCards"
+	PaymentService_ForceCompletePayment_FullMethodName    = "/pb.PaymentService/ForceCompletePayment"
+)
+
+// PaymentServiceClient is the client API for PaymentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PaymentServiceClient interface {
+	CreatePaymentAdjustment(ctx context.Context, in *CreatePaymentAdjustmentRequest, opts ...grpc.CallOption) (*CreatePaymentAdjustmentResponse, error)
+	ReleaseGiftCards(ctx context.Context, in *ReleaseGiftCardsRequest, opts ...grpc.CallOption) (*ReleaseGiftCardsResponse, error)
+	ForceCompletePayment(ctx context.Context, in *ForceCompletePaymentRequest, opts ...grpc.CallOption) (*ForceCompletePaymentResponse, error)
+}
+
+type paymentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPaymentServiceClient(cc grpc.ClientConnInterface) PaymentServiceClient {
+	return &paymentServiceClient{cc}
+}
+
+func (c *paymentServiceClient) CreatePaymentAdjustment(ctx context.Context, in *CreatePaymentAdjustmentRequest, opts ...grpc.CallOption) (*CreatePaymentAdjustmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePaymentAdjustmentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_CreatePaymentAdjustment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ReleaseGiftCards(ctx context.Context, in *ReleaseGiftCardsRequest, opts ...grpc.CallOption) (*ReleaseGiftCardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseGiftCardsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ReleaseGiftCards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ForceCompletePayment(ctx context.Context, in *ForceCompletePaymentRequest, opts ...grpc.CallOption) (*ForceCompletePaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForceCompletePaymentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ForceCompletePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PaymentServiceServer is the server API for PaymentService service.
+// All implementations must embed UnimplementedPaymentServiceServer
+// for forward compatibility.
+type PaymentServiceServer interface {
+	CreatePaymentAdjustment(context.Context, *CreatePaymentAdjustmentRequest) (*CreatePaymentAdjustmentResponse, error)
+	ReleaseGiftCards(context.Context, *ReleaseGiftCardsRequest) (*ReleaseGiftCardsResponse, error)
+	ForceCompletePayment(context.Context, *ForceCompletePaymentRequest) (*ForceCompletePaymentResponse, error)
+	mustEmbedUnimplementedPaymentServiceServer()
+}
+
+// UnimplementedPaymentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPaymentServiceServer struct{}
+
+func (UnimplementedPaymentServiceServer) CreatePaymentAdjustment(context.Context, *CreatePaymentAdjustmentRequest) (*CreatePaymentAdjustmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePaymentAdjustment not implemented")
+}
+func (UnimplementedPaymentServiceServer) ReleaseGiftCards(context.Context, *ReleaseGiftCardsRequest) (*ReleaseGiftCardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseGiftCards not implemented")
+}
+func (UnimplementedPaymentServiceServer) ForceCompletePayment(context.Context, *ForceCompletePaymentRequest) (*ForceCompletePaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceCompletePayment not implemented")
+}
+func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
+func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafePaymentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PaymentServiceServer will
+// result in compilation errors.
+type UnsafePaymentServiceServer interface {
+	mustEmbedUnimplementedPaymentServiceServer()
+}
+
+func RegisterPaymentServiceServer(s grpc.ServiceRegistrar, srv PaymentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPaymentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PaymentService_ServiceDesc, srv)
+}
+
+func _PaymentService_CreatePaymentAdjustment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePaymentAdjustmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CreatePaymentAdjustment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_CreatePaymentAdjustment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CreatePaymentAdjustment(ctx, req.(*CreatePaymentAdjustmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ReleaseGiftCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseGiftCardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ReleaseGiftCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ReleaseGiftCards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ReleaseGiftCards(ctx, req.(*ReleaseGiftCardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ForceCompletePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceCompletePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ForceCompletePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ForceCompletePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ForceCompletePayment(ctx, req.(*ForceCompletePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PaymentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.PaymentService",
+	HandlerType: (*PaymentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePaymentAdjustment",
+			Handler:    _PaymentService_CreatePaymentAdjustment_Handler,
+		},
+		{
+			MethodName: "ReleaseGiftCards",
+			Handler:    _PaymentService_ReleaseGiftCards_Handler,
+		},
+		{
+			MethodName: "ForceCompletePayment",
+			Handler:    _PaymentService_ForceCompletePayment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "payment.proto",
+}
@@ -16,10 +16,13 @@ REDIS_DB=${REDIS_DB:-0}
 
 OUTBOX_INTERVAL=${OUTBOX_INTERVAL:-5s}
 CRAWL_INTERVAL=${CRAWL_INTERVAL:-1h}
+ANALYTICS_TIMEZONE=${ANALYTICS_TIMEZONE:-UTC}
+ANALYTICS_ROLLUP_INTERVAL=${ANALYTICS_ROLLUP_INTERVAL:-5m}
 
 export DB_HOST DB_PORT DB_USER DB_PASSWORD DB_NAME DB_SSLMODE
 export REDIS_HOST REDIS_PORT REDIS_PASSWORD REDIS_DB
 export OUTBOX_INTERVAL CRAWL_INTERVAL
+export ANALYTICS_TIMEZONE ANALYTICS_ROLLUP_INTERVAL
 
 case $JOB in
   "outbox")
@@ -30,9 +33,17 @@ case $JOB in
     echo "Starting crawl worker..."
     go run ./cmd/crawl
     ;;
+  "sweeper")
+    echo "Starting booking sweeper worker..."
+    go run ./cmd/sweeper
+    ;;
+  "rollup")
+    echo "Starting analytics rollup worker..."
+    go run ./cmd/rollup
+    ;;
   *)
     echo "Unknown job: $JOB"
-    echo "Available jobs: outbox, crawl"
+    echo "Available jobs: outbox, crawl, sweeper, rollup"
     exit 1
     ;;
 esac